@@ -4,7 +4,9 @@
 package azure
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 )
 
@@ -30,3 +32,358 @@ func QuotedStringSlice(strs []string) string {
 
 	return sb.String()
 }
+
+// PolicyViolation describes a request which was denied by Azure Policy, as parsed from a `RequestDisallowedByPolicy`
+// error (which may be nested within an `InvalidTemplateDeployment` error).
+type PolicyViolation struct {
+	// Target is the name of the resource (or field) which the request was denied for
+	Target string
+
+	PolicyAssignmentId          string
+	PolicyAssignmentName        string
+	PolicyAssignmentDisplayName string
+	PolicyAssignmentScope       string
+
+	PolicyDefinitionId          string
+	PolicyDefinitionName        string
+	PolicyDefinitionDisplayName string
+	PolicyDefinitionEffect      string
+	PolicyDefinitionReferenceId string
+
+	PolicySetDefinitionId          string
+	PolicySetDefinitionDisplayName string
+
+	// PolicyExemptionIds are the IDs of the Policy Exemptions which ARM evaluated for the request
+	PolicyExemptionIds []string
+
+	EvaluatedExpressions []PolicyEvaluatedExpression
+
+	// Exemptions are the Policy Exemptions which exist for the Policy Assignment, these aren't returned by ARM and must
+	// be looked up separately
+	Exemptions []PolicyExemption
+}
+
+// PolicyExemption is a Policy Exemption for the Policy Assignment which denied a request.
+type PolicyExemption struct {
+	Id                           string
+	DisplayName                  string
+	Category                     string
+	ExpiresOn                    string
+	PolicyDefinitionReferenceIds []string
+}
+
+// PolicyEvaluatedExpression is a condition of the Policy Definition which was evaluated against the request.
+type PolicyEvaluatedExpression struct {
+	// Path is the path of the field within the request (e.g. `properties.networkAcls.defaultAction`)
+	Path string
+
+	// Expression is the field (or alias) the Policy Definition refers to (e.g. `Microsoft.Storage/storageAccounts/networkAcls.defaultAction`)
+	Expression string
+
+	ExpressionKind  string
+	ExpressionValue interface{}
+	Operator        string
+	TargetValue     interface{}
+	Result          string
+}
+
+type policyViolationInfo struct {
+	EvaluationDetails struct {
+		EvaluatedExpressions []struct {
+			Expression      string      `json:"expression"`
+			ExpressionKind  string      `json:"expressionKind"`
+			ExpressionValue interface{} `json:"expressionValue"`
+			Operator        string      `json:"operator"`
+			Path            string      `json:"path"`
+			Result          string      `json:"result"`
+			TargetValue     interface{} `json:"targetValue"`
+		} `json:"evaluatedExpressions"`
+	} `json:"evaluationDetails"`
+	PolicyAssignmentDisplayName    string   `json:"policyAssignmentDisplayName"`
+	PolicyAssignmentId             string   `json:"policyAssignmentId"`
+	PolicyAssignmentName           string   `json:"policyAssignmentName"`
+	PolicyAssignmentScope          string   `json:"policyAssignmentScope"`
+	PolicyDefinitionDisplayName    string   `json:"policyDefinitionDisplayName"`
+	PolicyDefinitionEffect         string   `json:"policyDefinitionEffect"`
+	PolicyDefinitionId             string   `json:"policyDefinitionId"`
+	PolicyDefinitionName           string   `json:"policyDefinitionName"`
+	PolicyDefinitionReferenceId    string   `json:"policyDefinitionReferenceId"`
+	PolicyExemptionIds             []string `json:"policyExemptionIds"`
+	PolicySetDefinitionDisplayName string   `json:"policySetDefinitionDisplayName"`
+	PolicySetDefinitionId          string   `json:"policySetDefinitionId"`
+}
+
+type policyIdentifier struct {
+	PolicyAssignment struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"policyAssignment"`
+	PolicyDefinition struct {
+		Id                          string `json:"id"`
+		Name                        string `json:"name"`
+		ReferenceId                 string `json:"referenceId"`
+		PolicyDefinitionReferenceId string `json:"policyDefinitionReferenceId"`
+	} `json:"policyDefinition"`
+	PolicySetDefinition struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"policySetDefinition"`
+}
+
+// policyIdentifiersRegex matches the identifiers which ARM includes in the message of a `RequestDisallowedByPolicy` error,
+// e.g. `Resource 'example' was disallowed by policy. Policy identifiers: '[{"policyAssignment":{...}}]'.`
+var policyIdentifiersRegex = regexp.MustCompile(`Policy identifiers: '(\[.*\])'`)
+
+// ParsePolicyViolations returns the Policy Violations contained within the (API response embedded in the) error `err`,
+// or nil when the request wasn't denied by Azure Policy.
+func ParsePolicyViolations(err error) []PolicyViolation {
+	if err == nil {
+		return nil
+	}
+
+	message := err.Error()
+	if !strings.Contains(message, "RequestDisallowedByPolicy") && !strings.Contains(message, "PolicyViolation") {
+		return nil
+	}
+
+	violations := make([]PolicyViolation, 0)
+	seen := make(map[string]struct{})
+
+	// the API response is embedded in the error alongside other text, so attempt to decode each JSON object within it
+	for offset := 0; offset < len(message); {
+		start := strings.IndexByte(message[offset:], '{')
+		if start < 0 {
+			break
+		}
+		start += offset
+
+		var decoded interface{}
+		decoder := json.NewDecoder(strings.NewReader(message[start:]))
+		if decodeErr := decoder.Decode(&decoded); decodeErr != nil {
+			offset = start + 1
+			continue
+		}
+		offset = start + int(decoder.InputOffset())
+
+		for _, v := range findPolicyViolations(decoded, "") {
+			key := strings.ToLower(strings.Join([]string{v.PolicyAssignmentId, v.PolicyDefinitionId, v.PolicyDefinitionReferenceId}, "|"))
+			if _, exists := seen[key]; exists {
+				continue
+			}
+			seen[key] = struct{}{}
+			violations = append(violations, v)
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return violations
+}
+
+// findPolicyViolations walks the decoded JSON `input` looking for `RequestDisallowedByPolicy` errors, preferring the
+// `PolicyViolation` additional info (when present) over the identifiers within the error message
+func findPolicyViolations(input interface{}, target string) []PolicyViolation {
+	out := make([]PolicyViolation, 0)
+
+	switch v := input.(type) {
+	case []interface{}:
+		for _, item := range v {
+			out = append(out, findPolicyViolations(item, target)...)
+		}
+
+	case map[string]interface{}:
+		if t, ok := v["target"].(string); ok && t != "" {
+			target = t
+		}
+
+		if infoType, ok := v["type"].(string); ok && strings.EqualFold(infoType, "PolicyViolation") {
+			if info, ok := v["info"].(map[string]interface{}); ok {
+				if violation := expandPolicyViolationInfo(info, target); violation != nil {
+					return append(out, *violation)
+				}
+			}
+		}
+
+		for _, key := range []string{"error", "details", "additionalInfo", "AdditionalInfo"} {
+			if nested, ok := v[key]; ok {
+				out = append(out, findPolicyViolations(nested, target)...)
+			}
+		}
+
+		if len(out) > 0 {
+			return out
+		}
+
+		if code, ok := v["code"].(string); ok && strings.EqualFold(code, "RequestDisallowedByPolicy") {
+			if message, ok := v["message"].(string); ok {
+				out = append(out, parsePolicyIdentifiers(message, target)...)
+			}
+		}
+	}
+
+	return out
+}
+
+func expandPolicyViolationInfo(input map[string]interface{}, target string) *PolicyViolation {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil
+	}
+
+	var info policyViolationInfo
+	if err := json.Unmarshal(raw, &info); err != nil || info.PolicyAssignmentId == "" {
+		return nil
+	}
+
+	violation := PolicyViolation{
+		Target:                         target,
+		PolicyAssignmentId:             info.PolicyAssignmentId,
+		PolicyAssignmentName:           info.PolicyAssignmentName,
+		PolicyAssignmentDisplayName:    info.PolicyAssignmentDisplayName,
+		PolicyAssignmentScope:          info.PolicyAssignmentScope,
+		PolicyDefinitionId:             info.PolicyDefinitionId,
+		PolicyDefinitionName:           info.PolicyDefinitionName,
+		PolicyDefinitionDisplayName:    info.PolicyDefinitionDisplayName,
+		PolicyDefinitionEffect:         info.PolicyDefinitionEffect,
+		PolicyDefinitionReferenceId:    info.PolicyDefinitionReferenceId,
+		PolicySetDefinitionId:          info.PolicySetDefinitionId,
+		PolicySetDefinitionDisplayName: info.PolicySetDefinitionDisplayName,
+		PolicyExemptionIds:             info.PolicyExemptionIds,
+		EvaluatedExpressions:           make([]PolicyEvaluatedExpression, 0),
+	}
+
+	for _, e := range info.EvaluationDetails.EvaluatedExpressions {
+		violation.EvaluatedExpressions = append(violation.EvaluatedExpressions, PolicyEvaluatedExpression{
+			Path:            e.Path,
+			Expression:      e.Expression,
+			ExpressionKind:  e.ExpressionKind,
+			ExpressionValue: e.ExpressionValue,
+			Operator:        e.Operator,
+			TargetValue:     e.TargetValue,
+			Result:          e.Result,
+		})
+	}
+
+	return &violation
+}
+
+func parsePolicyIdentifiers(message, target string) []PolicyViolation {
+	out := make([]PolicyViolation, 0)
+
+	matches := policyIdentifiersRegex.FindStringSubmatch(message)
+	if len(matches) != 2 {
+		return out
+	}
+
+	var identifiers []policyIdentifier
+	if err := json.Unmarshal([]byte(matches[1]), &identifiers); err != nil {
+		return out
+	}
+
+	for _, identifier := range identifiers {
+		if identifier.PolicyAssignment.Id == "" {
+			continue
+		}
+
+		referenceId := identifier.PolicyDefinition.PolicyDefinitionReferenceId
+		if referenceId == "" {
+			referenceId = identifier.PolicyDefinition.ReferenceId
+		}
+
+		out = append(out, PolicyViolation{
+			Target:                      target,
+			PolicyAssignmentId:          identifier.PolicyAssignment.Id,
+			PolicyAssignmentName:        identifier.PolicyAssignment.Name,
+			PolicyDefinitionId:          identifier.PolicyDefinition.Id,
+			PolicyDefinitionName:        identifier.PolicyDefinition.Name,
+			PolicyDefinitionReferenceId: referenceId,
+			PolicySetDefinitionId:       identifier.PolicySetDefinition.Id,
+			EvaluatedExpressions:        make([]PolicyEvaluatedExpression, 0),
+		})
+	}
+
+	return out
+}
+
+// Summary returns a single line summary of the Policy Violation, naming the Policy Assignment which denied the request.
+func (v PolicyViolation) Summary() string {
+	name := v.PolicyAssignmentDisplayName
+	if name == "" {
+		name = v.PolicyAssignmentName
+	}
+
+	if v.Target != "" {
+		return fmt.Sprintf("the request for %q was denied by the Policy Assignment %q", v.Target, name)
+	}
+	return fmt.Sprintf("the request was denied by the Policy Assignment %q", name)
+}
+
+// Detail returns a description of the Policy Violation, including the Policy Definition and its effect, the fields
+// which were evaluated and the Policy Exemptions which could be used to exempt the resource from the Policy Assignment.
+func (v PolicyViolation) Detail() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Policy Assignment: %q (%s)\n", v.PolicyAssignmentDisplayName, v.PolicyAssignmentId))
+	if v.PolicyAssignmentScope != "" {
+		sb.WriteString(fmt.Sprintf("Policy Assignment Scope: %s\n", v.PolicyAssignmentScope))
+	}
+	if v.PolicySetDefinitionId != "" {
+		sb.WriteString(fmt.Sprintf("Policy Set Definition: %q (%s)\n", v.PolicySetDefinitionDisplayName, v.PolicySetDefinitionId))
+	}
+	if v.PolicyDefinitionId != "" {
+		sb.WriteString(fmt.Sprintf("Policy Definition: %q (%s)\n", v.PolicyDefinitionDisplayName, v.PolicyDefinitionId))
+	}
+	if v.PolicyDefinitionReferenceId != "" {
+		sb.WriteString(fmt.Sprintf("Policy Definition Reference ID: %s\n", v.PolicyDefinitionReferenceId))
+	}
+	if v.PolicyDefinitionEffect != "" {
+		sb.WriteString(fmt.Sprintf("Effect: %s\n", v.PolicyDefinitionEffect))
+	}
+
+	if len(v.EvaluatedExpressions) > 0 {
+		sb.WriteString("\nEvaluated Fields:\n")
+		for _, e := range v.EvaluatedExpressions {
+			path := e.Path
+			if path == "" {
+				path = e.Expression
+			}
+			sb.WriteString(fmt.Sprintf("  - `%s` (%s %s): value %s, condition `%s` %s evaluated to %s\n", path, e.ExpressionKind, e.Expression, policyValueString(e.ExpressionValue), e.Operator, policyValueString(e.TargetValue), e.Result))
+		}
+	}
+
+	if len(v.Exemptions) > 0 {
+		sb.WriteString("\nPolicy Exemptions for this Policy Assignment:\n")
+		for _, e := range v.Exemptions {
+			sb.WriteString(fmt.Sprintf("  - %q (%s)", e.DisplayName, e.Id))
+			if e.Category != "" {
+				sb.WriteString(fmt.Sprintf(", category %s", e.Category))
+			}
+			if len(e.PolicyDefinitionReferenceIds) > 0 {
+				sb.WriteString(fmt.Sprintf(", for the Policy Definition Reference IDs %s", strings.Join(e.PolicyDefinitionReferenceIds, ", ")))
+			}
+			if e.ExpiresOn != "" {
+				sb.WriteString(fmt.Sprintf(", expires on %s", e.ExpiresOn))
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("An exemption only applies to resources within its scope, the resource must be within the scope of one of these (or a new) Policy Exemption to be exempted.\n")
+	} else if len(v.PolicyExemptionIds) > 0 {
+		sb.WriteString(fmt.Sprintf("\nPolicy Exemptions evaluated: %s\n", strings.Join(v.PolicyExemptionIds, ", ")))
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func policyValueString(input interface{}) string {
+	if input == nil {
+		return "null"
+	}
+
+	out, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Sprintf("%v", input)
+	}
+	return string(out)
+}
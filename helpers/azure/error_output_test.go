@@ -4,6 +4,9 @@
 package azure_test
 
 import (
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -41,3 +44,117 @@ func TestQuotedStringSlice(t *testing.T) {
 		}
 	}
 }
+
+func TestParsePolicyViolations(t *testing.T) {
+	policyViolationBody := `{"error":{"code":"RequestDisallowedByPolicy","target":"acctestsa","message":"Resource 'acctestsa' was disallowed by policy. Policy identifiers: '[{\"policyAssignment\":{\"name\":\"Allowed locations\",\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/allowed-locations\"},\"policyDefinition\":{\"name\":\"Allowed locations\",\"id\":\"/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c\"}}]'.","additionalInfo":[{"type":"PolicyViolation","info":{"evaluationDetails":{"evaluatedExpressions":[{"result":"False","expressionKind":"Field","expression":"location","path":"location","expressionValue":"westus","targetValue":["westeurope"],"operator":"In"}]},"policyDefinitionId":"/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c","policyDefinitionName":"e56962a6-4747-49cd-b67b-bf8b01975c4c","policyDefinitionDisplayName":"Allowed locations","policyDefinitionEffect":"deny","policyAssignmentId":"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/allowed-locations","policyAssignmentName":"allowed-locations","policyAssignmentDisplayName":"Allowed locations","policyAssignmentScope":"/subscriptions/00000000-0000-0000-0000-000000000000","policyExemptionIds":[]}}]}}`
+	templateDeploymentBody := `{"error":{"code":"InvalidTemplateDeployment","message":"The template deployment failed because of policy violation. Please see details for more information.","details":[{"code":"RequestDisallowedByPolicy","target":"acctestsa","message":"Resource 'acctestsa' was disallowed by policy. Policy identifiers: '[{\"policyAssignment\":{\"name\":\"Allowed locations\",\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/allowed-locations\"},\"policyDefinition\":{\"name\":\"Allowed locations\",\"id\":\"/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c\"}}]'."}]}}`
+
+	testData := []struct {
+		input    error
+		expected []azure.PolicyViolation
+	}{
+		{
+			input:    nil,
+			expected: nil,
+		},
+		{
+			input:    fmt.Errorf("creating Storage Account: unexpected status 409 (409 Conflict) with error: StorageAccountAlreadyTaken"),
+			expected: nil,
+		},
+		{
+			input: fmt.Errorf("creating Storage Account: unexpected status 403 (403 Forbidden) with error: RequestDisallowedByPolicy: Resource was disallowed by policy.\n\nAPI Response:\n\n----[start]----\n%s\n-----[end]-----\n", policyViolationBody),
+			expected: []azure.PolicyViolation{
+				{
+					Target:                      "acctestsa",
+					PolicyAssignmentId:          "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/allowed-locations",
+					PolicyAssignmentName:        "allowed-locations",
+					PolicyAssignmentDisplayName: "Allowed locations",
+					PolicyAssignmentScope:       "/subscriptions/00000000-0000-0000-0000-000000000000",
+					PolicyDefinitionId:          "/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c",
+					PolicyDefinitionName:        "e56962a6-4747-49cd-b67b-bf8b01975c4c",
+					PolicyDefinitionDisplayName: "Allowed locations",
+					PolicyDefinitionEffect:      "deny",
+					PolicyExemptionIds:          []string{},
+					EvaluatedExpressions: []azure.PolicyEvaluatedExpression{
+						{
+							Path:            "location",
+							Expression:      "location",
+							ExpressionKind:  "Field",
+							ExpressionValue: "westus",
+							Operator:        "In",
+							TargetValue:     []interface{}{"westeurope"},
+							Result:          "False",
+						},
+					},
+				},
+			},
+		},
+		{
+			input: fmt.Errorf("creating Template Deployment: %s", templateDeploymentBody),
+			expected: []azure.PolicyViolation{
+				{
+					Target:               "acctestsa",
+					PolicyAssignmentId:   "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/allowed-locations",
+					PolicyAssignmentName: "Allowed locations",
+					PolicyDefinitionId:   "/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c",
+					PolicyDefinitionName: "Allowed locations",
+					EvaluatedExpressions: []azure.PolicyEvaluatedExpression{},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %v..", v.input)
+
+		actual := azure.ParsePolicyViolations(v.input)
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestPolicyViolationDetail(t *testing.T) {
+	violation := azure.PolicyViolation{
+		Target:                      "acctestsa",
+		PolicyAssignmentId:          "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/allowed-locations",
+		PolicyAssignmentDisplayName: "Allowed locations",
+		PolicyDefinitionId:          "/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c",
+		PolicyDefinitionDisplayName: "Allowed locations",
+		PolicyDefinitionEffect:      "deny",
+		EvaluatedExpressions: []azure.PolicyEvaluatedExpression{
+			{
+				Path:            "location",
+				Expression:      "location",
+				ExpressionKind:  "Field",
+				ExpressionValue: "westus",
+				Operator:        "In",
+				TargetValue:     []interface{}{"westeurope"},
+				Result:          "False",
+			},
+		},
+		Exemptions: []azure.PolicyExemption{
+			{
+				Id:          "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyExemptions/dev",
+				DisplayName: "Development",
+				Category:    "Waiver",
+			},
+		},
+	}
+
+	expectedSummary := `the request for "acctestsa" was denied by the Policy Assignment "Allowed locations"`
+	if actual := violation.Summary(); actual != expectedSummary {
+		t.Fatalf("Expected summary %q but got %q", expectedSummary, actual)
+	}
+
+	detail := violation.Detail()
+	for _, expected := range []string{
+		"Effect: deny",
+		"`location` (Field location): value \"westus\", condition `In` [\"westeurope\"] evaluated to False",
+		"\"Development\" (/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyExemptions/dev), category Waiver",
+	} {
+		if !strings.Contains(detail, expected) {
+			t.Fatalf("Expected detail to contain %q but got:\n%s", expected, detail)
+		}
+	}
+}
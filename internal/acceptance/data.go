@@ -33,6 +33,28 @@ func init() {
 		os.Setenv("ARM_SUBSCRIPTION_ID", vcr.SubscriptionPlaceholder)
 		os.Setenv("ARM_SUBSCRIPTION_ID_ALT", vcr.SubscriptionPlaceholderAlt)
 		os.Setenv("ARM_SUBSCRIPTION_ID_ALT2", vcr.SubscriptionPlaceholderAlt2)
+		os.Setenv("ARM_TENANT_ID", vcr.TenantPlaceholder)
+	}
+
+	if os.Getenv("TC_TEST_VIA_EMULATOR") != "" {
+		// The ARM Emulator neither requires nor validates credentials, so default anything which hasn't been
+		// specified to allow the acceptance tests to run without any Azure configuration
+		emulatorDefaults := map[string]string{
+			"ARM_CLIENT_ID":            vcr.SubscriptionPlaceholder,
+			"ARM_CLIENT_SECRET":        "emulator",
+			"ARM_SUBSCRIPTION_ID":      vcr.SubscriptionPlaceholder,
+			"ARM_SUBSCRIPTION_ID_ALT":  vcr.SubscriptionPlaceholderAlt,
+			"ARM_SUBSCRIPTION_ID_ALT2": vcr.SubscriptionPlaceholderAlt2,
+			"ARM_TENANT_ID":            vcr.SubscriptionPlaceholder,
+			"ARM_TEST_LOCATION":        "westeurope",
+			"ARM_TEST_LOCATION_ALT":    "northeurope",
+			"ARM_TEST_LOCATION_ALT2":   "eastus2",
+		}
+		for k, v := range emulatorDefaults {
+			if os.Getenv(k) == "" {
+				os.Setenv(k, v)
+			}
+		}
 	}
 }
 
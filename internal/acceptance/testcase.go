@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/armemulator"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/vcr"
 )
@@ -196,9 +197,17 @@ func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 			_ = vcr.StopRecorder(testName)
 		}(t.Name())
 	}
+	if os.Getenv("TC_TEST_VIA_EMULATOR") != "" {
+		defer armemulator.StopEmulator(t.Name())
+	}
+
+	builderOptions, err := testclient.ClientBuilderOptions(t.Name())
+	if err != nil {
+		t.Fatalf("building the client options: %+v", err)
+	}
 
 	testCase.ExternalProviders = td.externalProviders()
-	testCase.ProtoV5ProviderFactories = framework.ProtoV5ProviderFactoriesInitWithTestName(context.Background(), t.Name(), "azurerm", "azurerm-alt")
+	testCase.ProtoV5ProviderFactories = framework.ProtoV5ProviderFactoriesInitWithTestName(context.Background(), t.Name(), builderOptions, "azurerm", "azurerm-alt")
 
 	resource.ParallelTest(t, testCase)
 }
@@ -209,9 +218,17 @@ func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.T
 			_ = vcr.StopRecorder(testName)
 		}(t.Name())
 	}
+	if os.Getenv("TC_TEST_VIA_EMULATOR") != "" {
+		defer armemulator.StopEmulator(t.Name())
+	}
+
+	builderOptions, err := testclient.ClientBuilderOptions(t.Name())
+	if err != nil {
+		t.Fatalf("building the client options: %+v", err)
+	}
 
 	testCase.ExternalProviders = td.externalProviders()
-	testCase.ProtoV5ProviderFactories = framework.ProtoV5ProviderFactoriesInitWithTestName(context.Background(), t.Name(), "azurerm")
+	testCase.ProtoV5ProviderFactories = framework.ProtoV5ProviderFactoriesInitWithTestName(context.Background(), t.Name(), builderOptions, "azurerm")
 
 	resource.Test(t, testCase)
 }
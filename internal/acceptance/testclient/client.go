@@ -78,6 +78,14 @@ func BuildWithTestName(testName string) (*clients.Client, error) {
 			TestName:          testName,
 		}
 
+		builderOptions, err := ClientBuilderOptions(testName)
+		if err != nil {
+			return nil, fmt.Errorf("building test client: %+v", err)
+		}
+		for _, option := range builderOptions {
+			option(&clientBuilder)
+		}
+
 		client, err := clients.Build(ctx, clientBuilder)
 		if err != nil {
 			return nil, fmt.Errorf("building test client: %+v", err)
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package testclient
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/armemulator"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// ClientBuilderOptions returns the options used to build the Clients for the acceptance test `testName`.
+//
+// TC_TEST_VIA_EMULATOR runs the acceptance tests against an in-memory emulation of Resource Manager, which neither
+// requires nor uses credentials - see `internal/armemulator` for more information.
+func ClientBuilderOptions(testName string) ([]clients.ClientBuilderOption, error) {
+	if os.Getenv("TC_TEST_VIA_EMULATOR") == "" || testName == "" {
+		return nil, nil
+	}
+
+	emulator, err := armemulator.GetEmulator(testName)
+	if err != nil {
+		return nil, fmt.Errorf("getting arm emulator: %w", err)
+	}
+
+	return []clients.ClientBuilderOption{
+		func(builder *clients.ClientBuilder) {
+			builder.Authorizer = armemulator.Authorizer{}
+			builder.Transport = emulator
+			builder.Features.EnhancedValidation.Locations = false
+			builder.Features.EnhancedValidation.ResourceProviders = false
+		},
+	}, nil
+}
@@ -0,0 +1,580 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package armemulator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// operationsPath is the path beneath which the status of long-running operations can be retrieved
+	operationsPath = "/providers/Microsoft.Emulator/operations/"
+
+	provisioningStateAccepted  = "Accepted"
+	provisioningStateDeleting  = "Deleting"
+	provisioningStateFailed    = "Failed"
+	provisioningStateSucceeded = "Succeeded"
+	provisioningStateUpdating  = "Updating"
+)
+
+// ActionFunc handles a POST request to an action on a resource (e.g. `listKeys`), `resource` is the stored
+// representation of the resource the action is being performed on. The returned value is serialised as the
+// response body.
+type ActionFunc func(resource map[string]interface{}, body map[string]interface{}) (int, interface{})
+
+// Options configures the behaviour of an Emulator.
+type Options struct {
+	// PollsUntilComplete is the number of times a long-running operation reports as being in progress before it
+	// completes. A value of 0 means that operations complete synchronously, without a polling URI being returned.
+	PollsUntilComplete int
+
+	// RetryAfterSeconds is the value of the `Retry-After` header returned for long-running operations.
+	RetryAfterSeconds int
+
+	// Actions is a map of (case-insensitive) action names to the function handling POST requests for them.
+	Actions map[string]ActionFunc
+
+	// Faults is a list of Faults which should be injected into matching requests.
+	Faults []*Fault
+}
+
+// DefaultOptions returns the Options used by the shared Emulator used for acceptance tests, which exercises
+// long-running operations whilst keeping the polling interval low.
+func DefaultOptions() Options {
+	return Options{
+		PollsUntilComplete: 1,
+		RetryAfterSeconds:  1,
+	}
+}
+
+type storedResource struct {
+	path resourcePath
+	body map[string]interface{}
+
+	// deleteOperationId is the ID of the in-progress delete operation for this resource, if any
+	deleteOperationId string
+}
+
+type operation struct {
+	resourceKey string
+	method      string
+	remaining   int
+	failed      bool
+}
+
+// Emulator is an in-memory implementation of the Azure Resource Manager API, which generically implements the
+// create (PUT), update (PATCH), read (GET), list (GET) and delete (DELETE) operations for any Resource ID path.
+//
+// The Emulator implements `http.RoundTripper` so that it can be used as the `Transport` within the `ClientOptions`,
+// meaning that requests never leave the process. Long-running operations are returned using the
+// `Azure-AsyncOperation` and `Location` headers, with the `provisioningState` of the resource transitioning from
+// `Accepted`/`Updating`/`Deleting` to a terminal state once the operation has completed.
+type Emulator struct {
+	mu sync.Mutex
+
+	options Options
+
+	resources  map[string]*storedResource
+	operations map[string]*operation
+
+	operationCounter int
+}
+
+var _ http.RoundTripper = &Emulator{}
+
+// New returns a new, empty Emulator using the specified Options.
+func New(options Options) *Emulator {
+	return &Emulator{
+		options:    options,
+		resources:  make(map[string]*storedResource),
+		operations: make(map[string]*operation),
+	}
+}
+
+// InjectFault adds a Fault to the Emulator, which is applied to subsequent matching requests.
+func (e *Emulator) InjectFault(fault *Fault) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.options.Faults = append(e.options.Faults, fault)
+}
+
+// Exists returns whether a resource exists at the specified Resource ID.
+func (e *Emulator) Exists(id string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	_, ok := e.resources[parsePath(id).key()]
+	return ok
+}
+
+// ResourceIDs returns the Resource IDs of all resources stored within the Emulator, in sorted order.
+func (e *Emulator) ResourceIDs() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ids := make([]string, 0, len(e.resources))
+	for _, r := range e.resources {
+		ids = append(ids, r.path.raw)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// RoundTrip implements http.RoundTripper by processing the request against the in-memory store.
+func (e *Emulator) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body map[string]interface{}
+	if req.Body != nil {
+		raw, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %+v", err)
+		}
+		_ = req.Body.Close()
+
+		if len(bytes.TrimSpace(raw)) > 0 {
+			if err := json.Unmarshal(raw, &body); err != nil {
+				return errorResponse(req, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("the request content was invalid: %+v", err)), nil
+			}
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	failOperation := false
+	for _, fault := range e.options.Faults {
+		if !fault.matches(req) {
+			continue
+		}
+		fault.hits++
+
+		if fault.FailOperation {
+			failOperation = true
+			break
+		}
+
+		resp := errorResponse(req, fault.StatusCode, fault.Code, fault.Message)
+		for k, v := range fault.Headers {
+			resp.Header.Set(k, v)
+		}
+		return resp, nil
+	}
+
+	if strings.HasPrefix(strings.ToLower(req.URL.Path), strings.ToLower(operationsPath)) {
+		return e.pollOperation(req, strings.TrimPrefix(req.URL.Path[len(operationsPath)-1:], "/")), nil
+	}
+
+	path := parsePath(req.URL.Path)
+	switch path.kind {
+	case pathKindProvider:
+		return e.provider(req, path), nil
+
+	case pathKindCollection:
+		if req.Method != http.MethodGet {
+			if req.Method == http.MethodPost {
+				return e.action(req, path, body), nil
+			}
+			return errorResponse(req, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported on the collection %q", req.Method, path.raw)), nil
+		}
+		return e.list(req, path), nil
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		return e.get(req, path), nil
+	case http.MethodPut:
+		return e.put(req, path, body, failOperation), nil
+	case http.MethodPatch:
+		return e.patch(req, path, body, failOperation), nil
+	case http.MethodDelete:
+		return e.delete(req, path, failOperation), nil
+	}
+
+	return errorResponse(req, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported on %q", req.Method, path.raw)), nil
+}
+
+func (e *Emulator) get(req *http.Request, path resourcePath) *http.Response {
+	if path.isSubscription() {
+		return jsonResponse(req, http.StatusOK, map[string]interface{}{
+			"id":             path.raw,
+			"subscriptionId": path.name,
+			"displayName":    path.name,
+			"state":          "Enabled",
+		})
+	}
+
+	existing, ok := e.resources[path.key()]
+	if !ok {
+		return notFoundResponse(req, path)
+	}
+
+	// Deletions are polled by retrieving the resource until it returns a 404, so retrieving a resource which is
+	// being deleted progresses the delete operation
+	if id := existing.deleteOperationId; id != "" {
+		if op, ok := e.operations[id]; ok {
+			if op.remaining > 0 {
+				op.remaining--
+			} else {
+				e.completeOperation(op)
+				delete(e.operations, id)
+				if _, stillExists := e.resources[path.key()]; !stillExists {
+					return notFoundResponse(req, path)
+				}
+			}
+		}
+	}
+
+	return jsonResponse(req, http.StatusOK, existing.body)
+}
+
+func (e *Emulator) list(req *http.Request, path resourcePath) *http.Response {
+	if strings.EqualFold(path.resourceType, "Microsoft.Resources/providers") {
+		return jsonResponse(req, http.StatusOK, map[string]interface{}{
+			"value": []interface{}{},
+		})
+	}
+
+	parent := strings.ToLower(path.parent)
+	if parent != "/" && !e.parentExists(parent) {
+		return notFoundResponse(req, parsePath(path.parent))
+	}
+
+	keys := make([]string, 0)
+	for key, r := range e.resources {
+		if !strings.EqualFold(r.path.resourceType, path.resourceType) {
+			continue
+		}
+		if strings.EqualFold(r.path.parent, path.parent) || strings.EqualFold(r.path.subscriptionScopedCollection(), path.raw) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	values := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, e.resources[key].body)
+	}
+
+	return jsonResponse(req, http.StatusOK, map[string]interface{}{
+		"value": values,
+	})
+}
+
+func (e *Emulator) put(req *http.Request, path resourcePath, body map[string]interface{}, failOperation bool) *http.Response {
+	if !e.parentExists(strings.ToLower(path.parent)) {
+		return parentNotFoundResponse(req, path)
+	}
+
+	if body == nil {
+		body = make(map[string]interface{})
+	}
+
+	_, exists := e.resources[path.key()]
+	body["id"] = path.raw
+	body["name"] = path.name
+	body["type"] = resourceTypeForBody(path)
+
+	statusCode := http.StatusCreated
+	if exists {
+		statusCode = http.StatusOK
+	}
+
+	return e.store(req, path, body, statusCode, provisioningStateAccepted, failOperation)
+}
+
+func (e *Emulator) patch(req *http.Request, path resourcePath, body map[string]interface{}, failOperation bool) *http.Response {
+	existing, ok := e.resources[path.key()]
+	if !ok {
+		return notFoundResponse(req, path)
+	}
+
+	merged := mergeObjects(existing.body, body)
+	return e.store(req, path, merged, http.StatusOK, provisioningStateUpdating, failOperation)
+}
+
+func (e *Emulator) store(req *http.Request, path resourcePath, body map[string]interface{}, statusCode int, pendingState string, failOperation bool) *http.Response {
+	key := path.key()
+	e.resources[key] = &storedResource{
+		path: path,
+		body: body,
+	}
+
+	if e.options.PollsUntilComplete == 0 {
+		state := provisioningStateSucceeded
+		if failOperation {
+			state = provisioningStateFailed
+		}
+		setProvisioningState(body, state)
+		return jsonResponse(req, statusCode, body)
+	}
+
+	setProvisioningState(body, pendingState)
+	resp := jsonResponse(req, statusCode, body)
+	e.startOperation(req, resp, key, failOperation)
+	return resp
+}
+
+func (e *Emulator) delete(req *http.Request, path resourcePath, failOperation bool) *http.Response {
+	key := path.key()
+	existing, ok := e.resources[key]
+	if !ok {
+		return emptyResponse(req, http.StatusNoContent)
+	}
+
+	if e.options.PollsUntilComplete == 0 {
+		if failOperation {
+			return errorResponse(req, http.StatusConflict, "DeleteFailed", fmt.Sprintf("deleting %q failed", path.raw))
+		}
+		e.remove(key)
+		return emptyResponse(req, http.StatusOK)
+	}
+
+	setProvisioningState(existing.body, provisioningStateDeleting)
+	resp := emptyResponse(req, http.StatusAccepted)
+	existing.deleteOperationId = e.startOperation(req, resp, key, failOperation)
+	return resp
+}
+
+func (e *Emulator) action(req *http.Request, path resourcePath, body map[string]interface{}) *http.Response {
+	// the action name is parsed as the type of a collection beneath the resource it's being performed on
+	actionName := path.raw[strings.LastIndex(path.raw, "/")+1:]
+	target, ok := e.resources[strings.ToLower(path.parent)]
+	if !ok {
+		return notFoundResponse(req, parsePath(path.parent))
+	}
+
+	for name, handler := range e.options.Actions {
+		if strings.EqualFold(name, actionName) {
+			statusCode, result := handler(target.body, body)
+			return jsonResponse(req, statusCode, result)
+		}
+	}
+
+	return errorResponse(req, http.StatusNotImplemented, "ActionNotSupported", fmt.Sprintf("the action %q is not supported by the emulator", actionName))
+}
+
+func (e *Emulator) provider(req *http.Request, path resourcePath) *http.Response {
+	return jsonResponse(req, http.StatusOK, map[string]interface{}{
+		"id":                path.raw,
+		"namespace":         path.name,
+		"registrationState": "Registered",
+		"resourceTypes":     []interface{}{},
+	})
+}
+
+func (e *Emulator) startOperation(req *http.Request, resp *http.Response, key string, failed bool) string {
+	e.operationCounter++
+	operationId := strconv.Itoa(e.operationCounter)
+	e.operations[operationId] = &operation{
+		resourceKey: key,
+		method:      req.Method,
+		remaining:   e.options.PollsUntilComplete,
+		failed:      failed,
+	}
+
+	pollingUri := fmt.Sprintf("%s://%s%s%s", req.URL.Scheme, req.URL.Host, operationsPath, operationId)
+	if req.Method == http.MethodDelete {
+		resp.Header.Set("Location", pollingUri)
+	} else {
+		resp.Header.Set("Azure-AsyncOperation", pollingUri)
+	}
+	resp.Header.Set("Retry-After", strconv.Itoa(e.options.RetryAfterSeconds))
+
+	return operationId
+}
+
+func (e *Emulator) pollOperation(req *http.Request, operationId string) *http.Response {
+	op, ok := e.operations[operationId]
+	if !ok {
+		return errorResponse(req, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("the operation %q was not found", operationId))
+	}
+
+	if op.remaining > 0 {
+		op.remaining--
+		if op.method == http.MethodDelete {
+			resp := emptyResponse(req, http.StatusAccepted)
+			resp.Header.Set("Retry-After", strconv.Itoa(e.options.RetryAfterSeconds))
+			return resp
+		}
+		return jsonResponse(req, http.StatusOK, map[string]interface{}{
+			"status": "InProgress",
+		})
+	}
+
+	e.completeOperation(op)
+	delete(e.operations, operationId)
+
+	if op.failed {
+		return jsonResponse(req, http.StatusOK, map[string]interface{}{
+			"status": provisioningStateFailed,
+			"error": map[string]interface{}{
+				"code":    "OperationFailed",
+				"message": "the operation failed due to an injected fault",
+			},
+		})
+	}
+
+	if op.method == http.MethodDelete {
+		return emptyResponse(req, http.StatusNoContent)
+	}
+
+	return jsonResponse(req, http.StatusOK, map[string]interface{}{
+		"status": provisioningStateSucceeded,
+	})
+}
+
+// completeOperation moves the resource the operation relates to into its terminal state.
+func (e *Emulator) completeOperation(op *operation) {
+	r, ok := e.resources[op.resourceKey]
+	if !ok {
+		return
+	}
+
+	if op.failed {
+		r.deleteOperationId = ""
+		setProvisioningState(r.body, provisioningStateFailed)
+		return
+	}
+
+	if op.method == http.MethodDelete {
+		e.remove(op.resourceKey)
+		return
+	}
+
+	setProvisioningState(r.body, provisioningStateSucceeded)
+}
+
+// remove deletes the resource and any resources nested beneath it, in the same way that deleting a Resource Group
+// deletes the resources within it.
+func (e *Emulator) remove(key string) {
+	delete(e.resources, key)
+	for k := range e.resources {
+		if strings.HasPrefix(k, key+"/") {
+			delete(e.resources, k)
+		}
+	}
+
+	// complete any outstanding operations against the removed resources so that their pollers terminate
+	for id, op := range e.operations {
+		if op.resourceKey == key || strings.HasPrefix(op.resourceKey, key+"/") {
+			if op.method != http.MethodDelete {
+				delete(e.operations, id)
+			}
+		}
+	}
+}
+
+func (e *Emulator) parentExists(parentKey string) bool {
+	parent := parsePath(parentKey)
+	if parentKey == "/" || parent.isSubscription() || parent.kind != pathKindResource {
+		return true
+	}
+
+	_, ok := e.resources[parentKey]
+	return ok
+}
+
+func setProvisioningState(body map[string]interface{}, state string) {
+	props, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		props = make(map[string]interface{})
+		body["properties"] = props
+	}
+	props["provisioningState"] = state
+}
+
+func resourceTypeForBody(path resourcePath) string {
+	if path.isResourceGroup() {
+		return "Microsoft.Resources/resourceGroups"
+	}
+	return path.resourceType
+}
+
+// mergeObjects applies `patch` over `existing` using JSON Merge Patch semantics, where nested objects are merged
+// and `null` values remove the existing value.
+func mergeObjects(existing, patch map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(existing))
+	for k, v := range existing {
+		out[k] = v
+	}
+
+	for k, v := range patch {
+		if v == nil {
+			delete(out, k)
+			continue
+		}
+
+		patchObject, patchIsObject := v.(map[string]interface{})
+		existingObject, existingIsObject := out[k].(map[string]interface{})
+		if patchIsObject && existingIsObject {
+			out[k] = mergeObjects(existingObject, patchObject)
+			continue
+		}
+
+		out[k] = v
+	}
+
+	return out
+}
+
+func notFoundResponse(req *http.Request, path resourcePath) *http.Response {
+	if path.isResourceGroup() {
+		return errorResponse(req, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", path.name))
+	}
+
+	return errorResponse(req, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s/%s' was not found.", path.resourceType, path.name))
+}
+
+func parentNotFoundResponse(req *http.Request, path resourcePath) *http.Response {
+	parent := parsePath(path.parent)
+	if parent.isResourceGroup() {
+		return notFoundResponse(req, parent)
+	}
+
+	return errorResponse(req, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("Can not perform requested operation on nested resource. Parent resource '%s' not found.", parent.name))
+}
+
+func errorResponse(req *http.Request, statusCode int, code, message string) *http.Response {
+	return jsonResponse(req, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func jsonResponse(req *http.Request, statusCode int, body interface{}) *http.Response {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return errorResponse(req, http.StatusInternalServerError, "InternalServerError", fmt.Sprintf("serialising response: %+v", err))
+	}
+
+	resp := emptyResponse(req, statusCode)
+	resp.Header.Set("Content-Type", "application/json; charset=utf-8")
+	resp.Body = io.NopCloser(bytes.NewReader(raw))
+	resp.ContentLength = int64(len(raw))
+	return resp
+}
+
+func emptyResponse(req *http.Request, statusCode int) *http.Response {
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode: statusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(bytes.NewReader(nil)),
+		Request:    req,
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package armemulator
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	testResourceGroupId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+	testResourceId      = testResourceGroupId + "/providers/Microsoft.Example/widgets/first"
+)
+
+func doRequest(t *testing.T, e *Emulator, method, uri, body string) (*http.Response, map[string]interface{}) {
+	t.Helper()
+
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	if !strings.HasPrefix(uri, "https://") {
+		uri = "https://management.azure.com" + uri + "?api-version=2020-01-01"
+	}
+	req, err := http.NewRequest(method, uri, reader)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	resp, err := e.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("performing request: %+v", err)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response: %+v", err)
+	}
+
+	var out map[string]interface{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &out); err != nil {
+			t.Fatalf("unmarshaling response %q: %+v", string(raw), err)
+		}
+	}
+
+	return resp, out
+}
+
+func provisioningStateOf(body map[string]interface{}) string {
+	props, _ := body["properties"].(map[string]interface{})
+	v, _ := props["provisioningState"].(string)
+	return v
+}
+
+func TestParsePath(t *testing.T) {
+	testData := []struct {
+		input        string
+		kind         pathKind
+		parent       string
+		resourceType string
+		name         string
+	}{
+		{
+			input:        "/subscriptions/sub1",
+			kind:         pathKindResource,
+			parent:       "/",
+			resourceType: "subscriptions",
+			name:         "sub1",
+		},
+		{
+			input:        "/subscriptions/sub1/resourceGroups",
+			kind:         pathKindCollection,
+			parent:       "/subscriptions/sub1",
+			resourceType: "resourceGroups",
+		},
+		{
+			input:        "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Example/widgets/w1",
+			kind:         pathKindResource,
+			parent:       "/subscriptions/sub1/resourceGroups/rg1",
+			resourceType: "Microsoft.Example/widgets",
+			name:         "w1",
+		},
+		{
+			input:        "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Example/widgets/w1/parts/p1",
+			kind:         pathKindResource,
+			parent:       "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Example/widgets/w1",
+			resourceType: "Microsoft.Example/widgets/parts",
+			name:         "p1",
+		},
+		{
+			input:        "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Example/widgets/w1/providers/Microsoft.Authorization/locks/l1",
+			kind:         pathKindResource,
+			parent:       "/subscriptions/sub1/resourceGroups/rg1/providers/Microsoft.Example/widgets/w1",
+			resourceType: "Microsoft.Authorization/locks",
+			name:         "l1",
+		},
+		{
+			input:        "/subscriptions/sub1/providers/Microsoft.Example/widgets",
+			kind:         pathKindCollection,
+			parent:       "/subscriptions/sub1",
+			resourceType: "Microsoft.Example/widgets",
+		},
+		{
+			input:        "/subscriptions/sub1/providers/Microsoft.Example",
+			kind:         pathKindProvider,
+			parent:       "/subscriptions/sub1",
+			resourceType: "Microsoft.Example",
+			name:         "Microsoft.Example",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual := parsePath(v.input)
+		if actual.kind != v.kind {
+			t.Fatalf("expected kind %d but got %d", v.kind, actual.kind)
+		}
+		if actual.parent != v.parent {
+			t.Fatalf("expected parent %q but got %q", v.parent, actual.parent)
+		}
+		if actual.resourceType != v.resourceType {
+			t.Fatalf("expected resource type %q but got %q", v.resourceType, actual.resourceType)
+		}
+		if actual.name != v.name {
+			t.Fatalf("expected name %q but got %q", v.name, actual.name)
+		}
+	}
+}
+
+func TestEmulator_lifecycle(t *testing.T) {
+	e := New(Options{
+		PollsUntilComplete: 1,
+	})
+
+	resp, body := doRequest(t, e, http.MethodPut, testResourceId, `{"location":"westeurope"}`)
+	if resp.StatusCode != http.StatusNotFound || body["error"].(map[string]interface{})["code"] != "ResourceGroupNotFound" {
+		t.Fatalf("expected a ResourceGroupNotFound error when the Resource Group doesn't exist, got %d: %+v", resp.StatusCode, body)
+	}
+
+	resp, _ = doRequest(t, e, http.MethodPut, testResourceGroupId, `{"location":"westeurope"}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected a 201 creating the Resource Group but got %d", resp.StatusCode)
+	}
+	pollingUri := resp.Header.Get("Azure-AsyncOperation")
+	if pollingUri == "" {
+		t.Fatalf("expected an Azure-AsyncOperation header")
+	}
+	_, body = doRequest(t, e, http.MethodGet, pollingUri, "")
+	if body["status"] != "InProgress" {
+		t.Fatalf("expected the operation to be InProgress but got %+v", body)
+	}
+	_, body = doRequest(t, e, http.MethodGet, pollingUri, "")
+	if body["status"] != "Succeeded" {
+		t.Fatalf("expected the operation to be Succeeded but got %+v", body)
+	}
+
+	resp, body = doRequest(t, e, http.MethodPut, testResourceId, `{"location":"westeurope","properties":{"size":1,"mode":"A"}}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected a 201 creating the resource but got %d", resp.StatusCode)
+	}
+	if provisioningStateOf(body) != provisioningStateAccepted {
+		t.Fatalf("expected the provisioningState to be Accepted but got %q", provisioningStateOf(body))
+	}
+	doRequest(t, e, http.MethodGet, resp.Header.Get("Azure-AsyncOperation"), "")
+	doRequest(t, e, http.MethodGet, resp.Header.Get("Azure-AsyncOperation"), "")
+
+	_, body = doRequest(t, e, http.MethodGet, strings.ToUpper(testResourceId), "")
+	if provisioningStateOf(body) != provisioningStateSucceeded {
+		t.Fatalf("expected the provisioningState to be Succeeded but got %q", provisioningStateOf(body))
+	}
+	if body["id"] != testResourceId || body["name"] != "first" || body["type"] != "Microsoft.Example/widgets" {
+		t.Fatalf("unexpected id/name/type: %+v", body)
+	}
+
+	resp, body = doRequest(t, e, http.MethodPatch, testResourceId, `{"properties":{"size":2,"mode":null}}`)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 updating the resource but got %d", resp.StatusCode)
+	}
+	props := body["properties"].(map[string]interface{})
+	if props["size"] != float64(2) {
+		t.Fatalf("expected size to be updated to 2 but got %+v", props["size"])
+	}
+	if _, ok := props["mode"]; ok {
+		t.Fatalf("expected mode to be removed")
+	}
+	if body["location"] != "westeurope" {
+		t.Fatalf("expected location to be retained")
+	}
+
+	for _, collection := range []string{
+		testResourceGroupId + "/providers/Microsoft.Example/widgets",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Example/widgets",
+	} {
+		_, body = doRequest(t, e, http.MethodGet, collection, "")
+		if values := body["value"].([]interface{}); len(values) != 1 {
+			t.Fatalf("expected 1 item listing %q but got %d", collection, len(values))
+		}
+	}
+
+	resp, _ = doRequest(t, e, http.MethodDelete, testResourceGroupId, "")
+	if resp.StatusCode != http.StatusAccepted || resp.Header.Get("Location") == "" {
+		t.Fatalf("expected a 202 with a Location header deleting the Resource Group but got %d", resp.StatusCode)
+	}
+
+	// the delete operation progresses as the resource is polled
+	_, body = doRequest(t, e, http.MethodGet, testResourceGroupId, "")
+	if provisioningStateOf(body) != provisioningStateDeleting {
+		t.Fatalf("expected the provisioningState to be Deleting but got %q", provisioningStateOf(body))
+	}
+	resp, _ = doRequest(t, e, http.MethodGet, testResourceGroupId, "")
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a 404 once the Resource Group was deleted but got %d", resp.StatusCode)
+	}
+
+	resp, _ = doRequest(t, e, http.MethodGet, testResourceId, "")
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected the nested resource to be deleted with the Resource Group but got %d", resp.StatusCode)
+	}
+
+	resp, _ = doRequest(t, e, http.MethodDelete, testResourceId, "")
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected a 204 deleting a resource which doesn't exist but got %d", resp.StatusCode)
+	}
+}
+
+func TestEmulator_faults(t *testing.T) {
+	e := New(Options{})
+
+	fault := NewFault(http.MethodPut, "/resourceGroups/[^/]+$", http.StatusTooManyRequests, "TooManyRequests", "slow down")
+	fault.Times = 1
+	e.InjectFault(fault)
+
+	resp, body := doRequest(t, e, http.MethodPut, testResourceGroupId, `{"location":"westeurope"}`)
+	if resp.StatusCode != http.StatusTooManyRequests || body["error"].(map[string]interface{})["code"] != "TooManyRequests" {
+		t.Fatalf("expected the injected fault but got %d: %+v", resp.StatusCode, body)
+	}
+
+	resp, _ = doRequest(t, e, http.MethodPut, testResourceGroupId, `{"location":"westeurope"}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected the fault to only be returned once but got %d", resp.StatusCode)
+	}
+
+	e.InjectFault(&Fault{
+		Method:        http.MethodPut,
+		FailOperation: true,
+	})
+	_, body = doRequest(t, e, http.MethodPut, testResourceId, `{}`)
+	if provisioningStateOf(body) != provisioningStateFailed {
+		t.Fatalf("expected the provisioningState to be Failed but got %q", provisioningStateOf(body))
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package armemulator
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Fault describes an error which should be returned by the Emulator instead of processing a matching request,
+// allowing error handling (such as retries, throttling and failed long-running operations) to be exercised.
+type Fault struct {
+	// Method is the HTTP Method which this Fault applies to, an empty value matches all methods.
+	Method string
+
+	// PathPattern is a case-insensitive regular expression which the request path must match, a nil value
+	// matches all paths.
+	PathPattern *regexp.Regexp
+
+	// StatusCode is the HTTP Status Code which should be returned.
+	StatusCode int
+
+	// Code is the ARM error code returned in the response body, e.g. `Conflict` or `TooManyRequests`.
+	Code string
+
+	// Message is the ARM error message returned in the response body.
+	Message string
+
+	// Headers are additional headers to include in the response, e.g. `Retry-After`.
+	Headers map[string]string
+
+	// Times is the number of times this Fault should be returned before matching requests are processed as
+	// normal, a value of 0 returns this Fault indefinitely.
+	Times int
+
+	// FailOperation specifies that rather than failing the request itself, the long-running operation started
+	// by the matching request should report a `Failed` status once it completes.
+	FailOperation bool
+
+	hits int
+}
+
+func (f *Fault) matches(req *http.Request) bool {
+	if f.Times > 0 && f.hits >= f.Times {
+		return false
+	}
+
+	if f.Method != "" && !strings.EqualFold(f.Method, req.Method) {
+		return false
+	}
+
+	if f.PathPattern != nil && !f.PathPattern.MatchString(req.URL.Path) {
+		return false
+	}
+
+	return true
+}
+
+// NewFault returns a Fault matching requests using the specified method to any path matching the case-insensitive
+// regular expression `pathPattern`.
+func NewFault(method, pathPattern string, statusCode int, code, message string) *Fault {
+	return &Fault{
+		Method:      method,
+		PathPattern: regexp.MustCompile("(?i)" + pathPattern),
+		StatusCode:  statusCode,
+		Code:        code,
+		Message:     message,
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package armemulator
+
+import (
+	"strings"
+)
+
+type pathKind int
+
+const (
+	// pathKindResource is a path to a single resource, e.g. `/subscriptions/{id}/resourceGroups/{name}`
+	pathKindResource pathKind = iota
+
+	// pathKindCollection is a path to a collection of resources, e.g. `/subscriptions/{id}/resourceGroups`
+	pathKindCollection
+
+	// pathKindProvider is a path to a Resource Provider, e.g. `/subscriptions/{id}/providers/Microsoft.Compute`
+	pathKindProvider
+)
+
+// resourcePath is a parsed Resource Manager path, which may be a single resource, a collection of resources or a
+// Resource Provider.
+type resourcePath struct {
+	// raw is the path as it was requested, without a trailing slash
+	raw string
+
+	kind pathKind
+
+	// parent is the path of the resource this path is nested beneath - for top-level resources this is the Resource
+	// Group (or Subscription), for nested and extension resources this is the resource they're nested beneath.
+	parent string
+
+	// resourceType is the fully qualified type of the resource (or collection), e.g. `Microsoft.Compute/virtualMachines`
+	resourceType string
+
+	// name is the name of the resource, this is empty for collections and Resource Providers
+	name string
+}
+
+// parsePath splits a Resource Manager path into type/name pairs, following the same structure as the Resource IDs
+// defined using `resourceids` - where a `providers/{namespace}` pair switches the Resource Provider namespace which
+// subsequent segments belong to.
+func parsePath(input string) resourcePath {
+	raw := "/" + strings.Trim(input, "/")
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	if len(segments) == 1 && segments[0] == "" {
+		segments = nil
+	}
+
+	out := resourcePath{
+		raw:  raw,
+		kind: pathKindResource,
+	}
+
+	namespace := ""
+	types := make([]string, 0)
+	parentEnd := 0
+	for i := 0; i < len(segments); {
+		segment := segments[i]
+
+		if strings.EqualFold(segment, "providers") {
+			if i+1 >= len(segments) {
+				// e.g. `/subscriptions/{id}/providers`
+				out.kind = pathKindCollection
+				out.name = ""
+				out.resourceType = "Microsoft.Resources/providers"
+				out.parent = joinSegments(segments[:i])
+				return out
+			}
+			namespace = segments[i+1]
+			types = make([]string, 0)
+			if i+2 >= len(segments) {
+				out.kind = pathKindProvider
+				out.resourceType = namespace
+				out.name = namespace
+				out.parent = joinSegments(segments[:i])
+				return out
+			}
+			i += 2
+			continue
+		}
+
+		// outside of a Resource Provider (e.g. `subscriptions` and `resourceGroups`) types aren't nested
+		if namespace == "" {
+			types = []string{segment}
+		} else {
+			types = append(types, segment)
+		}
+		if i+1 >= len(segments) {
+			out.kind = pathKindCollection
+			out.name = ""
+			out.parent = joinSegments(segments[:parentEnd])
+			out.resourceType = qualifiedType(namespace, types)
+			return out
+		}
+
+		out.name = segments[i+1]
+		out.parent = joinSegments(segments[:parentEnd])
+		out.resourceType = qualifiedType(namespace, types)
+		parentEnd = i + 2
+		i += 2
+	}
+
+	return out
+}
+
+// key returns the case-insensitive key used to store this resource
+func (p resourcePath) key() string {
+	return strings.ToLower(p.raw)
+}
+
+// isSubscription returns whether this path refers to a Subscription, which is assumed to always exist
+func (p resourcePath) isSubscription() bool {
+	return p.kind == pathKindResource && strings.EqualFold(p.resourceType, "subscriptions")
+}
+
+// isResourceGroup returns whether this path refers to a Resource Group
+func (p resourcePath) isResourceGroup() bool {
+	return p.kind == pathKindResource && strings.EqualFold(p.resourceType, "resourceGroups")
+}
+
+// subscriptionScopedCollection returns the Subscription-level collection which a resource within a Resource Group
+// is also listed within, e.g. `/subscriptions/{id}/providers/Microsoft.Compute/virtualMachines` - or an empty
+// string when the resource isn't a top-level resource within a Resource Group.
+func (p resourcePath) subscriptionScopedCollection() string {
+	segments := strings.Split(strings.Trim(p.raw, "/"), "/")
+	if len(segments) != 8 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "resourceGroups") || !strings.EqualFold(segments[4], "providers") {
+		return ""
+	}
+
+	return joinSegments([]string{segments[0], segments[1], segments[4], segments[5], segments[6]})
+}
+
+func joinSegments(segments []string) string {
+	return "/" + strings.Join(segments, "/")
+}
+
+func qualifiedType(namespace string, types []string) string {
+	if namespace == "" {
+		return strings.Join(types, "/")
+	}
+
+	return namespace + "/" + strings.Join(types, "/")
+}
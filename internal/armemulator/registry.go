@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package armemulator
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	emulators = make(map[string]*Emulator)
+	mu        sync.Mutex
+)
+
+// GetEmulator returns the shared Emulator for a given test name, initialising it using the DefaultOptions if
+// necessary. Sharing the Emulator between the Provider and the acceptance test helpers means that resources created
+// by the Provider can be checked (and removed, e.g. within a `DisappearsStep`) by the test itself.
+func GetEmulator(testName string) (*Emulator, error) {
+	if testName == "" {
+		return nil, errors.New("testName must be provided to retrieve an emulator")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if e, ok := emulators[testName]; ok {
+		return e, nil
+	}
+
+	e := New(DefaultOptions())
+	emulators[testName] = e
+
+	return e, nil
+}
+
+// StopEmulator discards the shared Emulator for a given test name, along with any resources stored within it.
+func StopEmulator(testName string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	delete(emulators, testName)
+}
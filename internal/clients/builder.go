@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"time"
 
@@ -26,6 +27,8 @@ type ClientBuilder struct {
 	AuthConfig *auth.Credentials
 	Features   features.UserFeatures
 
+	BatchResourceManagerReads   bool
+	CacheResourceManagerReads   bool
 	CustomCorrelationRequestID  string
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
@@ -36,8 +39,18 @@ type ClientBuilder struct {
 	SubscriptionID              string
 	TerraformVersion            string
 	TestName                    string
+
+	// Authorizer and Transport allow the acceptance tests to send requests to an alternate implementation of Resource
+	// Manager (such as the ARM Emulator). When Authorizer is set it's used for every API, and the account details are
+	// taken from the AuthConfig and SubscriptionID rather than being retrieved.
+	Authorizer auth.Authorizer
+	Transport  http.RoundTripper
 }
 
+// ClientBuilderOption customises the ClientBuilder before the Client is built, allowing the acceptance tests to
+// configure test-only behaviour without it being part of the Provider
+type ClientBuilderOption func(builder *ClientBuilder)
+
 const azureStackEnvironmentError = `
 The AzureRM Provider supports the different Azure Public Clouds - including China, Public,
 and US Government - however it does not support Azure Stack due to differences in API and
@@ -51,25 +64,32 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, errors.New(azureStackEnvironmentError)
 	}
 
+	newAuthorizer := auth.NewAuthorizerFromCredentials
+	if builder.Authorizer != nil {
+		newAuthorizer = func(_ context.Context, _ auth.Credentials, _ environments.Api) (auth.Authorizer, error) {
+			return builder.Authorizer, nil
+		}
+	}
+
 	var resourceManagerAuth, storageAuth, synapseAuth, batchManagementAuth, keyVaultAuth auth.Authorizer
 
-	resourceManagerAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
+	resourceManagerAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Resource Manager API: %+v", err)
 	}
 
-	storageAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
+	storageAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 	}
 
-	keyVaultAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
+	keyVaultAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Key Vault API: %+v", err)
 	}
 
 	if builder.AuthConfig.Environment.Synapse.Available() {
-		synapseAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
+		synapseAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Synapse API: %+v", err)
 		}
@@ -78,7 +98,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if builder.AuthConfig.Environment.Batch.Available() {
-		batchManagementAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
+		batchManagementAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Batch Management API: %+v", err)
 		}
@@ -88,7 +108,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// Helper for obtaining endpoint-specific tokens
 	authorizerFunc := common.ApiAuthorizerFunc(func(api environments.Api) (auth.Authorizer, error) {
-		authorizer, err := auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+		authorizer, err := newAuthorizer(ctx, *builder.AuthConfig, api)
 		if err != nil {
 			return nil, fmt.Errorf("building custom authorizer for API %q: %+v", api.Name(), err)
 		}
@@ -96,14 +116,26 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return authorizer, nil
 	})
 
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
-	if err != nil {
-		return nil, fmt.Errorf("building account: %+v", err)
+	var account *ResourceManagerAccount
+	if builder.Authorizer != nil {
+		account = &ResourceManagerAccount{
+			Environment:                      builder.AuthConfig.Environment,
+			ClientId:                         builder.AuthConfig.ClientID,
+			SubscriptionId:                   builder.SubscriptionID,
+			TenantId:                         builder.AuthConfig.TenantID,
+			AuthenticatedAsAServicePrincipal: true,
+			RegisteredResourceProviders:      builder.RegisteredResourceProviders,
+		}
+	} else {
+		account, err = NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
+		if err != nil {
+			return nil, fmt.Errorf("building account: %+v", err)
+		}
 	}
 
 	var managedHSMAuth auth.Authorizer
 	if builder.AuthConfig.Environment.ManagedHSM.Available() {
-		managedHSMAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM)
+		managedHSMAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Managed HSM API: %+v", err)
 		}
@@ -167,6 +199,37 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		}
 	}
 
+	if builder.Transport != nil {
+		o.Transport = builder.Transport
+	}
+
+	// the VCR recorder and a custom Transport need to observe each request individually
+	customTransport := o.Transport != nil
+
+	if builder.BatchResourceManagerReads {
+		if customTransport {
+			log.Printf("[DEBUG] Skipping batching Resource Manager reads since a custom transport is configured")
+		} else {
+			transport, err := common.NewResourceManagerBatchTransport(*resourceManagerEndpoint, nil)
+			if err != nil {
+				return nil, fmt.Errorf("building Resource Manager batch transport: %+v", err)
+			}
+			o.Transport = transport
+		}
+	}
+
+	if builder.CacheResourceManagerReads {
+		if customTransport {
+			log.Printf("[DEBUG] Skipping caching Resource Manager reads since a custom transport is configured")
+		} else {
+			cache, err := common.NewResponseCache(*resourceManagerEndpoint)
+			if err != nil {
+				return nil, fmt.Errorf("building Resource Manager response cache: %+v", err)
+			}
+			o.ResponseCache = cache
+		}
+	}
+
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
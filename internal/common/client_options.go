@@ -4,10 +4,15 @@
 package common
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
+	"runtime"
 	"strings"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/sender"
@@ -64,6 +69,10 @@ type ClientOptions struct {
 	// Transport exposes the go-azure-sdk mechanism to attach / replace the default transport. Primarily for go-vcr
 	// testing
 	Transport http.RoundTripper
+
+	// ResponseCache, when specified, caches the responses to GET requests made to Resource Manager for the lifetime
+	// of the provider
+	ResponseCache *ResponseCache
 }
 
 // Configure set up a resourcemanager.Client using an auth.Authorizer from hashicorp/go-azure-sdk
@@ -71,7 +80,9 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 	c.SetAuthorizer(authorizer)
 	c.SetUserAgent(userAgent(c.GetUserAgent(), o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID))
 
-	if o.Transport != nil {
+	if o.ResponseCache != nil {
+		c.SetTransport(o.ResponseCache.Transport(o.Transport))
+	} else if o.Transport != nil {
 		c.SetTransport(o.Transport)
 	}
 
@@ -93,6 +104,9 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
+	if o.ResponseCache != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, o.ResponseCache.SendDecorator())
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
@@ -103,6 +117,27 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	}
 }
 
+// defaultTransport returns a http.RoundTripper matching the transport used by `hashicorp/go-azure-sdk` when one isn't
+// specified, for use when wrapping the transport
+func defaultTransport() http.RoundTripper {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			d := &net.Dialer{Resolver: &net.Resolver{}}
+			return d.DialContext(ctx, network, addr)
+		},
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		},
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConnsPerHost:   runtime.GOMAXPROCS(0) + 1,
+	}
+}
+
 func userAgent(userAgent, tfVersion, partnerID string, disableTerraformPartnerID bool) string {
 	tfUserAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io)", tfVersion)
 
@@ -0,0 +1,390 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// resourceManagerBatchApiVersion is the API Version of the Resource Manager `$batch` endpoint
+	resourceManagerBatchApiVersion = "2020-06-01"
+
+	// resourceManagerBatchMaxRequests is the maximum number of requests ARM accepts within a single batch
+	resourceManagerBatchMaxRequests = 20
+
+	// resourceManagerBatchWindow is how long a GET request waits for other GET requests to be batched with, when
+	// other GET requests are already in progress
+	resourceManagerBatchWindow = 25 * time.Millisecond
+
+	// resourceManagerBatchTimeout is the maximum duration a batch (including polling for its completion) can take
+	resourceManagerBatchTimeout = 5 * time.Minute
+)
+
+var _ http.RoundTripper = &ResourceManagerBatchTransport{}
+
+// ResourceManagerBatchTransport is a http.RoundTripper which coalesces concurrent GET requests to Resource Manager into
+// requests to the ARM `$batch` endpoint, to reduce the number of requests made (and so the likelihood of being
+// throttled) when refreshing a large number of resources. The responses are returned to each caller as if the request
+// had been made individually.
+//
+// Requests to other endpoints (e.g. data-plane APIs), and requests other than GET, are sent individually - as are GET
+// requests made whilst no other GET request is in progress, since there's nothing to batch these with.
+type ResourceManagerBatchTransport struct {
+	endpoint *url.URL
+	inner    http.RoundTripper
+
+	lock sync.Mutex
+
+	// inProgress is the number of GET requests which are either waiting to be batched or being sent
+	inProgress int
+
+	// pending are the batches which are waiting to be sent, keyed by the headers of the requests (see
+	// resourceManagerBatchKey) since every request within a batch is made using the headers of the batch request
+	pending map[string]*resourceManagerBatch
+}
+
+type resourceManagerBatch struct {
+	requests []*resourceManagerBatchRequest
+	timer    *time.Timer
+}
+
+type resourceManagerBatchRequest struct {
+	request  *http.Request
+	response chan resourceManagerBatchResult
+}
+
+type resourceManagerBatchResult struct {
+	response *http.Response
+	err      error
+}
+
+// NewResourceManagerBatchTransport returns a ResourceManagerBatchTransport which batches GET requests to `endpoint`,
+// sending requests using `inner` - or a default transport when `inner` is nil.
+func NewResourceManagerBatchTransport(endpoint string, inner http.RoundTripper) (*ResourceManagerBatchTransport, error) {
+	uri, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Resource Manager endpoint %q: %+v", endpoint, err)
+	}
+
+	if inner == nil {
+		inner = defaultTransport()
+	}
+
+	return &ResourceManagerBatchTransport{
+		endpoint: uri,
+		inner:    inner,
+		pending:  make(map[string]*resourceManagerBatch),
+	}, nil
+}
+
+func (t *ResourceManagerBatchTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.canBatch(req) {
+		return t.inner.RoundTrip(req)
+	}
+
+	t.lock.Lock()
+	t.inProgress++
+	alone := t.inProgress == 1
+	t.lock.Unlock()
+
+	defer func() {
+		t.lock.Lock()
+		t.inProgress--
+		t.lock.Unlock()
+	}()
+
+	// waiting for other requests to batch with would only delay this request
+	if alone {
+		return t.inner.RoundTrip(req)
+	}
+
+	item := &resourceManagerBatchRequest{
+		request:  req,
+		response: make(chan resourceManagerBatchResult, 1),
+	}
+	t.enqueue(item)
+
+	select {
+	case result := <-item.response:
+		return result.response, result.err
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+}
+
+// canBatch returns whether `req` is a GET request to Resource Manager which can be included within a batch
+func (t *ResourceManagerBatchTransport) canBatch(req *http.Request) bool {
+	if req.Method != http.MethodGet || req.URL == nil || !strings.EqualFold(req.URL.Host, t.endpoint.Host) {
+		return false
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		return false
+	}
+
+	// conditional requests and the batch endpoint itself can't be batched
+	if req.Header.Get("If-Match") != "" || req.Header.Get("If-None-Match") != "" {
+		return false
+	}
+
+	return !strings.EqualFold(strings.TrimSuffix(req.URL.Path, "/"), "/batch")
+}
+
+// resourceManagerBatchKey returns the key of the batch `req` can be included within. Since the requests within a batch
+// are made using the headers of the batch request, only requests with identical headers are batched together.
+func resourceManagerBatchKey(req *http.Request) string {
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	key := strings.Builder{}
+	for _, name := range names {
+		for _, value := range req.Header[name] {
+			key.WriteString(name)
+			key.WriteString(": ")
+			key.WriteString(value)
+			key.WriteString("\n")
+		}
+	}
+
+	return key.String()
+}
+
+func (t *ResourceManagerBatchTransport) enqueue(item *resourceManagerBatchRequest) {
+	key := resourceManagerBatchKey(item.request)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	batch, ok := t.pending[key]
+	if !ok {
+		batch = &resourceManagerBatch{}
+		batch.timer = time.AfterFunc(resourceManagerBatchWindow, func() {
+			t.flush(key, batch)
+		})
+		t.pending[key] = batch
+	}
+
+	batch.requests = append(batch.requests, item)
+	if len(batch.requests) >= resourceManagerBatchMaxRequests {
+		batch.timer.Stop()
+		delete(t.pending, key)
+		go t.send(batch.requests)
+	}
+}
+
+func (t *ResourceManagerBatchTransport) flush(key string, batch *resourceManagerBatch) {
+	t.lock.Lock()
+	if t.pending[key] != batch {
+		// this batch has already been sent since it reached the maximum size
+		t.lock.Unlock()
+		return
+	}
+	delete(t.pending, key)
+	t.lock.Unlock()
+
+	t.send(batch.requests)
+}
+
+type resourceManagerBatchRequestBody struct {
+	Requests []resourceManagerBatchRequestItem `json:"requests"`
+}
+
+type resourceManagerBatchRequestItem struct {
+	HttpMethod string `json:"httpMethod"`
+	Name       string `json:"name"`
+	Url        string `json:"url"`
+}
+
+type resourceManagerBatchResponseBody struct {
+	Responses []resourceManagerBatchResponseItem `json:"responses"`
+}
+
+type resourceManagerBatchResponseItem struct {
+	Name           string            `json:"name"`
+	HttpStatusCode int               `json:"httpStatusCode"`
+	Headers        map[string]string `json:"headers"`
+	Content        json.RawMessage   `json:"content"`
+}
+
+// send performs the batch request for `items`, falling back to individual requests when the batch can't be performed
+func (t *ResourceManagerBatchTransport) send(items []*resourceManagerBatchRequest) {
+	if len(items) == 1 {
+		t.sendIndividually(items)
+		return
+	}
+
+	responses, err := t.performBatch(items)
+	if err != nil {
+		log.Printf("[DEBUG] performing Resource Manager batch of %d requests, falling back to individual requests: %+v", len(items), err)
+		t.sendIndividually(items)
+		return
+	}
+
+	remaining := make([]*resourceManagerBatchRequest, 0)
+	for i, item := range items {
+		response, ok := responses[strconv.Itoa(i)]
+		if !ok {
+			remaining = append(remaining, item)
+			continue
+		}
+
+		item.response <- resourceManagerBatchResult{
+			response: response.toHttpResponse(item.request),
+		}
+	}
+
+	if len(remaining) > 0 {
+		log.Printf("[DEBUG] the Resource Manager batch response did not contain %d of %d responses, sending these individually", len(remaining), len(items))
+		t.sendIndividually(remaining)
+	}
+}
+
+func (t *ResourceManagerBatchTransport) sendIndividually(items []*resourceManagerBatchRequest) {
+	for _, item := range items {
+		go func(item *resourceManagerBatchRequest) {
+			resp, err := t.inner.RoundTrip(item.request)
+			item.response <- resourceManagerBatchResult{
+				response: resp,
+				err:      err,
+			}
+		}(item)
+	}
+}
+
+// performBatch sends the batch request and returns the responses keyed by the name of each request (its index)
+func (t *ResourceManagerBatchTransport) performBatch(items []*resourceManagerBatchRequest) (map[string]resourceManagerBatchResponseItem, error) {
+	// the batch is performed on behalf of multiple callers, so can't be tied to the context of any one of them
+	ctx, cancel := context.WithTimeout(context.Background(), resourceManagerBatchTimeout)
+	defer cancel()
+
+	payload := resourceManagerBatchRequestBody{
+		Requests: make([]resourceManagerBatchRequestItem, 0, len(items)),
+	}
+	for i, item := range items {
+		payload.Requests = append(payload.Requests, resourceManagerBatchRequestItem{
+			HttpMethod: http.MethodGet,
+			Name:       strconv.Itoa(i),
+			Url:        item.request.URL.String(),
+		})
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling batch request: %+v", err)
+	}
+
+	uri := *t.endpoint
+	uri.Path = "/batch"
+	uri.RawQuery = url.Values{"api-version": []string{resourceManagerBatchApiVersion}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("building batch request: %+v", err)
+	}
+	copyBatchHeaders(req, items[0].request)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := t.inner.RoundTrip(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending batch request: %+v", err)
+	}
+
+	out := make(map[string]resourceManagerBatchResponseItem)
+	for {
+		switch resp.StatusCode {
+		case http.StatusOK, http.StatusAccepted:
+		default:
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected status %d for batch request", resp.StatusCode)
+		}
+
+		var result resourceManagerBatchResponseBody
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("parsing batch response: %+v", err)
+		}
+		for _, item := range result.Responses {
+			out[item.Name] = item
+		}
+
+		// when not all of the requests completed within the batch request, the remainder must be polled for
+		location := resp.Header.Get("Location")
+		if resp.StatusCode == http.StatusOK || location == "" {
+			return out, nil
+		}
+
+		delay := time.Second
+		if v, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && v > 0 {
+			delay = time.Duration(v) * time.Second
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for batch to complete: %+v", ctx.Err())
+		case <-time.After(delay):
+		}
+
+		pollReq, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return nil, fmt.Errorf("building batch polling request: %+v", err)
+		}
+		copyBatchHeaders(pollReq, items[0].request)
+
+		resp, err = t.inner.RoundTrip(pollReq)
+		if err != nil {
+			return nil, fmt.Errorf("polling batch: %+v", err)
+		}
+	}
+}
+
+// copyBatchHeaders copies the headers from one of the batched requests to the batch request, since only requests with
+// identical headers are batched together these are the headers of every request within the batch
+func copyBatchHeaders(req *http.Request, from *http.Request) {
+	for header, values := range from.Header {
+		req.Header[header] = append([]string(nil), values...)
+	}
+}
+
+func (r resourceManagerBatchResponseItem) toHttpResponse(req *http.Request) *http.Response {
+	header := make(http.Header)
+	for k, v := range r.Headers {
+		header.Set(k, v)
+	}
+
+	content := []byte(r.Content)
+	if len(content) == 0 || string(content) == "null" {
+		content = []byte{}
+	} else if header.Get("Content-Type") == "" {
+		header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+	header.Set("Content-Length", strconv.Itoa(len(content)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.HttpStatusCode, http.StatusText(r.HttpStatusCode)),
+		StatusCode:    r.HttpStatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(content)),
+		ContentLength: int64(len(content)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestResourceManagerBatchTransport(t *testing.T) {
+	var batchRequests, individualRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/batch" {
+			atomic.AddInt32(&batchRequests, 1)
+			if r.Header.Get("Authorization") != "Bearer token" {
+				t.Errorf("expected the batch request to use the Authorization header of the batched requests")
+			}
+
+			var body resourceManagerBatchRequestBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decoding batch request: %+v", err)
+			}
+			if len(body.Requests) > resourceManagerBatchMaxRequests {
+				t.Errorf("expected at most %d requests in a batch but got %d", resourceManagerBatchMaxRequests, len(body.Requests))
+			}
+
+			responses := make([]map[string]interface{}, 0)
+			for _, item := range body.Requests {
+				uri, _ := url.Parse(item.Url)
+				if uri.Path == "/missing" {
+					responses = append(responses, map[string]interface{}{
+						"name":           item.Name,
+						"httpStatusCode": http.StatusNotFound,
+						"content":        map[string]interface{}{"error": map[string]string{"code": "ResourceNotFound"}},
+					})
+					continue
+				}
+
+				responses = append(responses, map[string]interface{}{
+					"name":           item.Name,
+					"httpStatusCode": http.StatusOK,
+					"headers":        map[string]string{"x-ms-request-id": item.Name},
+					"content":        map[string]string{"id": uri.Path},
+				})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"responses": responses})
+			return
+		}
+
+		atomic.AddInt32(&individualRequests, 1)
+		// the first request is sent individually, so ensure it's still in progress when the others are made
+		time.Sleep(50 * time.Millisecond)
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": r.URL.Path})
+	}))
+	defer server.Close()
+
+	transport, err := NewResourceManagerBatchTransport(server.URL, server.Client().Transport)
+	if err != nil {
+		t.Fatalf("building transport: %+v", err)
+	}
+	client := &http.Client{Transport: transport}
+
+	requests := 45
+	wg := sync.WaitGroup{}
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			path := fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg%d", i)
+			expectedStatus := http.StatusOK
+			if i == 0 {
+				path = "/missing"
+				expectedStatus = http.StatusNotFound
+			}
+
+			req, _ := http.NewRequest(http.MethodGet, server.URL+path+"?api-version=2020-06-01", nil)
+			req.Header.Set("Authorization", "Bearer token")
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Errorf("performing request %d: %+v", i, err)
+				return
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != expectedStatus {
+				t.Errorf("expected status %d for request %d but got %d", expectedStatus, i, resp.StatusCode)
+				return
+			}
+			if expectedStatus != http.StatusOK {
+				return
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			var model map[string]string
+			if err := json.Unmarshal(body, &model); err != nil {
+				t.Errorf("decoding response %d: %+v", i, err)
+				return
+			}
+			if model["id"] != path {
+				t.Errorf("expected the response for %q but got %q", path, model["id"])
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if batchRequests == 0 {
+		t.Fatalf("expected the requests to be batched")
+	}
+	if total := int(batchRequests) + int(individualRequests); total >= requests {
+		t.Fatalf("expected fewer than %d requests to be made but got %d", requests, total)
+	}
+
+	// requests other than GETs, and to other endpoints, are sent individually
+	before := atomic.LoadInt32(&individualRequests)
+	req, _ := http.NewRequest(http.MethodPut, server.URL+"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg?api-version=2020-06-01", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("performing PUT request: %+v", err)
+	}
+	resp.Body.Close()
+	if atomic.LoadInt32(&individualRequests) != before+1 {
+		t.Fatalf("expected the PUT request to be sent individually")
+	}
+}
+
+func TestResourceManagerBatchTransportFallsBackWhenBatchFails(t *testing.T) {
+	var individualRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/batch" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		atomic.AddInt32(&individualRequests, 1)
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": r.URL.Path})
+	}))
+	defer server.Close()
+
+	transport, err := NewResourceManagerBatchTransport(server.URL, server.Client().Transport)
+	if err != nil {
+		t.Fatalf("building transport: %+v", err)
+	}
+	client := &http.Client{Transport: transport}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			resp, err := client.Get(fmt.Sprintf("%s/resource%d", server.URL, i))
+			if err != nil {
+				t.Errorf("performing request %d: %+v", i, err)
+				return
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("expected status 200 for request %d but got %d", i, resp.StatusCode)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if individualRequests != 5 {
+		t.Fatalf("expected 5 individual requests but got %d", individualRequests)
+	}
+}
+
+func TestResourceManagerBatchTransportSendsSingleRequestsImmediately(t *testing.T) {
+	var batchRequests, individualRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/batch" {
+			atomic.AddInt32(&batchRequests, 1)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		atomic.AddInt32(&individualRequests, 1)
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": r.URL.Path})
+	}))
+	defer server.Close()
+
+	transport, err := NewResourceManagerBatchTransport(server.URL, server.Client().Transport)
+	if err != nil {
+		t.Fatalf("building transport: %+v", err)
+	}
+	client := &http.Client{Transport: transport}
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(fmt.Sprintf("%s/resource%d", server.URL, i))
+		if err != nil {
+			t.Fatalf("performing request %d: %+v", i, err)
+		}
+		resp.Body.Close()
+	}
+
+	if batchRequests != 0 {
+		t.Fatalf("expected sequential requests not to be batched but got %d batch requests", batchRequests)
+	}
+	if individualRequests != 3 {
+		t.Fatalf("expected 3 individual requests but got %d", individualRequests)
+	}
+
+	transport.lock.Lock()
+	defer transport.lock.Unlock()
+	if len(transport.pending) != 0 || transport.inProgress != 0 {
+		t.Fatalf("expected no requests to be in progress but got %d in progress and %d pending batches", transport.inProgress, len(transport.pending))
+	}
+}
+
+func TestResourceManagerBatchTransportOnlyBatchesMatchingHeaders(t *testing.T) {
+	var batchRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/batch" {
+			atomic.AddInt32(&batchRequests, 1)
+
+			var body resourceManagerBatchRequestBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decoding batch request: %+v", err)
+			}
+
+			// each request is made to a path containing the token it was made with
+			token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			responses := make([]map[string]interface{}, 0)
+			for _, item := range body.Requests {
+				uri, _ := url.Parse(item.Url)
+				if !strings.HasPrefix(uri.Path, "/"+token+"/") {
+					t.Errorf("expected only requests made using %q to be batched together but got %q", token, uri.Path)
+				}
+
+				responses = append(responses, map[string]interface{}{
+					"name":           item.Name,
+					"httpStatusCode": http.StatusOK,
+					"content":        map[string]string{"id": uri.Path},
+				})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"responses": responses})
+			return
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": r.URL.Path})
+	}))
+	defer server.Close()
+
+	transport, err := NewResourceManagerBatchTransport(server.URL, server.Client().Transport)
+	if err != nil {
+		t.Fatalf("building transport: %+v", err)
+	}
+	client := &http.Client{Transport: transport}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			token := "first"
+			if i%2 == 0 {
+				token = "second"
+			}
+
+			req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s/resource%d", server.URL, token, i), nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Errorf("performing request %d: %+v", i, err)
+				return
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("expected status 200 for request %d but got %d", i, resp.StatusCode)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if batchRequests == 0 {
+		t.Fatalf("expected the requests to be batched")
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// ResponseCache caches the responses to GET requests made to Resource Manager for the lifetime of the provider (i.e.
+// a single plan or apply), allowing resources which read the same parent resource (for example Subnets reading their
+// Virtual Network) to share the response, rather than each re-reading it.
+//
+// Responses are keyed by their normalised URL (including the API Version), and any write (any request other than a GET)
+// to a resource invalidates the cached responses for that resource, its parents and any nested resources. Once written
+// to, responses for these resources are no longer cached - since they're typically then polled (for example waiting
+// for a change to become consistent, or for the resource to be deleted) and each poll must retrieve the resource.
+type ResponseCache struct {
+	endpoint *url.URL
+
+	lock    sync.RWMutex
+	entries map[string]responseCacheEntry
+
+	// written contains the normalised paths of the resources which have been written to
+	written map[string]struct{}
+}
+
+type responseCacheEntry struct {
+	// resourceId is the normalised path of the resource, used to invalidate this entry
+	resourceId string
+
+	statusCode int
+	status     string
+	header     http.Header
+	body       []byte
+}
+
+// NewResponseCache returns a ResponseCache for the Resource Manager `endpoint`.
+func NewResponseCache(endpoint string) (*ResponseCache, error) {
+	uri, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Resource Manager endpoint %q: %+v", endpoint, err)
+	}
+
+	return &ResponseCache{
+		endpoint: uri,
+		entries:  make(map[string]responseCacheEntry),
+		written:  make(map[string]struct{}),
+	}, nil
+}
+
+// Transport returns a http.RoundTripper which caches responses, sending requests using `inner` - or a default
+// transport when `inner` is nil.
+func (c *ResponseCache) Transport(inner http.RoundTripper) http.RoundTripper {
+	if inner == nil {
+		inner = defaultTransport()
+	}
+
+	return responseCacheTransport{
+		cache: c,
+		inner: inner,
+	}
+}
+
+// SendDecorator returns an autorest.SendDecorator which caches responses, for use with `go-autorest` clients.
+func (c *ResponseCache) SendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return c.do(r, s.Do)
+		})
+	}
+}
+
+type responseCacheTransport struct {
+	cache *ResponseCache
+	inner http.RoundTripper
+}
+
+func (t responseCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.cache.do(req, t.inner.RoundTrip)
+}
+
+func (c *ResponseCache) do(req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if req.URL == nil || !strings.EqualFold(req.URL.Host, c.endpoint.Host) {
+		return send(req)
+	}
+
+	resourceId := normaliseResourcePath(req.URL.Path)
+
+	if req.Method != http.MethodGet {
+		// invalidate both before and after the write, since a concurrent read could otherwise cache the old response
+		c.invalidate(resourceId)
+		resp, err := send(req)
+		c.invalidate(resourceId)
+		return resp, err
+	}
+
+	if c.wasWritten(resourceId) {
+		return send(req)
+	}
+
+	key := responseCacheKey(req.URL)
+	c.lock.RLock()
+	entry, ok := c.entries[key]
+	c.lock.RUnlock()
+	if ok {
+		log.Printf("[DEBUG] Using cached response for GET %s", req.URL)
+		return entry.toHttpResponse(req), nil
+	}
+
+	resp, err := send(req)
+	if err != nil || resp == nil || resp.StatusCode != http.StatusOK || !isCacheablePath(resourceId) {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %+v", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if isTerminalResponse(body) {
+		c.lock.Lock()
+		c.entries[key] = responseCacheEntry{
+			resourceId: resourceId,
+			statusCode: resp.StatusCode,
+			status:     resp.Status,
+			header:     resp.Header.Clone(),
+			body:       body,
+		}
+		c.lock.Unlock()
+	}
+
+	return resp, nil
+}
+
+// invalidate removes the cached responses for `resourceId`, the resources it's nested within and the resources
+// nested within it - since (for example) the response for a Virtual Network includes its Subnets - and records that
+// `resourceId` has been written to
+func (c *ResponseCache) invalidate(resourceId string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.written[resourceId] = struct{}{}
+	for key, entry := range c.entries {
+		if resourcePathsRelated(entry.resourceId, resourceId) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// wasWritten returns whether `resourceId`, a resource it's nested within or a resource nested within it has been
+// written to
+func (c *ResponseCache) wasWritten(resourceId string) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	for written := range c.written {
+		if resourcePathsRelated(written, resourceId) {
+			return true
+		}
+	}
+	return false
+}
+
+func resourcePathsRelated(first, second string) bool {
+	return first == second || strings.HasPrefix(first, second+"/") || strings.HasPrefix(second, first+"/")
+}
+
+func (e responseCacheEntry) toHttpResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        e.status,
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+func normaliseResourcePath(input string) string {
+	return strings.ToLower(strings.TrimSuffix(input, "/"))
+}
+
+// responseCacheKey returns the normalised URL for `uri` - where the path is case-insensitive (as Resource IDs are) and
+// the query string (including the API Version) is sorted
+func responseCacheKey(uri *url.URL) string {
+	query := uri.Query()
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range query[k] {
+			values = append(values, fmt.Sprintf("%s=%s", strings.ToLower(k), v))
+		}
+	}
+
+	return fmt.Sprintf("%s%s?%s", strings.ToLower(uri.Host), normaliseResourcePath(uri.Path), strings.Join(values, "&"))
+}
+
+// isCacheablePath returns whether responses for the path can be cached - the status of long-running operations must
+// always be retrieved
+func isCacheablePath(resourceId string) bool {
+	for _, segment := range []string{"/operations/", "/operationresults/", "/operationstatuses/", "/asyncoperations/", "/operationstatus/"} {
+		if strings.Contains(resourceId+"/", segment) {
+			return false
+		}
+	}
+	return true
+}
+
+// isTerminalResponse returns whether the resource within the response `body` is in a terminal provisioning state, since
+// resources which are still being provisioned are polled until they're complete
+func isTerminalResponse(body []byte) bool {
+	var model struct {
+		Properties struct {
+			ProvisioningState *string `json:"provisioningState"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(body, &model); err != nil || model.Properties.ProvisioningState == nil {
+		return true
+	}
+
+	switch strings.ToLower(*model.Properties.ProvisioningState) {
+	case "succeeded", "failed", "canceled", "cancelled":
+		return true
+	}
+	return false
+}
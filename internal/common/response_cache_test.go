@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	testVirtualNetworkPath = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet"
+	testSubnetPath         = testVirtualNetworkPath + "/subnets/subnet"
+)
+
+type responseCacheTestServer struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	requests map[string]int
+
+	// provisioningState is returned for the Virtual Network
+	provisioningState string
+}
+
+func newResponseCacheTestServer() *responseCacheTestServer {
+	s := &responseCacheTestServer{
+		requests:          make(map[string]int),
+		provisioningState: "Succeeded",
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		s.requests[fmt.Sprintf("%s %s", r.Method, r.URL.Path)]++
+		_, _ = fmt.Fprintf(w, `{"id":%q,"properties":{"provisioningState":%q}}`, r.URL.Path, s.provisioningState)
+	}))
+	return s
+}
+
+func (s *responseCacheTestServer) count(method, path string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.requests[fmt.Sprintf("%s %s", method, path)]
+}
+
+func TestResponseCache(t *testing.T) {
+	server := newResponseCacheTestServer()
+	defer server.Close()
+
+	cache, err := NewResponseCache(server.URL)
+	if err != nil {
+		t.Fatalf("building cache: %+v", err)
+	}
+	client := &http.Client{Transport: cache.Transport(server.Client().Transport)}
+
+	get := func(path, apiVersion string) string {
+		resp, err := client.Get(fmt.Sprintf("%s%s?api-version=%s", server.URL, path, apiVersion))
+		if err != nil {
+			t.Fatalf("performing GET: %+v", err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("reading body: %+v", err)
+		}
+		return string(body)
+	}
+	put := func(path string) {
+		req, _ := http.NewRequest(http.MethodPut, fmt.Sprintf("%s%s?api-version=2024-05-01", server.URL, path), nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("performing PUT: %+v", err)
+		}
+		resp.Body.Close()
+	}
+
+	first := get(testVirtualNetworkPath, "2024-05-01")
+	// the path of a Resource ID is case-insensitive
+	second := get(strings.ToUpper(testVirtualNetworkPath), "2024-05-01")
+	if first != second {
+		t.Fatalf("expected the cached response %q but got %q", first, second)
+	}
+	if count := server.count(http.MethodGet, testVirtualNetworkPath); count != 1 {
+		t.Fatalf("expected 1 request for the Virtual Network but got %d", count)
+	}
+
+	// a different API Version is cached separately
+	get(testVirtualNetworkPath, "2023-09-01")
+	if count := server.count(http.MethodGet, testVirtualNetworkPath); count != 2 {
+		t.Fatalf("expected 2 requests for the Virtual Network but got %d", count)
+	}
+
+	// writing to a nested resource invalidates the parent
+	put(testSubnetPath)
+	get(testVirtualNetworkPath, "2024-05-01")
+	if count := server.count(http.MethodGet, testVirtualNetworkPath); count != 3 {
+		t.Fatalf("expected 3 requests for the Virtual Network but got %d", count)
+	}
+
+	// resources which aren't in a terminal provisioning state aren't cached
+	server.lock.Lock()
+	server.provisioningState = "Updating"
+	server.lock.Unlock()
+	put(testVirtualNetworkPath)
+	get(testVirtualNetworkPath, "2024-05-01")
+	get(testVirtualNetworkPath, "2024-05-01")
+	if count := server.count(http.MethodGet, testVirtualNetworkPath); count != 5 {
+		t.Fatalf("expected 5 requests for the Virtual Network but got %d", count)
+	}
+}
+
+func TestResponseCachePollingAfterWrite(t *testing.T) {
+	const roleDefinitionPath = "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/00000000-0000-0000-0000-000000000001"
+
+	// the Role Definition (which has no provisioning state) remains visible for a few reads after being deleted
+	var lock sync.Mutex
+	readsUntilDeleted := -1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		switch {
+		case r.Method == http.MethodDelete:
+			readsUntilDeleted = 3
+		case readsUntilDeleted == 0:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case readsUntilDeleted > 0:
+			readsUntilDeleted--
+		}
+		_, _ = fmt.Fprintf(w, `{"id":%q,"properties":{"roleName":"example"}}`, r.URL.Path)
+	}))
+	defer server.Close()
+
+	cache, err := NewResponseCache(server.URL)
+	if err != nil {
+		t.Fatalf("building cache: %+v", err)
+	}
+	client := &http.Client{Transport: cache.Transport(server.Client().Transport)}
+
+	get := func() int {
+		resp, err := client.Get(fmt.Sprintf("%s%s?api-version=2022-04-01", server.URL, roleDefinitionPath))
+		if err != nil {
+			t.Fatalf("performing GET: %+v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if status := get(); status != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", status)
+	}
+
+	req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s%s?api-version=2022-04-01", server.URL, roleDefinitionPath), nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("performing DELETE: %+v", err)
+	}
+	resp.Body.Close()
+
+	// each poll after the write must reach the API, otherwise the first response would be returned until timing out
+	for i := 0; i < 5; i++ {
+		if get() == http.StatusNotFound {
+			return
+		}
+	}
+	t.Fatalf("expected polling to observe the deletion of the Role Definition")
+}
+
+func TestResponseCacheSendDecorator(t *testing.T) {
+	server := newResponseCacheTestServer()
+	defer server.Close()
+
+	cache, err := NewResponseCache(server.URL)
+	if err != nil {
+		t.Fatalf("building cache: %+v", err)
+	}
+	sender := autorest.DecorateSender(server.Client(), cache.SendDecorator())
+
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s%s?api-version=2024-05-01", server.URL, testSubnetPath), nil)
+		resp, err := sender.Do(req)
+		if err != nil {
+			t.Fatalf("performing GET: %+v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if count := server.count(http.MethodGet, testSubnetPath); count != 1 {
+		t.Fatalf("expected 1 request for the Subnet but got %d", count)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package customermanagedkeys
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	dataplane "github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+// VersionlessKeyID returns the versionless ID of the Key Vault or Managed HSM Key `input`, and whether `input` was a
+// valid Key ID
+func VersionlessKeyID(input string) (string, bool) {
+	id, err := keyvault.ParseNestedItemID(input, keyvault.VersionTypeAny, keyvault.NestedItemTypeKey)
+	if err != nil {
+		return "", false
+	}
+
+	return id.VersionlessID(), true
+}
+
+// IsSameKey returns whether the Key IDs `first` and `second` refer to the same Key, regardless of the Key Version
+func IsSameKey(first, second string) bool {
+	firstId, ok := VersionlessKeyID(first)
+	if !ok {
+		return false
+	}
+	secondId, ok := VersionlessKeyID(second)
+	if !ok {
+		return false
+	}
+
+	return strings.EqualFold(firstId, secondId)
+}
+
+// DiffSuppressVersionlessKey suppresses the diff when a versionless Key ID is specified in the configuration and the
+// service reports the (versioned) ID of the current version of the same Key.
+//
+// Services which are configured with a versionless Key automatically use the latest Key Version, as such when the Key
+// is rotated in Key Vault the new Key Version isn't a change to the resource.
+func DiffSuppressVersionlessKey(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	configured, err := keyvault.ParseNestedItemID(new, keyvault.VersionTypeAny, keyvault.NestedItemTypeKey)
+	if err != nil || configured.Version != "" {
+		return false
+	}
+
+	return IsSameKey(old, new)
+}
+
+// KeyIDForState returns the Key ID which should be persisted into the state for a service which pins a specific Key
+// Version, where `previous` is the Key ID in the configuration/state and `current` is the Key ID the service reports.
+//
+// `previous` is retained when it refers to the Key Version the service is using, or when the service is using the latest
+// Key Version (retrieved using `latestKeyVersion`) and either `previous` is versionless or automatic key rotation is
+// enabled. Otherwise `current` is returned, so that a Key which has been rotated in Key Vault (but not by the service),
+// or a change to the Key Version in the configuration, shows up as a diff.
+func KeyIDForState(previous string, current keyvault.NestedItemID, autoRotationEnabled bool, latestKeyVersion func() (*keyvault.NestedItemID, error)) string {
+	if previous == "" || !IsSameKey(previous, current.ID()) {
+		return current.ID()
+	}
+
+	previousId, err := keyvault.ParseNestedItemID(previous, keyvault.VersionTypeAny, keyvault.NestedItemTypeKey)
+	if err != nil {
+		return current.ID()
+	}
+
+	if previousId.Version != "" && strings.EqualFold(previousId.Version, current.Version) {
+		return previous
+	}
+
+	if previousId.Version == "" || autoRotationEnabled {
+		latest, err := latestKeyVersion()
+		if err != nil {
+			log.Printf("[DEBUG] unable to determine whether %q is the latest version of the Key: %+v", current.ID(), err)
+			return current.ID()
+		}
+
+		if strings.EqualFold(latest.Version, current.Version) {
+			return previous
+		}
+	}
+
+	return current.ID()
+}
+
+// LatestKeyVersion returns the ID of the latest version of the Key `id`, retrieved using `keyVaultClient` for Key Vault
+// Keys or `managedHSMClient` for Managed HSM Keys. This allows a versionless Key to be used with services which require
+// a specific Key Version.
+func LatestKeyVersion(ctx context.Context, keyVaultClient, managedHSMClient *dataplane.BaseClient, id keyvault.NestedItemID) (*keyvault.NestedItemID, error) {
+	client := keyVaultClient
+	if id.IsManagedHSM() {
+		client = managedHSMClient
+	}
+
+	resp, err := client.GetKey(ctx, id.KeyVaultBaseURL, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the latest version of Key %q: %+v", id.VersionlessID(), err)
+	}
+	if resp.Key == nil || resp.Key.Kid == nil {
+		return nil, fmt.Errorf("retrieving the latest version of Key %q: `key.kid` was nil", id.VersionlessID())
+	}
+
+	latest, err := keyvault.ParseNestedItemID(*resp.Key.Kid, keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the latest version of Key %q: %+v", id.VersionlessID(), err)
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package customermanagedkeys_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/customermanagedkeys"
+)
+
+func TestIsSameKey(t *testing.T) {
+	tests := []struct {
+		first  string
+		second string
+		want   bool
+	}{
+		{
+			first:  "https://test.vault.azure.net/keys/key1",
+			second: "https://test.vault.azure.net/keys/key1/9ec2ab4b4c7f4b5c8a1e5ec6d2e1a7d0",
+			want:   true,
+		},
+		{
+			first:  "https://test.vault.azure.net/keys/key1/3b9e4c8e3a8a4e8c9a3b7c6d5e4f3a2b",
+			second: "https://TEST.vault.azure.net/keys/key1/9ec2ab4b4c7f4b5c8a1e5ec6d2e1a7d0",
+			want:   true,
+		},
+		{
+			first:  "https://test.managedhsm.azure.net/keys/key1",
+			second: "https://test.managedhsm.azure.net/keys/key1/9ec2ab4b4c7f4b5c8a1e5ec6d2e1a7d0",
+			want:   true,
+		},
+		{
+			first:  "https://test.vault.azure.net/keys/key1",
+			second: "https://test.vault.azure.net/keys/key2/9ec2ab4b4c7f4b5c8a1e5ec6d2e1a7d0",
+			want:   false,
+		},
+		{
+			first:  "https://test.vault.azure.net/keys/key1",
+			second: "https://other.vault.azure.net/keys/key1",
+			want:   false,
+		},
+		{
+			first:  "https://test.vault.azure.net/secrets/key1",
+			second: "https://test.vault.azure.net/secrets/key1",
+			want:   false,
+		},
+		{
+			first:  "",
+			second: "https://test.vault.azure.net/keys/key1",
+			want:   false,
+		},
+	}
+
+	for _, test := range tests {
+		if got := customermanagedkeys.IsSameKey(test.first, test.second); got != test.want {
+			t.Errorf("IsSameKey(%q, %q): expected %t but got %t", test.first, test.second, test.want, got)
+		}
+	}
+}
+
+func TestDiffSuppressVersionlessKey(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want bool
+	}{
+		{
+			name: "versionless key in config, current version in state",
+			old:  "https://test.vault.azure.net/keys/key1/9ec2ab4b4c7f4b5c8a1e5ec6d2e1a7d0",
+			new:  "https://test.vault.azure.net/keys/key1",
+			want: true,
+		},
+		{
+			name: "versioned key in config",
+			old:  "https://test.vault.azure.net/keys/key1/9ec2ab4b4c7f4b5c8a1e5ec6d2e1a7d0",
+			new:  "https://test.vault.azure.net/keys/key1/3b9e4c8e3a8a4e8c9a3b7c6d5e4f3a2b",
+			want: false,
+		},
+		{
+			name: "versionless key in config, different key in state",
+			old:  "https://test.vault.azure.net/keys/key2/9ec2ab4b4c7f4b5c8a1e5ec6d2e1a7d0",
+			new:  "https://test.vault.azure.net/keys/key1",
+			want: false,
+		},
+		{
+			name: "new resource",
+			old:  "",
+			new:  "https://test.vault.azure.net/keys/key1",
+			want: false,
+		},
+		{
+			name: "key removed from config",
+			old:  "https://test.vault.azure.net/keys/key1/9ec2ab4b4c7f4b5c8a1e5ec6d2e1a7d0",
+			new:  "",
+			want: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := customermanagedkeys.DiffSuppressVersionlessKey("key_vault_key_id", test.old, test.new, nil); got != test.want {
+				t.Errorf("expected %t but got %t", test.want, got)
+			}
+		})
+	}
+}
+
+func TestKeyIDForState(t *testing.T) {
+	const (
+		versionless = "https://test.vault.azure.net/keys/key1"
+		version1    = "https://test.vault.azure.net/keys/key1/3b9e4c8e3a8a4e8c9a3b7c6d5e4f3a2b"
+		version2    = "https://test.vault.azure.net/keys/key1/9ec2ab4b4c7f4b5c8a1e5ec6d2e1a7d0"
+		version3    = "https://test.vault.azure.net/keys/key1/0c6f0e5d8b1a4d0a9f3e2c1b0a9d8e7f"
+		otherKey    = "https://test.vault.azure.net/keys/key2/9ec2ab4b4c7f4b5c8a1e5ec6d2e1a7d0"
+	)
+
+	tests := []struct {
+		name                string
+		previous            string
+		current             string
+		latest              string
+		autoRotationEnabled bool
+		want                string
+	}{
+		{
+			name:     "imported",
+			previous: "",
+			current:  version1,
+			want:     version1,
+		},
+		{
+			name:     "versioned key in use",
+			previous: version1,
+			current:  version1,
+			latest:   version2,
+			want:     version1,
+		},
+		{
+			name:     "versioned key changed outside of terraform",
+			previous: version1,
+			current:  version2,
+			latest:   version2,
+			want:     version2,
+		},
+		{
+			name:                "versioned key rotated by the service",
+			previous:            version1,
+			current:             version2,
+			latest:              version2,
+			autoRotationEnabled: true,
+			want:                version1,
+		},
+		{
+			name:                "versioned key rotated by the service, not the latest version",
+			previous:            version1,
+			current:             version2,
+			latest:              version3,
+			autoRotationEnabled: true,
+			want:                version2,
+		},
+		{
+			name:     "versionless key using the latest version",
+			previous: versionless,
+			current:  version2,
+			latest:   version2,
+			want:     versionless,
+		},
+		{
+			name:     "versionless key rotated in key vault",
+			previous: versionless,
+			current:  version1,
+			latest:   version2,
+			want:     version1,
+		},
+		{
+			name:     "versionless key, latest version unavailable",
+			previous: versionless,
+			current:  version1,
+			want:     version1,
+		},
+		{
+			name:     "different key",
+			previous: otherKey,
+			current:  version1,
+			latest:   version1,
+			want:     version1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			current, err := keyvault.ParseNestedItemID(test.current, keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey)
+			if err != nil {
+				t.Fatalf("parsing %q: %+v", test.current, err)
+			}
+
+			latestKeyVersion := func() (*keyvault.NestedItemID, error) {
+				if test.latest == "" {
+					return nil, errors.New("key not found")
+				}
+				return keyvault.ParseNestedItemID(test.latest, keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey)
+			}
+
+			if got := customermanagedkeys.KeyIDForState(test.previous, *current, test.autoRotationEnabled, latestKeyVersion); got != test.want {
+				t.Errorf("expected %q but got %q", test.want, got)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ type UserFeatures struct {
 	RecoveryService          RecoveryServiceFeatures
 	NetApp                   NetAppFeatures
 	DatabricksWorkspace      DatabricksWorkspaceFeatures
+	SharedImageGallery       SharedImageGalleryFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -127,3 +128,7 @@ type NetAppFeatures struct {
 type DatabricksWorkspaceFeatures struct {
 	ForceDelete bool
 }
+
+type SharedImageGalleryFeatures struct {
+	RecoverSoftDeletedImageVersions bool
+}
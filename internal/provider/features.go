@@ -427,6 +427,22 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"shared_image_gallery": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"recover_soft_deleted_image_versions": {
+						Description: "When enabled, soft deleted Shared Image Versions will be recovered when a Shared Image Version with the same name is created.",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+				},
+			},
+		},
 	}
 
 	if !features.FivePointOh() {
@@ -722,5 +738,15 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["shared_image_gallery"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			sharedImageGalleryRaw := items[0].(map[string]interface{})
+			if v, ok := sharedImageGalleryRaw["recover_soft_deleted_image_versions"]; ok {
+				featuresMap.SharedImageGallery.RecoverSoftDeletedImageVersions = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
@@ -102,6 +102,9 @@ func TestExpandFeatures(t *testing.T) {
 				DatabricksWorkspace: features.DatabricksWorkspaceFeatures{
 					ForceDelete: false,
 				},
+				SharedImageGallery: features.SharedImageGalleryFeatures{
+					RecoverSoftDeletedImageVersions: true,
+				},
 			},
 		},
 		{
@@ -223,6 +226,11 @@ func TestExpandFeatures(t *testing.T) {
 							"force_delete": true,
 						},
 					},
+					"shared_image_gallery": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_image_versions": true,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -307,6 +315,9 @@ func TestExpandFeatures(t *testing.T) {
 				DatabricksWorkspace: features.DatabricksWorkspaceFeatures{
 					ForceDelete: true,
 				},
+				SharedImageGallery: features.SharedImageGalleryFeatures{
+					RecoverSoftDeletedImageVersions: true,
+				},
 			},
 		},
 		{
@@ -428,6 +439,11 @@ func TestExpandFeatures(t *testing.T) {
 							"force_delete": false,
 						},
 					},
+					"shared_image_gallery": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_image_versions": false,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -512,6 +528,9 @@ func TestExpandFeatures(t *testing.T) {
 				DatabricksWorkspace: features.DatabricksWorkspaceFeatures{
 					ForceDelete: false,
 				},
+				SharedImageGallery: features.SharedImageGalleryFeatures{
+					RecoverSoftDeletedImageVersions: false,
+				},
 			},
 		},
 	}
@@ -1948,3 +1967,68 @@ func TestExpandFeaturesDatabricksWorkspace(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesSharedImageGallery(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"shared_image_gallery": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				SharedImageGallery: features.SharedImageGalleryFeatures{
+					RecoverSoftDeletedImageVersions: true,
+				},
+			},
+		},
+		{
+			Name: "Shared Image Gallery Features Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"shared_image_gallery": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_image_versions": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				SharedImageGallery: features.SharedImageGalleryFeatures{
+					RecoverSoftDeletedImageVersions: true,
+				},
+			},
+		},
+		{
+			Name: "Shared Image Gallery Features Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"shared_image_gallery": []interface{}{
+						map[string]interface{}{
+							"recover_soft_deleted_image_versions": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				SharedImageGallery: features.SharedImageGalleryFeatures{
+					RecoverSoftDeletedImageVersions: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.SharedImageGallery, testCase.Expected.SharedImageGallery) {
+			t.Fatalf("Expected %+v but got %+v", result.SharedImageGallery, testCase.Expected.SharedImageGallery)
+		}
+	}
+}
@@ -126,6 +126,8 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	p.clientBuilder.DisableCorrelationRequestID = getEnvBoolOrDefault(data.DisableCorrelationRequestId, "ARM_DISABLE_CORRELATION_REQUEST_ID", false)
 	p.clientBuilder.DisableTerraformPartnerID = getEnvBoolOrDefault(data.DisableTerraformPartnerId, "ARM_DISABLE_TERRAFORM_PARTNER_ID", false)
 	p.clientBuilder.StorageUseAzureAD = getEnvBoolOrDefault(data.StorageUseAzureAD, "ARM_STORAGE_USE_AZUREAD", false)
+	p.clientBuilder.BatchResourceManagerReads = getEnvBoolOrDefault(data.BatchResourceManagerReads, "ARM_BATCH_RESOURCE_MANAGER_READS", false)
+	p.clientBuilder.CacheResourceManagerReads = getEnvBoolOrDefault(data.CacheResourceManagerReads, "ARM_CACHE_RESOURCE_MANAGER_READS", false)
 	// In 4.x, validate that the legacy and specific enhanced validation env vars don't conflict
 	if !providerfeatures.FivePointOh() {
 		if err := providerfeatures.ValidateEnhancedValidationEnvVars(); err != nil {
@@ -549,6 +551,22 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 		} else {
 			f.DatabricksWorkspace.ForceDelete = false
 		}
+
+		if !features.SharedImageGallery.IsNull() && !features.SharedImageGallery.IsUnknown() {
+			var feature []SharedImageGallery
+			d := features.SharedImageGallery.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.SharedImageGallery.RecoverSoftDeletedImageVersions = true
+			if !feature[0].RecoverSoftDeletedImageVersions.IsNull() && !feature[0].RecoverSoftDeletedImageVersions.IsUnknown() {
+				f.SharedImageGallery.RecoverSoftDeletedImageVersions = feature[0].RecoverSoftDeletedImageVersions.ValueBool()
+			}
+		} else {
+			f.SharedImageGallery.RecoverSoftDeletedImageVersions = true
+		}
 	}
 
 	f.EnhancedValidation.Locations = enhancedValidationLocations
@@ -606,7 +624,8 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	defer cancel()
 
 	// Ensure that we do not trigger the RP cache when running in VCR mode or the cassettes have a base size of 3.5MiB!
-	if os.Getenv("TC_TEST_VIA_VCR") == "" {
+	// This is also skipped when a custom Transport (such as the ARM Emulator) is configured.
+	if os.Getenv("TC_TEST_VIA_VCR") == "" && p.clientBuilder.Transport == nil {
 		if err = resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subId, requiredResourceProviders); err != nil {
 			diags.AddError("registering resource providers", err.Error())
 			return
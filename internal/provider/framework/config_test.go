@@ -218,6 +218,10 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 	if features.DatabricksWorkspace.ForceDelete {
 		t.Errorf("expected databricks_workspace.ForceDelete to be false")
 	}
+
+	if !features.SharedImageGallery.RecoverSoftDeletedImageVersions {
+		t.Errorf("expected shared_image_gallery.RecoverSoftDeletedImageVersions to be true")
+	}
 }
 
 // TODO - helper functions to make setting up test date more easily so we can add more configuration coverage
@@ -337,6 +341,11 @@ func defaultFeaturesList() types.List {
 	})
 	databricksWorkspaceList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(DatabricksWorkspaceAttributes), []attr.Value{databricksWorkspace})
 
+	sharedImageGallery, _ := basetypes.NewObjectValueFrom(context.Background(), SharedImageGalleryAttributes, map[string]attr.Value{
+		"recover_soft_deleted_image_versions": basetypes.NewBoolNull(),
+	})
+	sharedImageGalleryList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(SharedImageGalleryAttributes), []attr.Value{sharedImageGallery})
+
 	fData, d := basetypes.NewObjectValue(FeaturesAttributes, map[string]attr.Value{
 		"api_management":             apiManagementList,
 		"app_configuration":          appConfigurationList,
@@ -357,6 +366,7 @@ func defaultFeaturesList() types.List {
 		"recovery_services_vaults":   recoveryServicesVaultsList,
 		"netapp":                     netappList,
 		"databricks_workspace":       databricksWorkspaceList,
+		"shared_image_gallery":       sharedImageGalleryList,
 	})
 
 	fmt.Printf("%+v", d)
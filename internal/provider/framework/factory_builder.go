@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 )
 
@@ -66,12 +67,12 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 }
 
 // ProtoV5ProviderFactoriesInitWithTestName is a new Factory for the acceptance test suite.
-func ProtoV5ProviderFactoriesInitWithTestName(ctx context.Context, testName string, providerNames ...string) map[string]func() (tfprotov5.ProviderServer, error) {
+func ProtoV5ProviderFactoriesInitWithTestName(ctx context.Context, testName string, builderOptions []clients.ClientBuilderOption, providerNames ...string) map[string]func() (tfprotov5.ProviderServer, error) {
 	factories := make(map[string]func() (tfprotov5.ProviderServer, error), len(providerNames))
 
 	for _, name := range providerNames {
 		factories[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, _, err := ProtoV5ProviderServerFactoryWithTestName(ctx, testName)
+			providerServerFactory, _, err := ProtoV5ProviderServerFactoryWithTestName(ctx, testName, builderOptions...)
 			if err != nil {
 				return nil, err
 			}
@@ -83,8 +84,8 @@ func ProtoV5ProviderFactoriesInitWithTestName(ctx context.Context, testName stri
 	return factories
 }
 
-func ProtoV5ProviderServerFactoryWithTestName(ctx context.Context, testName string) (func() tfprotov5.ProviderServer, *schema.Provider, error) {
-	v2Provider := provider.AzureProviderWithTestName(testName)
+func ProtoV5ProviderServerFactoryWithTestName(ctx context.Context, testName string, builderOptions ...clients.ClientBuilderOption) (func() tfprotov5.ProviderServer, *schema.Provider, error) {
+	v2Provider := provider.AzureProviderWithTestName(testName, builderOptions...)
 
 	providers := []func() tfprotov5.ProviderServer{
 		v2Provider.GRPCProvider,
@@ -36,6 +36,8 @@ type ProviderModel struct {
 	DisableCorrelationRequestId    types.Bool   `tfsdk:"disable_correlation_request_id"`
 	DisableTerraformPartnerId      types.Bool   `tfsdk:"disable_terraform_partner_id"`
 	StorageUseAzureAD              types.Bool   `tfsdk:"storage_use_azuread"`
+	BatchResourceManagerReads      types.Bool   `tfsdk:"batch_resource_manager_reads"`
+	CacheResourceManagerReads      types.Bool   `tfsdk:"cache_resource_manager_reads"`
 	EnhancedValidation             types.List   `tfsdk:"enhanced_validation"`
 	Features                       types.List   `tfsdk:"features"`
 	SkipProviderRegistration       types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
@@ -63,6 +65,7 @@ type Features struct {
 	RecoveryServicesVaults   types.List `tfsdk:"recovery_services_vaults"`
 	NetApp                   types.List `tfsdk:"netapp"`
 	DatabricksWorkspace      types.List `tfsdk:"databricks_workspace"`
+	SharedImageGallery       types.List `tfsdk:"shared_image_gallery"`
 }
 
 // FeaturesAttributes and the other block attribute vars are required for unit testing on the Load func
@@ -87,6 +90,7 @@ var FeaturesAttributes = map[string]attr.Type{
 	"recovery_services_vaults":   types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes)),
 	"netapp":                     types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(NetAppAttributes)),
 	"databricks_workspace":       types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(DatabricksWorkspaceAttributes)),
+	"shared_image_gallery":       types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(SharedImageGalleryAttributes)),
 }
 
 type APIManagement struct {
@@ -283,6 +287,14 @@ var DatabricksWorkspaceAttributes = map[string]attr.Type{
 	"force_delete": types.BoolType,
 }
 
+type SharedImageGallery struct {
+	RecoverSoftDeletedImageVersions types.Bool `tfsdk:"recover_soft_deleted_image_versions"`
+}
+
+var SharedImageGalleryAttributes = map[string]attr.Type{
+	"recover_soft_deleted_image_versions": types.BoolType,
+}
+
 type EnhancedValidationModel struct {
 	Locations         types.Bool `tfsdk:"locations"`
 	ResourceProviders types.Bool `tfsdk:"resource_providers"`
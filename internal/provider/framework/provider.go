@@ -210,6 +210,16 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
 
+			"batch_resource_manager_reads": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Should the AzureRM Provider combine concurrent read requests to Resource Manager into batch requests? This reduces the number of requests made when refreshing a large number of resources.",
+			},
+
+			"cache_resource_manager_reads": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Should the AzureRM Provider cache the responses to read requests to Resource Manager for the duration of the plan/apply? Cached responses are invalidated when the resource, or a parent/nested resource, is modified.",
+			},
+
 			"resource_provider_registrations": schema.StringAttribute{
 				Optional:    true,
 				Description: "The set of Resource Providers which should be automatically registered for the subscription.",
@@ -519,6 +529,16 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 								},
 							},
 						},
+						"shared_image_gallery": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"recover_soft_deleted_image_versions": schema.BoolAttribute{
+										Optional:    true,
+										Description: "When enabled, soft deleted Shared Image Versions will be recovered when a Shared Image Version with the same name is created.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -599,8 +619,19 @@ func (p *azureRmFrameworkProvider) EphemeralResources(_ context.Context) []func(
 
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		providerfunction.NewARMOutputsFunction,
+		providerfunction.NewARMParametersFunction,
+		providerfunction.NewBuildResourceIDFunction,
+		providerfunction.NewGenerateResourceNameFunction,
 		providerfunction.NewNormaliseResourceIDFunction,
+		providerfunction.NewParseConnectionStringFunction,
+		providerfunction.NewParseKeyVaultIDFunction,
 		providerfunction.NewParseResourceIDFunction,
+		providerfunction.NewParseStorageURLFunction,
+		providerfunction.NewResourceTypeForIDFunction,
+		providerfunction.NewSubnetAllocateFunction,
+		providerfunction.NewSubnetUsableHostsFunction,
+		providerfunction.NewValidateResourceNameFunction,
 	}
 }
 
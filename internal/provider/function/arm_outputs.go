@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource"
+)
+
+type ARMOutputsFunction struct{}
+
+var _ function.Function = ARMOutputsFunction{}
+
+func NewARMOutputsFunction() function.Function {
+	return &ARMOutputsFunction{}
+}
+
+func (a ARMOutputsFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "arm_outputs"
+}
+
+func (a ARMOutputsFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "arm_outputs",
+		Description:         "Unwraps the outputs of an ARM Template Deployment into an object containing the value of each output",
+		MarkdownDescription: "Unwraps the outputs of an ARM Template Deployment into an object containing the value of each output",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "json",
+				Description:         "The outputs of the ARM Template Deployment as JSON, e.g. the `output_content` of a Template Deployment",
+				MarkdownDescription: "The outputs of the ARM Template Deployment as JSON, e.g. the `output_content` of a Template Deployment",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (a ARMOutputsFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var input string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &input))
+
+	if response.Error != nil {
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(input)))
+	decoder.UseNumber()
+
+	var raw interface{}
+	if err := decoder.Decode(&raw); err != nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("parsing JSON: %+v", err))
+		return
+	}
+
+	outputs, err := resource.FlattenTemplateDeploymentOutputs(raw)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, err := attrValueFromJSON(outputs)
+	if err != nil {
+		response.Error = function.NewFuncError(fmt.Sprintf("converting outputs: %+v", err))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, types.DynamicValue(result)))
+}
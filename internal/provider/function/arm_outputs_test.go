@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionARMOutputs_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testARMOutputsOutput(`{"storageAccountName":{"type":"String","value":"account1"},"instanceCount":{"type":"Int","value":3},"settings":{"type":"Object","value":{"enabled":true,"zones":["1","2"]}}}`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("storage_account_name", "account1"),
+					acceptance.TestCheckOutput("instance_count", "3"),
+					acceptance.TestCheckOutput("settings_enabled", "true"),
+					acceptance.TestCheckOutput("settings_zone", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionARMOutputs_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testARMOutputsOutput(`["account1"]`),
+				ExpectError: regexp.MustCompile("expected the outputs to be an object"),
+			},
+		},
+	})
+}
+
+func testARMOutputsOutput(input string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  outputs = provider::azurerm::arm_outputs(%q)
+}
+
+output "storage_account_name" {
+  value = local.outputs.storageAccountName
+}
+
+output "instance_count" {
+  value = tostring(local.outputs.instanceCount)
+}
+
+output "settings_enabled" {
+  value = tostring(local.outputs.settings.enabled)
+}
+
+output "settings_zone" {
+  value = local.outputs.settings.zones[1]
+}
+`, input)
+}
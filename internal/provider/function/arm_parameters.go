@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource"
+)
+
+type ARMParametersFunction struct{}
+
+var _ function.Function = ARMParametersFunction{}
+
+func NewARMParametersFunction() function.Function {
+	return &ARMParametersFunction{}
+}
+
+func (a ARMParametersFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "arm_parameters"
+}
+
+func (a ARMParametersFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "arm_parameters",
+		Description:         "Builds the JSON parameters document for an ARM Template Deployment from an object or map of parameter values",
+		MarkdownDescription: "Builds the JSON parameters document for an ARM Template Deployment from an object or map of parameter values",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "parameters",
+				Description:         "An object or map of the parameter values, keyed by the name of the parameter",
+				MarkdownDescription: "An object or map of the parameter values, keyed by the name of the parameter",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (a ARMParametersFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var input types.Dynamic
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &input))
+
+	if response.Error != nil {
+		return
+	}
+
+	if input.IsNull() || input.IsUnderlyingValueNull() {
+		response.Error = function.NewArgumentFuncError(0, "the parameters must not be null")
+		return
+	}
+
+	raw, err := jsonFromAttrValue(ctx, input.UnderlyingValue())
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("converting parameters: %+v", err))
+		return
+	}
+
+	values, ok := raw.(map[string]interface{})
+	if !ok {
+		response.Error = function.NewArgumentFuncError(0, "expected the parameters to be an object or map")
+		return
+	}
+
+	parameters, err := json.Marshal(resource.ExpandTemplateDeploymentParameters(values))
+	if err != nil {
+		response.Error = function.NewFuncError(fmt.Sprintf("marshalling parameters: %+v", err))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, string(parameters)))
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionARMParameters_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testARMParametersOutput(`{
+    storageAccountName = "account1"
+    instanceCount      = 3
+    zones              = ["1", "2"]
+  }`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("parameters", `{"instanceCount":{"value":3},"storageAccountName":{"value":"account1"},"zones":{"value":["1","2"]}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionARMParameters_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testARMParametersOutput(`["account1"]`),
+				ExpectError: regexp.MustCompile("expected the parameters to be an object or map"),
+			},
+		},
+	})
+}
+
+func testARMParametersOutput(input string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+output "parameters" {
+  value = provider::azurerm::arm_parameters(%s)
+}
+`, input)
+}
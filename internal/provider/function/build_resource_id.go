@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type BuildResourceIDFunction struct{}
+
+var _ function.Function = BuildResourceIDFunction{}
+
+func NewBuildResourceIDFunction() function.Function {
+	return &BuildResourceIDFunction{}
+}
+
+func (a BuildResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_resource_id"
+}
+
+func (a BuildResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_resource_id",
+		Description:         "Builds an Azure Resource Manager ID from a scope, resource provider, resource type and resource name",
+		MarkdownDescription: "Builds an Azure Resource Manager ID from a scope, resource provider, resource type and resource name",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "scope",
+				Description:         "The ID of the scope the resource is created within, such as a Subscription or Resource Group ID. An empty string or `/` denotes the tenant",
+				MarkdownDescription: "The ID of the scope the resource is created within, such as a Subscription or Resource Group ID. An empty string or `/` denotes the tenant",
+			},
+			function.StringParameter{
+				Name:                "resource_provider",
+				Description:         "Resource Provider, e.g. `Microsoft.Network`",
+				MarkdownDescription: "Resource Provider, e.g. `Microsoft.Network`",
+			},
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "Resource Type, with any parent resource types separated by `/`, e.g. `virtualNetworks/subnets`",
+				MarkdownDescription: "Resource Type, with any parent resource types separated by `/`, e.g. `virtualNetworks/subnets`",
+			},
+			function.StringParameter{
+				Name:                "resource_name",
+				Description:         "Resource Name, with any parent resource names separated by `/`, e.g. `network1/subnet1`",
+				MarkdownDescription: "Resource Name, with any parent resource names separated by `/`, e.g. `network1/subnet1`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (a BuildResourceIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var scope, resourceProvider, resourceType, resourceName string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &scope, &resourceProvider, &resourceType, &resourceName))
+
+	if response.Error != nil {
+		return
+	}
+
+	if scope != "" && !strings.HasPrefix(scope, "/") {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected `scope` to start with `/`, got %q", scope))
+		return
+	}
+	scope = strings.TrimSuffix(scope, "/")
+
+	if resourceProvider == "" || strings.Contains(resourceProvider, "/") {
+		response.Error = function.NewArgumentFuncError(1, fmt.Sprintf("expected `resource_provider` to be a single segment such as `Microsoft.Network`, got %q", resourceProvider))
+		return
+	}
+
+	resourceTypes := strings.Split(strings.Trim(resourceType, "/"), "/")
+	names := strings.Split(strings.Trim(resourceName, "/"), "/")
+	if len(resourceTypes) != len(names) {
+		response.Error = function.NewFuncError(fmt.Sprintf("expected `resource_type` and `resource_name` to contain the same number of segments, got %d and %d", len(resourceTypes), len(names)))
+		return
+	}
+
+	segments := []string{scope, "providers", resourceProvider}
+	for i := range resourceTypes {
+		if resourceTypes[i] == "" || names[i] == "" {
+			response.Error = function.NewFuncError(fmt.Sprintf("`resource_type` (%q) and `resource_name` (%q) must not contain empty segments", resourceType, resourceName))
+			return
+		}
+		segments = append(segments, resourceTypes[i], names[i])
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, strings.Join(segments, "/")))
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// attrValueFromJSON converts a value decoded from JSON (using `UseNumber`) into the equivalent Terraform value,
+// JSON objects are returned as Objects and JSON arrays as Tuples since their elements can be of differing types
+func attrValueFromJSON(input interface{}) (attr.Value, error) {
+	switch v := input.(type) {
+	case nil:
+		return types.StringNull(), nil
+
+	case bool:
+		return types.BoolValue(v), nil
+
+	case string:
+		return types.StringValue(v), nil
+
+	case json.Number:
+		f, ok := new(big.Float).SetString(v.String())
+		if !ok {
+			return nil, fmt.Errorf("parsing %q as a number", v.String())
+		}
+		return types.NumberValue(f), nil
+
+	case []interface{}:
+		elementTypes := make([]attr.Type, 0, len(v))
+		elements := make([]attr.Value, 0, len(v))
+		for _, item := range v {
+			element, err := attrValueFromJSON(item)
+			if err != nil {
+				return nil, err
+			}
+			elementTypes = append(elementTypes, element.Type(context.Background()))
+			elements = append(elements, element)
+		}
+
+		value, diags := types.TupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("building tuple: %+v", diags)
+		}
+		return value, nil
+
+	case map[string]interface{}:
+		attributeTypes := make(map[string]attr.Type, len(v))
+		attributes := make(map[string]attr.Value, len(v))
+		for key, item := range v {
+			attribute, err := attrValueFromJSON(item)
+			if err != nil {
+				return nil, err
+			}
+			attributeTypes[key] = attribute.Type(context.Background())
+			attributes[key] = attribute
+		}
+
+		value, diags := types.ObjectValue(attributeTypes, attributes)
+		if diags.HasError() {
+			return nil, fmt.Errorf("building object: %+v", diags)
+		}
+		return value, nil
+	}
+
+	return nil, fmt.Errorf("unsupported JSON type %T", input)
+}
+
+// jsonFromAttrValue converts a Terraform value into the equivalent value to be encoded as JSON
+func jsonFromAttrValue(ctx context.Context, input attr.Value) (interface{}, error) {
+	value, err := input.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonFromTerraformValue(value)
+}
+
+func jsonFromTerraformValue(input tftypes.Value) (interface{}, error) {
+	if input.IsNull() {
+		return nil, nil
+	}
+
+	if !input.IsKnown() {
+		return nil, fmt.Errorf("unknown values are not supported")
+	}
+
+	typ := input.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var v string
+		err := input.As(&v)
+		return v, err
+
+	case typ.Is(tftypes.Bool):
+		var v bool
+		err := input.As(&v)
+		return v, err
+
+	case typ.Is(tftypes.Number):
+		v := new(big.Float)
+		if err := input.As(&v); err != nil {
+			return nil, err
+		}
+		return json.Number(v.Text('f', -1)), nil
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var items []tftypes.Value
+		if err := input.As(&items); err != nil {
+			return nil, err
+		}
+
+		output := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			v, err := jsonFromTerraformValue(item)
+			if err != nil {
+				return nil, err
+			}
+			output = append(output, v)
+		}
+		return output, nil
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var items map[string]tftypes.Value
+		if err := input.As(&items); err != nil {
+			return nil, err
+		}
+
+		output := make(map[string]interface{}, len(items))
+		for key, item := range items {
+			v, err := jsonFromTerraformValue(item)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %+v", key, err)
+			}
+			output[key] = v
+		}
+		return output, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", typ.String())
+}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type GenerateResourceNameFunction struct{}
+
+var _ function.Function = GenerateResourceNameFunction{}
+
+// generatedResourceNameRandomLength is the maximum number of characters derived from the random seed, fewer characters
+// are used when the full length would exceed the maximum length of the name
+const generatedResourceNameRandomLength = 8
+
+var nonAlphanumericRegex = regexp.MustCompile(`[^a-zA-Z0-9]`)
+
+// generatedResourceNameTransforms are applied in order to the prefix and suffix until the generated name is valid,
+// allowing e.g. `my-app-` to be used as the prefix for both a Key Vault and a Storage Account
+var generatedResourceNameTransforms = []func(string) string{
+	func(input string) string {
+		return input
+	},
+	strings.ToLower,
+	func(input string) string {
+		return nonAlphanumericRegex.ReplaceAllString(input, "")
+	},
+	func(input string) string {
+		return strings.ToLower(nonAlphanumericRegex.ReplaceAllString(input, ""))
+	},
+}
+
+func NewGenerateResourceNameFunction() function.Function {
+	return &GenerateResourceNameFunction{}
+}
+
+func (a GenerateResourceNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "generate_resource_name"
+}
+
+func (a GenerateResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "generate_resource_name",
+		Description:         "Generates a deterministic name from a prefix, suffix and random seed which is valid for the `name` of the specified resource type",
+		MarkdownDescription: "Generates a deterministic name from a prefix, suffix and random seed which is valid for the `name` of the specified resource type",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "Terraform Resource Type, e.g. `azurerm_storage_account`",
+				MarkdownDescription: "Terraform Resource Type, e.g. `azurerm_storage_account`",
+			},
+			function.StringParameter{
+				Name:                "prefix",
+				Description:         "The prefix of the name",
+				MarkdownDescription: "The prefix of the name",
+			},
+			function.StringParameter{
+				Name:                "suffix",
+				Description:         "The suffix of the name",
+				MarkdownDescription: "The suffix of the name",
+			},
+			function.StringParameter{
+				Name:                "random_seed",
+				Description:         "The seed used to derive the random characters of the name, the same seed always generates the same name",
+				MarkdownDescription: "The seed used to derive the random characters of the name, the same seed always generates the same name",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (a GenerateResourceNameFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType, prefix, suffix, randomSeed string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType, &prefix, &suffix, &randomSeed))
+
+	if response.Error != nil {
+		return
+	}
+
+	validateFunc, err := resourceNameValidator(resourceType)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	random := randomCharactersFromSeed(randomSeed, generatedResourceNameRandomLength)
+
+	// prefer the longest random portion, only then falling back to transforming the prefix and suffix
+	for length := generatedResourceNameRandomLength; length >= 0; length-- {
+		for _, transform := range generatedResourceNameTransforms {
+			name := transform(prefix) + random[:length] + transform(suffix)
+			if len(validateResourceName(validateFunc, name)) == 0 {
+				response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, name))
+				return
+			}
+		}
+	}
+
+	errs := validateResourceName(validateFunc, prefix+random+suffix)
+	response.Error = function.NewFuncError(fmt.Sprintf("unable to generate a valid name for %q from the prefix %q and suffix %q: %s", resourceType, prefix, suffix, strings.Join(errs, "; ")))
+}
+
+// randomCharactersFromSeed deterministically derives `length` lowercase alphanumeric characters from the seed
+func randomCharactersFromSeed(seed string, length int) string {
+	hash := sha256.Sum256([]byte(seed))
+	encoded := new(big.Int).SetBytes(hash[:]).Text(36)
+
+	// the first character is always a letter, since many resource types require names to start with a letter
+	return string(rune('a'+hash[0]%26)) + encoded[:length-1]
+}
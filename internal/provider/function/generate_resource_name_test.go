@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionGenerateResourceName_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testGenerateResourceNameOutput("azurerm_key_vault", "my-app-", "-prod", "seed1"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("name", "my-app-p5kn91fk-prod"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionGenerateResourceName_transformed(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testGenerateResourceNameOutput("azurerm_storage_account", "my-App-", "-prod", "seed1"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("name", "myappp5kn91fkprod"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionGenerateResourceName_tooLong(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testGenerateResourceNameOutput("azurerm_storage_account", "averyveryveryverylongprefix", "", "seed1"),
+				ExpectError: regexp.MustCompile("unable to generate a valid name"),
+			},
+		},
+	})
+}
+
+func testGenerateResourceNameOutput(resourceType, prefix, suffix, randomSeed string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+output "name" {
+  value = provider::azurerm::generate_resource_name("%s", "%s", "%s", "%s")
+}
+`, resourceType, prefix, suffix, randomSeed)
+}
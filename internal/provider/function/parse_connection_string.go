@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ParseConnectionStringFunction struct{}
+
+var _ function.Function = ParseConnectionStringFunction{}
+
+var connectionStringParseResultTypes = map[string]attr.Type{
+	"endpoint":    types.StringType,
+	"name":        types.StringType,
+	"key_name":    types.StringType,
+	"key":         types.StringType,
+	"entity_path": types.StringType,
+	"properties":  types.MapType{}.WithElementType(types.StringType),
+}
+
+func NewParseConnectionStringFunction() function.Function {
+	return &ParseConnectionStringFunction{}
+}
+
+func (p ParseConnectionStringFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "parse_connection_string"
+}
+
+func (p ParseConnectionStringFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "parse_connection_string",
+		Description:         "Parses a Service Bus, Event Hubs, Storage or Cosmos DB connection string and exposes the contained information",
+		MarkdownDescription: "Parses a Service Bus, Event Hubs, Storage or Cosmos DB connection string and exposes the contained information",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "connection_string",
+				Description:         "Connection String",
+				MarkdownDescription: "Connection String",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: connectionStringParseResultTypes,
+		},
+	}
+}
+
+func (p ParseConnectionStringFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var connectionString string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &connectionString))
+
+	if response.Error != nil {
+		return
+	}
+
+	if len(connectionString) == 0 {
+		response.Error = function.NewFuncError("Got empty connection string")
+		return
+	}
+
+	properties, err := parseConnectionString(connectionString)
+	if err != nil {
+		response.Error = function.NewFuncError(fmt.Sprintf("Parsing Connection String Error: %s", err))
+		return
+	}
+
+	// Service Bus and Event Hubs use `Endpoint`, Cosmos DB uses `AccountEndpoint` and Storage uses either the
+	// explicit service endpoints or the `AccountName` and `EndpointSuffix` pair
+	endpoint := connectionStringValue(properties, "Endpoint", "AccountEndpoint", "BlobEndpoint")
+	name := connectionStringValue(properties, "AccountName")
+	if endpoint == "" && name != "" {
+		if suffix := connectionStringValue(properties, "EndpointSuffix"); suffix != "" {
+			protocol := connectionStringValue(properties, "DefaultEndpointsProtocol")
+			if protocol == "" {
+				protocol = "https"
+			}
+			endpoint = fmt.Sprintf("%s://%s.blob.%s/", protocol, name, suffix)
+		}
+	}
+	if name == "" && endpoint != "" {
+		if u, err := url.Parse(endpoint); err == nil && u.Hostname() != "" {
+			name = strings.Split(u.Hostname(), ".")[0]
+		}
+	}
+
+	propertiesValue, diags := types.MapValueFrom(ctx, types.StringType, properties)
+	if diags.HasError() {
+		response.Error = function.NewFuncError("failed to flatten connection string properties")
+		return
+	}
+
+	output := map[string]attr.Value{
+		"endpoint":    types.StringValue(endpoint),
+		"name":        types.StringValue(name),
+		"key_name":    types.StringValue(connectionStringValue(properties, "SharedAccessKeyName")),
+		"key":         types.StringValue(connectionStringValue(properties, "SharedAccessKey", "AccountKey")),
+		"entity_path": types.StringValue(connectionStringValue(properties, "EntityPath")),
+		"properties":  propertiesValue,
+	}
+
+	result, diags := types.ObjectValue(connectionStringParseResultTypes, output)
+	if diags.HasError() {
+		response.Error = function.ConcatFuncErrors(response.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+// parseConnectionString splits a connection string in the format `Key1=Value1;Key2=Value2` into its properties,
+// values can contain `=` (e.g. base64 encoded keys) so only the first `=` in each pair is used as the separator
+func parseConnectionString(input string) (map[string]string, error) {
+	properties := make(map[string]string)
+	for _, pair := range strings.Split(input, ";") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("expected a `Key=Value` pair but got %q", pair)
+		}
+
+		properties[strings.TrimSpace(key)] = value
+	}
+
+	if len(properties) == 0 {
+		return nil, fmt.Errorf("no `Key=Value` pairs were found")
+	}
+
+	return properties, nil
+}
+
+// connectionStringValue returns the value of the first of the specified keys present in the connection string
+// properties, matching the keys case-insensitively
+func connectionStringValue(properties map[string]string, keys ...string) string {
+	for _, key := range keys {
+		for k, v := range properties {
+			if strings.EqualFold(k, key) {
+				return v
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionParseConnectionString_serviceBus(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParseConnectionStringOutput("Endpoint=sb://namespace1.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=c2VjcmV0a2V5==;EntityPath=queue1"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("endpoint", "sb://namespace1.servicebus.windows.net/"),
+					acceptance.TestCheckOutput("name", "namespace1"),
+					acceptance.TestCheckOutput("key_name", "RootManageSharedAccessKey"),
+					acceptance.TestCheckOutput("key", "c2VjcmV0a2V5=="),
+					acceptance.TestCheckOutput("entity_path", "queue1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionParseConnectionString_storage(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParseConnectionStringOutput("DefaultEndpointsProtocol=https;AccountName=account1;AccountKey=c2VjcmV0a2V5==;EndpointSuffix=core.windows.net"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("endpoint", "https://account1.blob.core.windows.net/"),
+					acceptance.TestCheckOutput("name", "account1"),
+					acceptance.TestCheckOutput("key_name", ""),
+					acceptance.TestCheckOutput("key", "c2VjcmV0a2V5=="),
+					acceptance.TestCheckOutput("entity_path", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionParseConnectionString_cosmosDB(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParseConnectionStringOutput("AccountEndpoint=https://account1.documents.azure.com:443/;AccountKey=c2VjcmV0a2V5==;"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("endpoint", "https://account1.documents.azure.com:443/"),
+					acceptance.TestCheckOutput("name", "account1"),
+					acceptance.TestCheckOutput("key_name", ""),
+					acceptance.TestCheckOutput("key", "c2VjcmV0a2V5=="),
+					acceptance.TestCheckOutput("entity_path", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionParseConnectionString_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testParseConnectionStringOutput("not-a-connection-string"),
+				ExpectError: regexp.MustCompile("Parsing Connection String Error"),
+			},
+		},
+	})
+}
+
+func testParseConnectionStringOutput(connectionString string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  parsed = provider::azurerm::parse_connection_string("%s")
+}
+
+output "endpoint" {
+  value = local.parsed["endpoint"]
+}
+
+output "name" {
+  value = local.parsed["name"]
+}
+
+output "key_name" {
+  value = local.parsed["key_name"]
+}
+
+output "key" {
+  value = local.parsed["key"]
+}
+
+output "entity_path" {
+  value = local.parsed["entity_path"]
+}
+`, connectionString)
+}
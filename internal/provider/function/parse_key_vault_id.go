@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+)
+
+type ParseKeyVaultIDFunction struct{}
+
+var _ function.Function = ParseKeyVaultIDFunction{}
+
+var keyVaultIdParseResultTypes = map[string]attr.Type{
+	"key_vault_base_url": types.StringType,
+	"key_vault_name":     types.StringType,
+	"type":               types.StringType,
+	"name":               types.StringType,
+	"version":            types.StringType,
+	"versionless_id":     types.StringType,
+}
+
+func NewParseKeyVaultIDFunction() function.Function {
+	return &ParseKeyVaultIDFunction{}
+}
+
+func (p ParseKeyVaultIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "parse_key_vault_id"
+}
+
+func (p ParseKeyVaultIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "parse_key_vault_id",
+		Description:         "Parses a Key Vault Key, Secret or Certificate ID and exposes the contained information",
+		MarkdownDescription: "Parses a Key Vault Key, Secret or Certificate ID and exposes the contained information",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				Description:         "Key Vault Nested Item ID",
+				MarkdownDescription: "Key Vault Nested Item ID",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: keyVaultIdParseResultTypes,
+		},
+	}
+}
+
+func (p ParseKeyVaultIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var id string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &id))
+
+	if response.Error != nil {
+		return
+	}
+
+	if len(id) == 0 {
+		response.Error = function.NewFuncError("Got empty ID")
+		return
+	}
+
+	item, err := parse.ParseOptionallyVersionedNestedItemID(id)
+	if err != nil {
+		response.Error = function.NewFuncError(fmt.Sprintf("Parsing Key Vault ID Error: %s", err))
+		return
+	}
+
+	baseUrl, err := url.Parse(item.KeyVaultBaseUrl)
+	if err != nil {
+		response.Error = function.NewFuncError(fmt.Sprintf("Parsing Key Vault Base URL Error: %s", err))
+		return
+	}
+
+	output := map[string]attr.Value{
+		"key_vault_base_url": types.StringValue(item.KeyVaultBaseUrl),
+		"key_vault_name":     types.StringValue(strings.Split(baseUrl.Hostname(), ".")[0]),
+		"type":               types.StringValue(string(item.NestedItemType)),
+		"name":               types.StringValue(item.Name),
+		"version":            types.StringValue(item.Version),
+		"versionless_id":     types.StringValue(item.VersionlessID()),
+	}
+
+	result, diags := types.ObjectValue(keyVaultIdParseResultTypes, output)
+	if diags.HasError() {
+		response.Error = function.ConcatFuncErrors(response.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
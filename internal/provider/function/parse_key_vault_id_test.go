@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionParseKeyVaultID_versioned(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParseKeyVaultIdOutput("https://vault1.vault.azure.net/secrets/secret1/fdf067c93bbb4b22bff4d8b7a9a56217"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("key_vault_base_url", "https://vault1.vault.azure.net/"),
+					acceptance.TestCheckOutput("key_vault_name", "vault1"),
+					acceptance.TestCheckOutput("type", "secrets"),
+					acceptance.TestCheckOutput("name", "secret1"),
+					acceptance.TestCheckOutput("version", "fdf067c93bbb4b22bff4d8b7a9a56217"),
+					acceptance.TestCheckOutput("versionless_id", "https://vault1.vault.azure.net/secrets/secret1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionParseKeyVaultID_versionless(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParseKeyVaultIdOutput("https://vault1.vault.usgovcloudapi.net/keys/key1"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("key_vault_base_url", "https://vault1.vault.usgovcloudapi.net/"),
+					acceptance.TestCheckOutput("key_vault_name", "vault1"),
+					acceptance.TestCheckOutput("type", "keys"),
+					acceptance.TestCheckOutput("name", "key1"),
+					acceptance.TestCheckOutput("version", ""),
+					acceptance.TestCheckOutput("versionless_id", "https://vault1.vault.usgovcloudapi.net/keys/key1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionParseKeyVaultID_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testParseKeyVaultIdOutput("https://vault1.vault.azure.net/widgets/widget1"),
+				ExpectError: regexp.MustCompile("Parsing Key Vault ID Error"),
+			},
+		},
+	})
+}
+
+func testParseKeyVaultIdOutput(id string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  parsed_id = provider::azurerm::parse_key_vault_id("%s")
+}
+
+output "key_vault_base_url" {
+  value = local.parsed_id["key_vault_base_url"]
+}
+
+output "key_vault_name" {
+  value = local.parsed_id["key_vault_name"]
+}
+
+output "type" {
+  value = local.parsed_id["type"]
+}
+
+output "name" {
+  value = local.parsed_id["name"]
+}
+
+output "version" {
+  value = local.parsed_id["version"]
+}
+
+output "versionless_id" {
+  value = local.parsed_id["versionless_id"]
+}
+`, id)
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ParseStorageURLFunction struct{}
+
+var _ function.Function = ParseStorageURLFunction{}
+
+var storageUrlParseResultTypes = map[string]attr.Type{
+	"storage_account_name": types.StringType,
+	"service":              types.StringType,
+	"domain_suffix":        types.StringType,
+	"service_endpoint":     types.StringType,
+	"resource_name":        types.StringType,
+	"path":                 types.StringType,
+}
+
+// storageUrlServices are the Storage services which expose data plane URLs in the format
+// `https://{accountName}.{service}.{domainSuffix}/{resourceName}/{path}`
+var storageUrlServices = []string{
+	"blob",
+	"dfs",
+	"file",
+	"queue",
+	"table",
+}
+
+func NewParseStorageURLFunction() function.Function {
+	return &ParseStorageURLFunction{}
+}
+
+func (p ParseStorageURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "parse_storage_url"
+}
+
+func (p ParseStorageURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "parse_storage_url",
+		Description:         "Parses a Storage Blob, Data Lake, File, Queue or Table URL and exposes the contained information",
+		MarkdownDescription: "Parses a Storage Blob, Data Lake, File, Queue or Table URL and exposes the contained information",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				Description:         "Storage URL",
+				MarkdownDescription: "Storage URL",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: storageUrlParseResultTypes,
+		},
+	}
+}
+
+func (p ParseStorageURLFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var input string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &input))
+
+	if response.Error != nil {
+		return
+	}
+
+	if len(input) == 0 {
+		response.Error = function.NewFuncError("Got empty URL")
+		return
+	}
+
+	u, err := url.Parse(input)
+	if err != nil {
+		response.Error = function.NewFuncError(fmt.Sprintf("Parsing Storage URL Error: %s", err))
+		return
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		response.Error = function.NewFuncError(fmt.Sprintf("expected an absolute URL in the format `https://{accountName}.{service}.{domainSuffix}/{path}`, got %q", input))
+		return
+	}
+
+	// e.g. `account1.blob.core.windows.net`
+	hostParts := strings.SplitN(u.Hostname(), ".", 3)
+	if len(hostParts) != 3 {
+		response.Error = function.NewFuncError(fmt.Sprintf("expected the host to be in the format `{accountName}.{service}.{domainSuffix}`, got %q", u.Hostname()))
+		return
+	}
+
+	service := strings.ToLower(hostParts[1])
+	if !slices.Contains(storageUrlServices, service) {
+		response.Error = function.NewFuncError(fmt.Sprintf("expected the service to be one of %s, got %q", strings.Join(storageUrlServices, ", "), hostParts[1]))
+		return
+	}
+
+	resourceName := ""
+	path := ""
+	if trimmed := strings.Trim(u.Path, "/"); trimmed != "" {
+		segments := strings.SplitN(trimmed, "/", 2)
+		resourceName = segments[0]
+		if len(segments) > 1 {
+			path = segments[1]
+		}
+	}
+
+	output := map[string]attr.Value{
+		"storage_account_name": types.StringValue(hostParts[0]),
+		"service":              types.StringValue(service),
+		"domain_suffix":        types.StringValue(hostParts[2]),
+		"service_endpoint":     types.StringValue(fmt.Sprintf("%s://%s/", u.Scheme, u.Host)),
+		"resource_name":        types.StringValue(resourceName),
+		"path":                 types.StringValue(path),
+	}
+
+	result, diags := types.ObjectValue(storageUrlParseResultTypes, output)
+	if diags.HasError() {
+		response.Error = function.ConcatFuncErrors(response.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionParseStorageURL_blob(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParseStorageUrlOutput("https://account1.blob.core.windows.net/container1/path/to/blob1.txt"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("storage_account_name", "account1"),
+					acceptance.TestCheckOutput("service", "blob"),
+					acceptance.TestCheckOutput("domain_suffix", "core.windows.net"),
+					acceptance.TestCheckOutput("service_endpoint", "https://account1.blob.core.windows.net/"),
+					acceptance.TestCheckOutput("resource_name", "container1"),
+					acceptance.TestCheckOutput("path", "path/to/blob1.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionParseStorageURL_fileShare(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParseStorageUrlOutput("https://account1.file.core.chinacloudapi.cn/share1"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("storage_account_name", "account1"),
+					acceptance.TestCheckOutput("service", "file"),
+					acceptance.TestCheckOutput("domain_suffix", "core.chinacloudapi.cn"),
+					acceptance.TestCheckOutput("service_endpoint", "https://account1.file.core.chinacloudapi.cn/"),
+					acceptance.TestCheckOutput("resource_name", "share1"),
+					acceptance.TestCheckOutput("path", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionParseStorageURL_invalidService(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testParseStorageUrlOutput("https://vault1.vault.azure.net/secrets/secret1"),
+				ExpectError: regexp.MustCompile("expected the service to be one of"),
+			},
+		},
+	})
+}
+
+func testParseStorageUrlOutput(url string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  parsed_url = provider::azurerm::parse_storage_url("%s")
+}
+
+output "storage_account_name" {
+  value = local.parsed_url["storage_account_name"]
+}
+
+output "service" {
+  value = local.parsed_url["service"]
+}
+
+output "domain_suffix" {
+  value = local.parsed_url["domain_suffix"]
+}
+
+output "service_endpoint" {
+  value = local.parsed_url["service_endpoint"]
+}
+
+output "resource_name" {
+  value = local.parsed_url["resource_name"]
+}
+
+output "path" {
+  value = local.parsed_url["path"]
+}
+`, url)
+}
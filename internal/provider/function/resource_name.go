@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"sync"
+
+	pluginsdkprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var (
+	resourceNameValidatorsOnce sync.Once
+	resourceNameValidators     map[string]pluginsdk.SchemaValidateFunc
+)
+
+// globallyUniqueResourceTypes are the Resource Types whose names are used within a DNS name, and as such must be
+// unique across all of Azure rather than just within the Resource Group
+var globallyUniqueResourceTypes = map[string]struct{}{
+	"azurerm_api_management":                             {},
+	"azurerm_app_configuration":                          {},
+	"azurerm_container_registry":                         {},
+	"azurerm_cosmosdb_account":                           {},
+	"azurerm_eventhub_namespace":                         {},
+	"azurerm_key_vault":                                  {},
+	"azurerm_key_vault_managed_hardware_security_module": {},
+	"azurerm_linux_function_app":                         {},
+	"azurerm_linux_web_app":                              {},
+	"azurerm_mssql_server":                               {},
+	"azurerm_mysql_flexible_server":                      {},
+	"azurerm_postgresql_flexible_server":                 {},
+	"azurerm_redis_cache":                                {},
+	"azurerm_search_service":                             {},
+	"azurerm_servicebus_namespace":                       {},
+	"azurerm_signalr_service":                            {},
+	"azurerm_storage_account":                            {},
+	"azurerm_web_pubsub":                                 {},
+	"azurerm_windows_function_app":                       {},
+	"azurerm_windows_web_app":                            {},
+}
+
+// resourceNameValidator returns the validation function used for the `name` field of the specified Resource Type,
+// these are built once from the Typed and Untyped Service Registrations
+func resourceNameValidator(resourceType string) (pluginsdk.SchemaValidateFunc, error) {
+	resourceNameValidatorsOnce.Do(func() {
+		resourceNameValidators = make(map[string]pluginsdk.SchemaValidateFunc)
+
+		for _, service := range pluginsdkprovider.SupportedTypedServices() {
+			for _, r := range service.Resources() {
+				if v, ok := r.Arguments()["name"]; ok && v.ValidateFunc != nil {
+					resourceNameValidators[r.ResourceType()] = v.ValidateFunc
+				}
+			}
+		}
+
+		for _, service := range pluginsdkprovider.SupportedUntypedServices() {
+			for resourceType, r := range service.SupportedResources() {
+				if v, ok := r.Schema["name"]; ok && v.ValidateFunc != nil {
+					resourceNameValidators[resourceType] = v.ValidateFunc
+				}
+			}
+		}
+	})
+
+	validateFunc, ok := resourceNameValidators[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("the resource type %q is not supported, either it does not exist or its `name` is not validated by the provider", resourceType)
+	}
+
+	return validateFunc, nil
+}
+
+// validateResourceName returns the errors, if any, when validating the name against the validation function for the `name` field
+func validateResourceName(validateFunc pluginsdk.SchemaValidateFunc, name string) []string {
+	_, errs := validateFunc(name, "name")
+
+	result := make([]string, 0, len(errs))
+	for _, err := range errs {
+		result = append(result, err.Error())
+	}
+
+	return result
+}
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	pluginsdkprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var (
+	resourceTypeIdValidatorsOnce sync.Once
+	resourceTypeIdValidators     map[string]resourceTypeIdValidator
+)
+
+type resourceTypeIdValidator struct {
+	validateFunc pluginsdk.IDValidationFunc
+
+	// named is whether the Resource Type has a `name` argument, Resource Types without one extend an existing
+	// Azure Resource (for example an association, or a setting on the parent resource) rather than managing it
+	named bool
+}
+
+// unknownResourceIds are Resource IDs which don't map to any Resource Type, used to exclude Resource Types whose ID
+// validation accepts any Resource ID (e.g. those which only check the ID is a valid Resource Manager ID)
+var unknownResourceIds = []string{
+	"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1/providers/Microsoft.Unknown/unknownResources/resource1",
+	"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1/providers/Microsoft.Unknown/unknownResources/resource1/nestedResources/resource2",
+}
+
+// resourceTypeKind defines the `kind` of the Azure Resource for Resource Types which share the same type of Resource ID,
+// where the (comma separated) `kind` must contain all of the `required` values and none of the `excluded` values
+type resourceTypeKind struct {
+	required []string
+	excluded []string
+}
+
+var resourceTypeKinds = map[string]resourceTypeKind{
+	"azurerm_function_app_flex_consumption": {required: []string{"functionapp", "linux"}, excluded: []string{"workflowapp"}},
+	"azurerm_linux_function_app":            {required: []string{"functionapp", "linux"}, excluded: []string{"workflowapp"}},
+	"azurerm_linux_function_app_slot":       {required: []string{"functionapp", "linux"}, excluded: []string{"workflowapp"}},
+	"azurerm_linux_web_app":                 {required: []string{"app", "linux"}},
+	"azurerm_linux_web_app_slot":            {required: []string{"app", "linux"}},
+	"azurerm_logic_app_standard":            {required: []string{"workflowapp"}},
+	"azurerm_windows_function_app":          {required: []string{"functionapp"}, excluded: []string{"linux", "workflowapp"}},
+	"azurerm_windows_function_app_slot":     {required: []string{"functionapp"}, excluded: []string{"linux", "workflowapp"}},
+	"azurerm_windows_web_app":               {required: []string{"app"}, excluded: []string{"linux"}},
+	"azurerm_windows_web_app_slot":          {required: []string{"app"}, excluded: []string{"linux"}},
+}
+
+// resourceIdValidators returns the function used to validate the Resource ID for each (non-deprecated) Resource Type,
+// these are built once from the Typed and Untyped Service Registrations
+func resourceIdValidators() map[string]resourceTypeIdValidator {
+	resourceTypeIdValidatorsOnce.Do(func() {
+		validators := make(map[string]resourceTypeIdValidator)
+
+		for _, service := range pluginsdkprovider.SupportedTypedServices() {
+			for _, r := range service.Resources() {
+				if _, ok := r.(sdk.ResourceWithDeprecationAndNoReplacement); ok {
+					continue
+				}
+				if _, ok := r.(sdk.ResourceWithDeprecationReplacedBy); ok {
+					continue
+				}
+
+				_, named := r.Arguments()["name"]
+				if v, ok := r.(sdk.ResourceWithIdentity); ok {
+					parser := resourceids.NewParserFromResourceIdType(v.Identity())
+					validators[r.ResourceType()] = resourceTypeIdValidator{
+						validateFunc: func(input string) error {
+							_, err := parser.Parse(input, false)
+							return err
+						},
+						named: named,
+					}
+					continue
+				}
+
+				validateFunc := r.IDValidationFunc()
+				validators[r.ResourceType()] = resourceTypeIdValidator{
+					validateFunc: func(input string) error {
+						if _, errs := validateFunc(input, "id"); len(errs) > 0 {
+							return errs[0]
+						}
+						return nil
+					},
+					named: named,
+				}
+			}
+		}
+
+		for _, service := range pluginsdkprovider.SupportedUntypedServices() {
+			for resourceType, r := range service.SupportedResources() {
+				if r.DeprecationMessage != "" {
+					continue
+				}
+
+				if validateFunc, ok := pluginsdk.IDValidationFuncForImporter(r.Importer); ok {
+					_, named := r.Schema["name"]
+					validators[resourceType] = resourceTypeIdValidator{
+						validateFunc: validateFunc,
+						named:        named,
+					}
+				}
+			}
+		}
+
+		resourceTypeIdValidators = make(map[string]resourceTypeIdValidator)
+		for resourceType, validator := range validators {
+			if acceptsUnknownResourceIds(validator.validateFunc) {
+				continue
+			}
+			resourceTypeIdValidators[resourceType] = validator
+		}
+	})
+
+	return resourceTypeIdValidators
+}
+
+func acceptsUnknownResourceIds(validateFunc pluginsdk.IDValidationFunc) bool {
+	for _, id := range unknownResourceIds {
+		if validateFunc(id) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceTypesForId returns the Resource Types whose Resource ID matches `id`, filtered by the `kind` of the Azure
+// Resource (when specified), sorted alphabetically. Resource Types which extend an existing Azure Resource are only
+// returned when no Resource Type manages the Azure Resource itself.
+func resourceTypesForId(id, kind string) []string {
+	// Resource IDs retrieved from elsewhere (for example Azure Resource Graph) may not be correctly cased
+	if recased, err := recaser.ReCaseKnownId(id); err == nil {
+		id = *recased
+	}
+
+	kinds := make(map[string]struct{})
+	for _, v := range strings.Split(strings.ToLower(kind), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			kinds[v] = struct{}{}
+		}
+	}
+
+	named := make([]string, 0)
+	extensions := make([]string, 0)
+	for resourceType, validator := range resourceIdValidators() {
+		if validator.validateFunc(id) != nil {
+			continue
+		}
+		if len(kinds) > 0 && !resourceTypeMatchesKind(resourceType, kinds) {
+			continue
+		}
+
+		if validator.named {
+			named = append(named, resourceType)
+		} else {
+			extensions = append(extensions, resourceType)
+		}
+	}
+
+	result := named
+	if len(result) == 0 {
+		result = extensions
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+func resourceTypeMatchesKind(resourceType string, kinds map[string]struct{}) bool {
+	if v, ok := resourceTypeKinds[resourceType]; ok {
+		for _, required := range v.required {
+			if _, ok := kinds[required]; !ok {
+				return false
+			}
+		}
+		for _, excluded := range v.excluded {
+			if _, ok := kinds[excluded]; ok {
+				return false
+			}
+		}
+		return true
+	}
+
+	// otherwise the Linux and Windows variants of a Resource Type can be distinguished when the kind contains the OS
+	_, isLinux := kinds["linux"]
+	_, isWindows := kinds["windows"]
+	if isLinux && strings.HasPrefix(resourceType, "azurerm_windows_") {
+		return false
+	}
+	if isWindows && strings.HasPrefix(resourceType, "azurerm_linux_") {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ResourceTypeForIDFunction struct{}
+
+var _ function.Function = ResourceTypeForIDFunction{}
+
+func NewResourceTypeForIDFunction() function.Function {
+	return &ResourceTypeForIDFunction{}
+}
+
+func (a ResourceTypeForIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "resource_type_for_id"
+}
+
+func (a ResourceTypeForIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "resource_type_for_id",
+		Description:         "Returns the Terraform Resource Types which manage the Azure Resource with the specified Resource ID, optionally filtered using the `kind` of the Azure Resource",
+		MarkdownDescription: "Returns the Terraform Resource Types which manage the Azure Resource with the specified Resource ID, optionally filtered using the `kind` of the Azure Resource",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				Description:         "Resource ID",
+				MarkdownDescription: "Resource ID",
+			},
+			function.StringParameter{
+				Name:                "kind",
+				Description:         "The `kind` of the Azure Resource, e.g. `functionapp,linux`, used to distinguish between Resource Types which share the same type of Resource ID. May be null or an empty string when unknown",
+				MarkdownDescription: "The `kind` of the Azure Resource, e.g. `functionapp,linux`, used to distinguish between Resource Types which share the same type of Resource ID. May be null or an empty string when unknown",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (a ResourceTypeForIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var id string
+	var kind types.String
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &id, &kind))
+
+	if response.Error != nil {
+		return
+	}
+
+	if !strings.HasPrefix(id, "/") {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected `id` to be a Resource ID starting with `/`, got %q", id))
+		return
+	}
+
+	result, diags := types.ListValueFrom(ctx, types.StringType, resourceTypesForId(id, kind.ValueString()))
+	if diags.HasError() {
+		response.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionResourceTypeForID_subnet(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				// the casing of the Resource ID is normalised
+				Config: testResourceTypeForIDOutput("/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1", "null"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("result", "azurerm_subnet"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionResourceTypeForID_kind(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceTypeForIDOutput("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1", `"functionapp"`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("result", "azurerm_windows_function_app"),
+				),
+			},
+			{
+				Config: testResourceTypeForIDOutput("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1", `"app,linux,container"`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("result", "azurerm_linux_web_app"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionResourceTypeForID_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testResourceTypeForIDOutput("not-a-resource-id", "null"),
+				ExpectError: regexp.MustCompile("expected `id` to be a Resource ID"),
+			},
+		},
+	})
+}
+
+func testResourceTypeForIDOutput(id, kind string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+output "result" {
+  value = join(",", provider::azurerm::resource_type_for_id("%s", %s))
+}
+`, id, kind)
+}
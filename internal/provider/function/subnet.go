@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+)
+
+const (
+	// subnetReservedAddresses is the number of addresses Azure reserves within each subnet, being the network
+	// address, the default gateway, two addresses for Azure DNS and the broadcast address
+	subnetReservedAddresses = 5
+
+	// subnetMaximumIPv4PrefixLength is the smallest IPv4 subnet supported by Azure (a /29)
+	subnetMaximumIPv4PrefixLength = 29
+
+	// subnetIPv6PrefixLength is the only size of IPv6 subnet supported by Azure (a /64)
+	subnetIPv6PrefixLength = 64
+)
+
+// parseSubnetPrefix parses a CIDR, ensuring that it's the network address of the range, e.g. `10.0.1.0/24` rather than `10.0.1.5/24`
+func parseSubnetPrefix(input string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(input)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("parsing %q as a CIDR: %+v", input, err)
+	}
+
+	if prefix.Masked() != prefix {
+		return netip.Prefix{}, fmt.Errorf("expected %q to be the network address of the range, i.e. %q", input, prefix.Masked().String())
+	}
+
+	return prefix, nil
+}
+
+// validateSubnetPrefixLength ensures that a subnet of the prefix length is supported by Azure
+func validateSubnetPrefixLength(is4 bool, prefixLength int) error {
+	if is4 && prefixLength > subnetMaximumIPv4PrefixLength {
+		return fmt.Errorf("the smallest IPv4 subnet supported by Azure is a /%d, got a /%d", subnetMaximumIPv4PrefixLength, prefixLength)
+	}
+
+	if !is4 && prefixLength != subnetIPv6PrefixLength {
+		return fmt.Errorf("IPv6 subnets in Azure must be a /%d, got a /%d", subnetIPv6PrefixLength, prefixLength)
+	}
+
+	return nil
+}
+
+// prefixSize returns the number of addresses within the prefix
+func prefixSize(prefix netip.Prefix) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+}
+
+func addrToInt(addr netip.Addr) *big.Int {
+	b := addr.AsSlice()
+	return new(big.Int).SetBytes(b)
+}
+
+func intToAddr(i *big.Int, is4 bool) netip.Addr {
+	length := 16
+	if is4 {
+		length = 4
+	}
+
+	b := make([]byte, length)
+	i.FillBytes(b)
+
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
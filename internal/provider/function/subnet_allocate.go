@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SubnetAllocateFunction struct{}
+
+var _ function.Function = SubnetAllocateFunction{}
+
+func NewSubnetAllocateFunction() function.Function {
+	return &SubnetAllocateFunction{}
+}
+
+func (a SubnetAllocateFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "subnet_allocate"
+}
+
+func (a SubnetAllocateFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "subnet_allocate",
+		Description:         "Returns the first free address prefix of the specified size within the address spaces of a Virtual Network which doesn't overlap with the existing subnets",
+		MarkdownDescription: "Returns the first free address prefix of the specified size within the address spaces of a Virtual Network which doesn't overlap with the existing subnets",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "vnet_cidrs",
+				Description:         "The address spaces of the Virtual Network in CIDR notation, which are searched in order",
+				MarkdownDescription: "The address spaces of the Virtual Network in CIDR notation, which are searched in order",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "existing_subnets",
+				Description:         "The address prefixes of the existing subnets in CIDR notation",
+				MarkdownDescription: "The address prefixes of the existing subnets in CIDR notation",
+				ElementType:         types.StringType,
+			},
+			function.Int64Parameter{
+				Name:                "size",
+				Description:         "The prefix length of the subnet to allocate, e.g. `24` for a /24",
+				MarkdownDescription: "The prefix length of the subnet to allocate, e.g. `24` for a /24",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (a SubnetAllocateFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var vnetCidrs, existingSubnets []string
+	var size int64
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &vnetCidrs, &existingSubnets, &size))
+
+	if response.Error != nil {
+		return
+	}
+
+	if len(vnetCidrs) == 0 {
+		response.Error = function.NewArgumentFuncError(0, "at least one address space must be specified")
+		return
+	}
+
+	addressSpaces := make([]netip.Prefix, 0, len(vnetCidrs))
+	for _, v := range vnetCidrs {
+		prefix, err := parseSubnetPrefix(v)
+		if err != nil {
+			response.Error = function.NewArgumentFuncError(0, err.Error())
+			return
+		}
+		addressSpaces = append(addressSpaces, prefix)
+	}
+
+	existing := make([]netip.Prefix, 0, len(existingSubnets))
+	for _, v := range existingSubnets {
+		prefix, err := parseSubnetPrefix(v)
+		if err != nil {
+			response.Error = function.NewArgumentFuncError(1, err.Error())
+			return
+		}
+		existing = append(existing, prefix)
+	}
+
+	for _, addressSpace := range addressSpaces {
+		if int(size) < addressSpace.Bits() || int(size) > addressSpace.Addr().BitLen() {
+			continue
+		}
+		if err := validateSubnetPrefixLength(addressSpace.Addr().Is4(), int(size)); err != nil {
+			response.Error = function.NewArgumentFuncError(2, err.Error())
+			return
+		}
+
+		if subnet, ok := allocateSubnet(addressSpace, existing, int(size)); ok {
+			response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, subnet.String()))
+			return
+		}
+	}
+
+	response.Error = function.NewFuncError(fmt.Sprintf("no free /%d address prefix was found within the address spaces %s", size, strings.Join(vnetCidrs, ", ")))
+}
+
+// allocateSubnet returns the first aligned prefix of the specified size within the address space which doesn't
+// overlap any of the existing subnets, skipping past any overlapping subnet rather than checking each candidate in turn
+func allocateSubnet(addressSpace netip.Prefix, existing []netip.Prefix, size int) (netip.Prefix, bool) {
+	is4 := addressSpace.Addr().Is4()
+	blockSize := new(big.Int).Lsh(big.NewInt(1), uint(addressSpace.Addr().BitLen()-size))
+
+	current := addrToInt(addressSpace.Addr())
+	end := new(big.Int).Add(current, prefixSize(addressSpace))
+
+	for new(big.Int).Add(current, blockSize).Cmp(end) <= 0 {
+		candidate := netip.PrefixFrom(intToAddr(current, is4), size)
+
+		var overlapping *netip.Prefix
+		for i := range existing {
+			if existing[i].Overlaps(candidate) {
+				overlapping = &existing[i]
+				break
+			}
+		}
+
+		if overlapping == nil {
+			return candidate, true
+		}
+
+		// move to the first aligned block after the end of both the candidate and the overlapping subnet
+		overlappingEnd := new(big.Int).Add(addrToInt(overlapping.Masked().Addr()), prefixSize(overlapping.Masked()))
+		candidateEnd := new(big.Int).Add(current, blockSize)
+		if overlappingEnd.Cmp(candidateEnd) < 0 {
+			overlappingEnd = candidateEnd
+		}
+
+		remainder := new(big.Int).Mod(overlappingEnd, blockSize)
+		if remainder.Sign() != 0 {
+			overlappingEnd.Add(overlappingEnd, new(big.Int).Sub(blockSize, remainder))
+		}
+		current = overlappingEnd
+	}
+
+	return netip.Prefix{}, false
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionSubnetAllocate_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetAllocateOutput(`["10.0.0.0/16"]`, `["10.0.0.0/24", "10.0.1.0/26"]`, 24),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("subnet", "10.0.2.0/24"),
+				),
+			},
+			{
+				Config: testSubnetAllocateOutput(`["10.0.0.0/16"]`, `["10.0.0.0/24", "10.0.1.0/26"]`, 26),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("subnet", "10.0.1.64/26"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionSubnetAllocate_multipleAddressSpaces(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetAllocateOutput(`["10.0.0.0/24", "10.1.0.0/16"]`, `["10.0.0.0/25", "10.0.0.128/25"]`, 27),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("subnet", "10.1.0.0/27"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionSubnetAllocate_full(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetAllocateOutput(`["10.0.0.0/24"]`, `["10.0.0.0/25", "10.0.0.128/25"]`, 27),
+				ExpectError: regexp.MustCompile("no free /27 address prefix was found"),
+			},
+		},
+	})
+}
+
+func testSubnetAllocateOutput(vnetCidrs, existingSubnets string, size int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+output "subnet" {
+  value = provider::azurerm::subnet_allocate(%s, %s, %d)
+}
+`, vnetCidrs, existingSubnets, size)
+}
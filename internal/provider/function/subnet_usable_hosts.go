@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type SubnetUsableHostsFunction struct{}
+
+var _ function.Function = SubnetUsableHostsFunction{}
+
+func NewSubnetUsableHostsFunction() function.Function {
+	return &SubnetUsableHostsFunction{}
+}
+
+func (a SubnetUsableHostsFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "subnet_usable_hosts"
+}
+
+func (a SubnetUsableHostsFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "subnet_usable_hosts",
+		Description:         "Returns the number of usable host addresses in an Azure subnet, excluding the 5 addresses reserved by Azure",
+		MarkdownDescription: "Returns the number of usable host addresses in an Azure subnet, excluding the 5 addresses reserved by Azure",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				Description:         "The address prefix of the subnet in CIDR notation, e.g. `10.0.1.0/24`",
+				MarkdownDescription: "The address prefix of the subnet in CIDR notation, e.g. `10.0.1.0/24`",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (a SubnetUsableHostsFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var cidr string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &cidr))
+
+	if response.Error != nil {
+		return
+	}
+
+	prefix, err := parseSubnetPrefix(cidr)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if err := validateSubnetPrefixLength(prefix.Addr().Is4(), prefix.Bits()); err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	usable := new(big.Int).Sub(prefixSize(prefix), big.NewInt(subnetReservedAddresses))
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, new(big.Float).SetInt(usable)))
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionSubnetUsableHosts_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetUsableHostsOutput("10.0.1.0/24"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("usable_hosts", "251"),
+				),
+			},
+			{
+				Config: testSubnetUsableHostsOutput("10.0.1.0/29"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("usable_hosts", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionSubnetUsableHosts_tooSmall(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetUsableHostsOutput("10.0.1.0/30"),
+				ExpectError: regexp.MustCompile("the smallest IPv4 subnet supported by Azure is a /29"),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionSubnetUsableHosts_notNetworkAddress(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testSubnetUsableHostsOutput("10.0.1.5/24"),
+				ExpectError: regexp.MustCompile("to be the network address of the range"),
+			},
+		},
+	})
+}
+
+func testSubnetUsableHostsOutput(cidr string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+output "usable_hosts" {
+  value = tostring(provider::azurerm::subnet_usable_hosts("%s"))
+}
+`, cidr)
+}
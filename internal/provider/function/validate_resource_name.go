@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ValidateResourceNameFunction struct{}
+
+var _ function.Function = ValidateResourceNameFunction{}
+
+var resourceNameValidationResultTypes = map[string]attr.Type{
+	"valid":           types.BoolType,
+	"errors":          types.ListType{}.WithElementType(types.StringType),
+	"globally_unique": types.BoolType,
+}
+
+func NewValidateResourceNameFunction() function.Function {
+	return &ValidateResourceNameFunction{}
+}
+
+func (a ValidateResourceNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "validate_resource_name"
+}
+
+func (a ValidateResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "validate_resource_name",
+		Description:         "Validates a name against the naming rules the provider applies to the `name` of the specified resource type",
+		MarkdownDescription: "Validates a name against the naming rules the provider applies to the `name` of the specified resource type",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "Terraform Resource Type, e.g. `azurerm_storage_account`",
+				MarkdownDescription: "Terraform Resource Type, e.g. `azurerm_storage_account`",
+			},
+			function.StringParameter{
+				Name:                "name",
+				Description:         "The name to validate",
+				MarkdownDescription: "The name to validate",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: resourceNameValidationResultTypes,
+		},
+	}
+}
+
+func (a ValidateResourceNameFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType, name string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType, &name))
+
+	if response.Error != nil {
+		return
+	}
+
+	validateFunc, err := resourceNameValidator(resourceType)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	errs := validateResourceName(validateFunc, name)
+
+	errorsValue, diags := types.ListValueFrom(ctx, types.StringType, errs)
+	if diags.HasError() {
+		response.Error = function.NewFuncError("failed to flatten validation errors")
+		return
+	}
+
+	_, globallyUnique := globallyUniqueResourceTypes[resourceType]
+
+	output := map[string]attr.Value{
+		"valid":           types.BoolValue(len(errs) == 0),
+		"errors":          errorsValue,
+		"globally_unique": types.BoolValue(globallyUnique),
+	}
+
+	result, diags := types.ObjectValue(resourceNameValidationResultTypes, output)
+	if diags.HasError() {
+		response.Error = function.ConcatFuncErrors(response.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
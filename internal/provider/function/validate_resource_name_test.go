@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionValidateResourceName_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testValidateResourceNameOutput("azurerm_storage_account", "examplestorage1"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("valid", "true"),
+					acceptance.TestCheckOutput("error_count", "0"),
+					acceptance.TestCheckOutput("globally_unique", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionValidateResourceName_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testValidateResourceNameOutput("azurerm_key_vault", "1-example--vault"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("valid", "false"),
+					acceptance.TestCheckOutput("error_count", "2"),
+					acceptance.TestCheckOutput("globally_unique", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionValidateResourceName_unsupportedResourceType(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testValidateResourceNameOutput("azurerm_does_not_exist", "example"),
+				ExpectError: regexp.MustCompile("is not supported"),
+			},
+		},
+	})
+}
+
+func testValidateResourceNameOutput(resourceType, name string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  result = provider::azurerm::validate_resource_name("%s", "%s")
+}
+
+output "valid" {
+  value = tostring(local.result["valid"])
+}
+
+output "error_count" {
+  value = tostring(length(local.result["errors"]))
+}
+
+output "globally_unique" {
+  value = tostring(local.result["globally_unique"])
+}
+`, resourceType, name)
+}
@@ -32,9 +32,9 @@ func TestAzureProvider() *schema.Provider {
 }
 
 // AzureProviderWithTestName returns provider for a specific test name when testing under go-vcr where context-awareness
-// is required.
-func AzureProviderWithTestName(testName string) *schema.Provider {
-	return azureProvider(false, testName)
+// is required. The ClientBuilderOptions allow the acceptance tests to customise how the Client is built.
+func AzureProviderWithTestName(testName string, builderOptions ...clients.ClientBuilderOption) *schema.Provider {
+	return azureProvider(false, testName, builderOptions...)
 }
 
 func ValidatePartnerID(i interface{}, k string) ([]string, []error) {
@@ -86,7 +86,7 @@ func ValidatePartnerID(i interface{}, k string) ([]string, []error) {
 	}
 }
 
-func azureProvider(supportLegacyTestSuite bool, testName string) *schema.Provider {
+func azureProvider(supportLegacyTestSuite bool, testName string, builderOptions ...clients.ClientBuilderOption) *schema.Provider {
 	dataSources := make(map[string]*schema.Resource)
 	resources := make(map[string]*schema.Resource)
 
@@ -141,6 +141,8 @@ func azureProvider(supportLegacyTestSuite bool, testName string) *schema.Provide
 				panic(fmt.Sprintf("An existing Resource exists for %q", k))
 			}
 
+			sdk.HandleUntypedInterruptions(k, v)
+			sdk.HandleUntypedPolicyViolations(v)
 			resources[k] = v
 		}
 	}
@@ -383,6 +385,20 @@ func azureProvider(supportLegacyTestSuite bool, testName string) *schema.Provide
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
 
+			"batch_resource_manager_reads": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_BATCH_RESOURCE_MANAGER_READS", false),
+				Description: "Should the AzureRM Provider combine concurrent read requests to Resource Manager into batch requests? This reduces the number of requests made when refreshing a large number of resources.",
+			},
+
+			"cache_resource_manager_reads": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CACHE_RESOURCE_MANAGER_READS", false),
+				Description: "Should the AzureRM Provider cache the responses to read requests to Resource Manager for the duration of the plan/apply? Cached responses are invalidated when the resource, or a parent/nested resource, is modified.",
+			},
+
 			"enhanced_validation": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -410,7 +426,7 @@ func azureProvider(supportLegacyTestSuite bool, testName string) *schema.Provide
 		ResourcesMap:   resources,
 	}
 
-	p.ConfigureContextFunc = providerConfigure(p, testName)
+	p.ConfigureContextFunc = providerConfigure(p, testName, builderOptions...)
 
 	if !providerfeatures.FivePointOh() {
 		p.Schema["resource_provider_registrations"].DefaultFunc = schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_REGISTRATIONS", resourceproviders.ProviderRegistrationsLegacy)
@@ -422,7 +438,7 @@ func azureProvider(supportLegacyTestSuite bool, testName string) *schema.Provide
 // providerConfigure is used to configure the cloud environment and authentication.
 // To configure behavioral aspects of the provider, use the buildClient function instead.
 // This separation allows us to robustly test different authentication scenarios.
-func providerConfigure(p *schema.Provider, testName string) schema.ConfigureContextFunc {
+func providerConfigure(p *schema.Provider, testName string, builderOptions ...clients.ClientBuilderOption) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		subscriptionId := d.Get("subscription_id").(string)
 		if subscriptionId == "" {
@@ -527,13 +543,13 @@ func providerConfigure(p *schema.Provider, testName string) schema.ConfigureCont
 			EnableAuthenticationUsingADOPipelineOIDC:   enableOidc,
 		}
 
-		return buildClient(ctx, p, d, authConfig, testName)
+		return buildClient(ctx, p, d, authConfig, testName, builderOptions...)
 	}
 }
 
 // buildClient is used to configure behavioral aspects of the provider. To configure the
 // cloud environment and authentication-related settings, use the providerConfigure function.
-func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials, testName string) (*clients.Client, diag.Diagnostics) {
+func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials, testName string, builderOptions ...clients.ClientBuilderOption) (*clients.Client, diag.Diagnostics) {
 	providerRegistrations := d.Get("resource_provider_registrations").(string)
 
 	// TODO: Remove in v5.0
@@ -585,6 +601,8 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 
 	clientBuilder := clients.ClientBuilder{
 		AuthConfig:                  authConfig,
+		BatchResourceManagerReads:   d.Get("batch_resource_manager_reads").(bool),
+		CacheResourceManagerReads:   d.Get("cache_resource_manager_reads").(bool),
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    features,
@@ -601,6 +619,10 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		CustomCorrelationRequestID: os.Getenv("ARM_CORRELATION_REQUEST_ID"),
 	}
 
+	for _, option := range builderOptions {
+		option(&clientBuilder)
+	}
+
 	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
 	stopCtx, ok := schema.StopContext(ctx) //nolint:staticcheck
 	if !ok {
@@ -619,8 +641,9 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 	ctx2, cancel := context.WithTimeout(ctx, 30*time.Minute)
 	defer cancel()
 
-	// Skip this if we're running VCR, it creates too much noise in the cassette
-	if os.Getenv("TC_TEST_VIA_VCR") == "" {
+	// Skip this if we're running VCR, it creates too much noise in the cassette - or when the acceptance tests have
+	// configured a custom Transport (such as the ARM Emulator, which treats all Resource Providers as registered)
+	if os.Getenv("TC_TEST_VIA_VCR") == "" && clientBuilder.Transport == nil {
 		if err = resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, requiredResourceProviders); err != nil {
 			return nil, diag.FromErr(err)
 		}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+)
+
+// ParseResourceType returns the Subscription ID, Resource Provider namespace and (nested) Resource Type of the Resource
+// Manager ID `input`, e.g. `Microsoft.Sql` and `servers/encryptionProtector`. The Subscription ID is empty for Resources
+// outside of a Subscription.
+func ParseResourceType(input string) (subscriptionId, providerNamespace, resourceType string, err error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+
+	providerIndex := -1
+	for i := 0; i < len(segments)-1; i++ {
+		switch {
+		case strings.EqualFold(segments[i], "subscriptions") && subscriptionId == "":
+			subscriptionId = segments[i+1]
+		case strings.EqualFold(segments[i], "providers"):
+			providerIndex = i
+		}
+	}
+
+	if providerIndex == -1 {
+		return "", "", "", fmt.Errorf("expected %q to be a Resource Manager ID containing a Resource Provider", input)
+	}
+
+	typeSegments := segments[providerIndex+2:]
+	if len(typeSegments) < 2 || len(typeSegments)%2 != 0 {
+		return "", "", "", fmt.Errorf("expected %q to be a Resource Manager ID for a Resource within the Resource Provider %q", input, segments[providerIndex+1])
+	}
+
+	resourceTypes := make([]string, 0)
+	for i := 0; i < len(typeSegments); i += 2 {
+		resourceTypes = append(resourceTypes, typeSegments[i])
+	}
+
+	return subscriptionId, segments[providerIndex+1], strings.Join(resourceTypes, "/"), nil
+}
+
+// LatestApiVersion returns the latest stable API version for `resourceType`, falling back to the latest preview API
+// version when the Resource Type has no stable API version
+func LatestApiVersion(resourceType string, availableResourceTypes []providers.ProviderResourceType) string {
+	for _, item := range availableResourceTypes {
+		if item.ResourceType == nil || item.ApiVersions == nil || !strings.EqualFold(*item.ResourceType, resourceType) {
+			continue
+		}
+
+		apiVersions := *item.ApiVersions
+		for _, apiVersion := range apiVersions {
+			if !strings.Contains(strings.ToLower(apiVersion), "preview") {
+				return apiVersion
+			}
+		}
+		if len(apiVersions) > 0 {
+			return apiVersions[0]
+		}
+	}
+
+	return ""
+}
+
+// ApiVersionsForResourceTypes returns the latest API version for each of `resourceTypes` within the Resource Provider
+// `providerId`, Resource Types the Resource Provider doesn't register are omitted
+func ApiVersionsForResourceTypes(ctx context.Context, client *providers.ProvidersClient, providerId providers.SubscriptionProviderId, resourceTypes ...string) (map[string]string, error) {
+	resp, err := client.Get(ctx, providerId, providers.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", providerId, err)
+	}
+
+	available := make([]providers.ProviderResourceType, 0)
+	if model := resp.Model; model != nil && model.ResourceTypes != nil {
+		available = *model.ResourceTypes
+	}
+
+	output := make(map[string]string)
+	for _, resourceType := range resourceTypes {
+		if apiVersion := LatestApiVersion(resourceType, available); apiVersion != "" {
+			output[resourceType] = apiVersion
+		}
+	}
+
+	return output, nil
+}
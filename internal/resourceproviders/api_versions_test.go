@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+)
+
+func TestParseResourceType(t *testing.T) {
+	testCases := []struct {
+		input             string
+		subscriptionId    string
+		providerNamespace string
+		resourceType      string
+		valid             bool
+	}{
+		{
+			input: "",
+			valid: false,
+		},
+		{
+			// no Resource Provider
+			input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			valid: false,
+		},
+		{
+			// missing the Resource name
+			input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers",
+			valid: false,
+		},
+		{
+			input:             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1",
+			subscriptionId:    "12345678-1234-9876-4563-123456789012",
+			providerNamespace: "Microsoft.Sql",
+			resourceType:      "servers",
+			valid:             true,
+		},
+		{
+			input:             "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/encryptionProtector/current",
+			subscriptionId:    "12345678-1234-9876-4563-123456789012",
+			providerNamespace: "Microsoft.Sql",
+			resourceType:      "servers/encryptionProtector",
+			valid:             true,
+		},
+		{
+			// Resources outside of a Subscription
+			input:             "/providers/Microsoft.Management/managementGroups/group1",
+			providerNamespace: "Microsoft.Management",
+			resourceType:      "managementGroups",
+			valid:             true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Testing %q", testCase.input)
+
+		subscriptionId, providerNamespace, resourceType, err := ParseResourceType(testCase.input)
+		if err != nil {
+			if testCase.valid {
+				t.Fatalf("expected %q to be valid but got: %+v", testCase.input, err)
+			}
+			continue
+		}
+		if !testCase.valid {
+			t.Fatalf("expected %q to be invalid", testCase.input)
+		}
+
+		if subscriptionId != testCase.subscriptionId {
+			t.Fatalf("expected Subscription ID %q but got %q", testCase.subscriptionId, subscriptionId)
+		}
+		if providerNamespace != testCase.providerNamespace {
+			t.Fatalf("expected Resource Provider %q but got %q", testCase.providerNamespace, providerNamespace)
+		}
+		if resourceType != testCase.resourceType {
+			t.Fatalf("expected Resource Type %q but got %q", testCase.resourceType, resourceType)
+		}
+	}
+}
+
+func TestLatestApiVersion(t *testing.T) {
+	resourceTypes := []providers.ProviderResourceType{
+		{
+			ResourceType: pointer.To("servers"),
+			ApiVersions:  pointer.To([]string{"2024-01-01-preview", "2023-08-01", "2023-05-01-preview"}),
+		},
+		{
+			ResourceType: pointer.To("servers/privateEndpointConnections"),
+			ApiVersions:  pointer.To([]string{"2024-05-01-preview"}),
+		},
+	}
+
+	testCases := []struct {
+		resourceType string
+		expected     string
+	}{
+		{
+			resourceType: "servers",
+			expected:     "2023-08-01",
+		},
+		{
+			// Resource Types are matched case-insensitively
+			resourceType: "Servers",
+			expected:     "2023-08-01",
+		},
+		{
+			// falls back to a preview API version when there's no stable API version
+			resourceType: "servers/privateEndpointConnections",
+			expected:     "2024-05-01-preview",
+		},
+		{
+			resourceType: "managedInstances",
+			expected:     "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		if actual := LatestApiVersion(testCase.resourceType, resourceTypes); actual != testCase.expected {
+			t.Fatalf("expected %q for %q but got %q", testCase.expected, testCase.resourceType, actual)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// DefaultCancellationTimeout is the default duration to wait for an interrupted operation to be cancelled in Azure
+const DefaultCancellationTimeout = 5 * time.Minute
+
+// interruptedOperationMessage prefixes the messages added to the errors of interrupted operations, so that an error is
+// only annotated once - e.g. when it's already been cancelled using CancelInterruptedOperation
+const interruptedOperationMessage = "The operation was interrupted"
+
+// CancelInterruptedOperation cancels the in-flight long-running operation using `cancel` when `err` was caused by `ctx`
+// being cancelled or reaching its deadline - for example when Terraform is interrupted, or the timeout is reached.
+//
+// Since `ctx` has expired, `cancel` is called with a new context bounded by DefaultCancellationTimeout. The returned
+// error contains `err` alongside the outcome of the cancellation. When `err` is nil, or wasn't caused by `ctx`, it's
+// returned as-is.
+func CancelInterruptedOperation(ctx context.Context, err error, cancel func(ctx context.Context) error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+
+	cancelCtx, cancelFunc := context.WithTimeout(context.WithoutCancel(ctx), DefaultCancellationTimeout)
+	defer cancelFunc()
+
+	if cancelErr := cancel(cancelCtx); cancelErr != nil {
+		return fmt.Errorf("%w\n\n%s and could not be cancelled, as such it may still be in progress in Azure: %+v", err, interruptedOperationMessage, cancelErr)
+	}
+
+	return fmt.Errorf("%w\n\n%s and has been cancelled in Azure", err, interruptedOperationMessage)
+}
+
+// interruptedOperationError returns `err` with a message that the operation may still be in progress in Azure, unless
+// the operation has already been cancelled (or the error already contains this message)
+func interruptedOperationError(err error, operation, resourceType string) error {
+	if strings.Contains(err.Error(), interruptedOperationMessage) {
+		return err
+	}
+
+	return fmt.Errorf("%w\n\n%s and the %s of this %s may still be in progress in Azure - this must complete before the resource can be modified again", err, interruptedOperationMessage, operation, resourceType)
+}
+
+// handleInterruption is called when the Create, Update or Delete function for a Typed Resource returns an error. When
+// the error is the result of the operation being interrupted a message that it may still be in progress is added.
+func (rw *ResourceWrapper) handleInterruption(ctx context.Context, operation string, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+
+	return interruptedOperationError(err, operation, rw.resource.ResourceType())
+}
+
+// HandleUntypedInterruptions wraps the Create, Update and Delete functions of the Untyped Resource `resource` so that
+// when an operation is interrupted (either when Terraform is interrupted, or the timeout is reached) the returned
+// error contains a message that the operation may still be in progress in Azure.
+//
+// This only adds a message, the operation isn't cancelled - resources which can cancel an operation must call
+// CancelInterruptedOperation themselves. Since Untyped Resources create their own context, an operation which returns
+// an error once its timeout has elapsed is assumed to have reached the timeout.
+func HandleUntypedInterruptions(resourceType string, resource *pluginsdk.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error, operation, timeoutKey string) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			started := time.Now()
+			err := f(d, meta)
+			if err == nil || !untypedOperationInterrupted(meta, time.Since(started), d.Timeout(timeoutKey)) {
+				return err
+			}
+
+			return interruptedOperationError(err, operation, resourceType)
+		}
+	}
+
+	//nolint:staticcheck
+	resource.Create = wrap(resource.Create, "creation", schema.TimeoutCreate)
+	//nolint:staticcheck
+	resource.Update = wrap(resource.Update, "update", schema.TimeoutUpdate)
+	//nolint:staticcheck
+	resource.Delete = wrap(resource.Delete, "deletion", schema.TimeoutDelete)
+}
+
+// untypedOperationInterrupted returns whether an operation of an Untyped Resource was interrupted, since these derive
+// their context from the Provider's StopContext and the operation's timeout rather than being passed it
+func untypedOperationInterrupted(meta interface{}, elapsed, timeout time.Duration) bool {
+	if client, ok := meta.(*clients.Client); ok && client != nil && client.StopContext != nil && client.StopContext.Err() != nil {
+		return true
+	}
+
+	return timeout > 0 && elapsed >= timeout
+}
@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestCancelInterruptedOperation(t *testing.T) {
+	operationErr := errors.New("polling failed: context canceled")
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testData := []struct {
+		name           string
+		ctx            context.Context
+		err            error
+		cancelErr      error
+		expectCancel   bool
+		expectContains string
+	}{
+		{
+			name: "no error",
+			ctx:  cancelled,
+		},
+		{
+			name:           "error without interruption",
+			ctx:            context.Background(),
+			err:            operationErr,
+			expectContains: operationErr.Error(),
+		},
+		{
+			name:           "interrupted and cancelled",
+			ctx:            cancelled,
+			err:            operationErr,
+			expectCancel:   true,
+			expectContains: "has been cancelled in Azure",
+		},
+		{
+			name:           "interrupted and cancellation failed",
+			ctx:            cancelled,
+			err:            operationErr,
+			cancelErr:      errors.New("conflict"),
+			expectCancel:   true,
+			expectContains: "may still be in progress in Azure: conflict",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		called := false
+		actual := CancelInterruptedOperation(v.ctx, v.err, func(ctx context.Context) error {
+			called = true
+			if ctx.Err() != nil {
+				t.Fatalf("expected the cancellation context to be active but got %+v", ctx.Err())
+			}
+			return v.cancelErr
+		})
+
+		if called != v.expectCancel {
+			t.Fatalf("expected cancellation to be called %t but got %t", v.expectCancel, called)
+		}
+		if v.err == nil {
+			if actual != nil {
+				t.Fatalf("expected no error but got %+v", actual)
+			}
+			continue
+		}
+		if !errors.Is(actual, v.err) {
+			t.Fatalf("expected the error to wrap %q but got %q", v.err, actual)
+		}
+		if !strings.Contains(actual.Error(), v.expectContains) {
+			t.Fatalf("expected the error to contain %q but got %q", v.expectContains, actual)
+		}
+	}
+}
+
+func TestInterruptedOperationError(t *testing.T) {
+	operationErr := errors.New("polling failed: context deadline exceeded")
+
+	actual := interruptedOperationError(operationErr, "creation", "azurerm_example")
+	if !errors.Is(actual, operationErr) {
+		t.Fatalf("expected the error to wrap %q but got %q", operationErr, actual)
+	}
+	if !strings.Contains(actual.Error(), "the creation of this azurerm_example may still be in progress in Azure") {
+		t.Fatalf("expected the error to contain the interruption message but got %q", actual)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	cancelledErr := CancelInterruptedOperation(cancelled, operationErr, func(ctx context.Context) error {
+		return nil
+	})
+	if actual := interruptedOperationError(cancelledErr, "creation", "azurerm_example"); actual != cancelledErr {
+		t.Fatalf("expected an error for a cancelled operation to be returned as-is but got %q", actual)
+	}
+}
+
+func TestUntypedOperationInterrupted(t *testing.T) {
+	stopped, stop := context.WithCancel(context.Background())
+	stop()
+
+	testData := []struct {
+		name     string
+		meta     interface{}
+		elapsed  time.Duration
+		timeout  time.Duration
+		expected bool
+	}{
+		{
+			name:    "within timeout",
+			meta:    &clients.Client{StopContext: context.Background()},
+			elapsed: time.Minute,
+			timeout: time.Hour,
+		},
+		{
+			name:     "timeout reached",
+			meta:     &clients.Client{StopContext: context.Background()},
+			elapsed:  time.Hour,
+			timeout:  time.Hour,
+			expected: true,
+		},
+		{
+			name:     "stopped",
+			meta:     &clients.Client{StopContext: stopped},
+			elapsed:  time.Minute,
+			timeout:  time.Hour,
+			expected: true,
+		},
+		{
+			name:    "no client",
+			elapsed: time.Minute,
+			timeout: time.Hour,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := untypedOperationInterrupted(v.meta, v.elapsed, v.timeout); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestHandleUntypedInterruptions(t *testing.T) {
+	operationErr := errors.New("creating example: context canceled")
+	stopped, stop := context.WithCancel(context.Background())
+	stop()
+
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{},
+		Create: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return operationErr
+		},
+		Delete: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	HandleUntypedInterruptions("azurerm_example", resource)
+
+	d := resource.TestResourceData()
+	//nolint:staticcheck
+	if err := resource.Create(d, &clients.Client{StopContext: context.Background()}); err != operationErr {
+		t.Fatalf("expected an error which wasn't interrupted to be returned as-is but got %q", err)
+	}
+	//nolint:staticcheck
+	if err := resource.Create(d, &clients.Client{StopContext: stopped}); err == nil || !strings.Contains(err.Error(), "may still be in progress in Azure") {
+		t.Fatalf("expected the error to contain the interruption message but got %q", err)
+	}
+	//nolint:staticcheck
+	if err := resource.Delete(d, &clients.Client{StopContext: stopped}); err != nil {
+		t.Fatalf("expected no error but got %q", err)
+	}
+	//nolint:staticcheck
+	if resource.Update != nil {
+		t.Fatalf("expected Update to remain nil")
+	}
+
+}
@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	assignments "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	policyClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// policyViolationResolutionTimeout is the maximum duration spent looking up the details of the Policy Assignments
+// which denied a request - since this is only used to enrich an error, this is best-effort
+const policyViolationResolutionTimeout = 2 * time.Minute
+
+// policyEffectParameterRegex matches a Policy Rule effect which is specified using a parameter, e.g. `[parameters('effect')]`
+var policyEffectParameterRegex = regexp.MustCompile(`^\[parameters\('([^']+)'\)\]$`)
+
+// errorDiagnostics returns the diagnostics for `err` - when the request was denied by Azure Policy a diagnostic
+// is returned for each Policy Violation, describing the Policy Assignment, Definition and the fields evaluated.
+func errorDiagnostics(ctx context.Context, meta interface{}, err error) diag.Diagnostics {
+	violations := azure.ParsePolicyViolations(err)
+	if len(violations) == 0 {
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       err.Error(),
+				Detail:        err.Error(),
+				AttributePath: nil,
+			},
+		}
+	}
+
+	if client, ok := meta.(*clients.Client); ok && client != nil && client.Policy != nil {
+		// the context may have already been cancelled or exceeded its deadline (e.g. the operation timed out)
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), policyViolationResolutionTimeout)
+		defer cancel()
+
+		for i := range violations {
+			resolvePolicyViolation(ctx, client.Policy, &violations[i])
+		}
+	}
+
+	out := make(diag.Diagnostics, 0, len(violations))
+	for _, v := range violations {
+		out = append(out, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       v.Summary(),
+			Detail:        fmt.Sprintf("%s\n\n%s", v.Detail(), err.Error()),
+			AttributePath: nil,
+		})
+	}
+
+	return out
+}
+
+// HandleUntypedPolicyViolations wraps the Create, Update and Delete functions of the Untyped Resource `resource` so that,
+// as for Typed Resources, when a request is denied by Azure Policy the Policy Violations are returned as diagnostics.
+//
+// The wrapped functions are registered as the `WithoutTimeout` variants, since Untyped Resources derive their context
+// from the Provider's StopContext and the operation's timeout themselves.
+func HandleUntypedPolicyViolations(resource *pluginsdk.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := f(d, meta); err != nil {
+				return errorDiagnostics(ctx, meta, err)
+			}
+
+			return nil
+		}
+	}
+
+	//nolint:staticcheck
+	if resource.Create != nil {
+		resource.CreateWithoutTimeout = wrap(resource.Create)
+		resource.Create = nil
+	}
+	//nolint:staticcheck
+	if resource.Update != nil {
+		resource.UpdateWithoutTimeout = wrap(resource.Update)
+		resource.Update = nil
+	}
+	//nolint:staticcheck
+	if resource.Delete != nil {
+		resource.DeleteWithoutTimeout = wrap(resource.Delete)
+		resource.Delete = nil
+	}
+}
+
+// resolvePolicyViolation populates the details which aren't included in the error returned by ARM (which, depending
+// on the API, may only contain the IDs of the Policy Assignment and Definition) using the Policy clients
+func resolvePolicyViolation(ctx context.Context, client *policyClient.Client, v *azure.PolicyViolation) {
+	var assignmentParameters map[string]assignments.ParameterValuesValue
+	if client.AssignmentsClient != nil {
+		id := assignments.NewPolicyAssignmentIdID(v.PolicyAssignmentId)
+		resp, err := client.AssignmentsClient.GetById(ctx, id)
+		if err != nil {
+			log.Printf("[DEBUG] retrieving Policy Assignment %q to describe Policy Violation: %+v", v.PolicyAssignmentId, err)
+		} else if model := resp.Model; model != nil {
+			if v.PolicyAssignmentName == "" {
+				v.PolicyAssignmentName = pointer.From(model.Name)
+			}
+			if props := model.Properties; props != nil {
+				if v.PolicyAssignmentDisplayName == "" {
+					v.PolicyAssignmentDisplayName = pointer.From(props.DisplayName)
+				}
+				if v.PolicyAssignmentScope == "" {
+					v.PolicyAssignmentScope = pointer.From(props.Scope)
+				}
+				if v.PolicyDefinitionId == "" {
+					v.PolicyDefinitionId = pointer.From(props.PolicyDefinitionId)
+				}
+				assignmentParameters = pointer.From(props.Parameters)
+			}
+		}
+	}
+
+	if (v.PolicyDefinitionDisplayName == "" || v.PolicyDefinitionEffect == "") && v.PolicyDefinitionId != "" && client.DefinitionsClient != nil {
+		resolvePolicyDefinition(ctx, client, v, assignmentParameters)
+	}
+
+	if client.ExemptionsClient != nil {
+		filter := fmt.Sprintf("policyAssignmentId eq '%s'", v.PolicyAssignmentId)
+		iterator, err := client.ExemptionsClient.ListComplete(ctx, filter)
+		if err != nil {
+			log.Printf("[DEBUG] listing Policy Exemptions for Policy Assignment %q to describe Policy Violation: %+v", v.PolicyAssignmentId, err)
+			return
+		}
+
+		for iterator.NotDone() {
+			exemption := iterator.Value()
+			if props := exemption.ExemptionProperties; props != nil {
+				referenceIds := pointer.From(props.PolicyDefinitionReferenceIds)
+				if v.PolicyDefinitionReferenceId == "" || len(referenceIds) == 0 || containsInsensitively(referenceIds, v.PolicyDefinitionReferenceId) {
+					expiresOn := ""
+					if props.ExpiresOn != nil {
+						expiresOn = props.ExpiresOn.String()
+					}
+
+					v.Exemptions = append(v.Exemptions, azure.PolicyExemption{
+						Id:                           pointer.From(exemption.ID),
+						DisplayName:                  pointer.From(props.DisplayName),
+						Category:                     string(props.ExemptionCategory),
+						ExpiresOn:                    expiresOn,
+						PolicyDefinitionReferenceIds: referenceIds,
+					})
+				}
+			}
+
+			if err := iterator.NextWithContext(ctx); err != nil {
+				log.Printf("[DEBUG] listing Policy Exemptions for Policy Assignment %q to describe Policy Violation: %+v", v.PolicyAssignmentId, err)
+				return
+			}
+		}
+	}
+}
+
+func resolvePolicyDefinition(ctx context.Context, client *policyClient.Client, v *azure.PolicyViolation, assignmentParameters map[string]assignments.ParameterValuesValue) {
+	id, err := parse.PolicyDefinitionID(v.PolicyDefinitionId)
+	if err != nil {
+		// this is a Policy Set Definition (or otherwise can't be looked up)
+		log.Printf("[DEBUG] parsing Policy Definition ID %q to describe Policy Violation: %+v", v.PolicyDefinitionId, err)
+		return
+	}
+
+	var definition policy.Definition
+	switch scope := id.PolicyScopeId.(type) {
+	case parse.ScopeAtManagementGroup:
+		definition, err = client.DefinitionsClient.GetAtManagementGroup(ctx, id.Name, scope.ManagementGroupName)
+	case parse.ScopeAtSubscription:
+		definition, err = client.DefinitionsClient.Get(ctx, id.Name)
+	default:
+		definition, err = client.DefinitionsClient.GetBuiltIn(ctx, id.Name)
+	}
+	if err != nil {
+		log.Printf("[DEBUG] retrieving Policy Definition %q to describe Policy Violation: %+v", v.PolicyDefinitionId, err)
+		return
+	}
+
+	props := definition.DefinitionProperties
+	if props == nil {
+		return
+	}
+
+	if v.PolicyDefinitionDisplayName == "" {
+		v.PolicyDefinitionDisplayName = pointer.From(props.DisplayName)
+	}
+
+	if v.PolicyDefinitionEffect != "" {
+		return
+	}
+
+	rule, ok := props.PolicyRule.(map[string]interface{})
+	if !ok {
+		return
+	}
+	then, ok := rule["then"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	effect, ok := then["effect"].(string)
+	if !ok {
+		return
+	}
+
+	// the effect is commonly parameterised, in which case it's the value specified on the assignment (if any) or the default
+	if matches := policyEffectParameterRegex.FindStringSubmatch(effect); len(matches) == 2 {
+		effect = ""
+		if value, ok := assignmentParameters[matches[1]]; ok && value.Value != nil {
+			effect = fmt.Sprintf("%v", *value.Value)
+		} else if param, ok := props.Parameters[matches[1]]; ok && param != nil && param.DefaultValue != nil {
+			effect = fmt.Sprintf("%v", param.DefaultValue)
+		}
+	}
+
+	v.PolicyDefinitionEffect = effect
+}
+
+func containsInsensitively(input []string, value string) bool {
+	for _, v := range input {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
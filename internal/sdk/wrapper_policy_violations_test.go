@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestHandleUntypedPolicyViolations(t *testing.T) {
+	policyViolationBody := `{"error":{"code":"RequestDisallowedByPolicy","target":"acctestsa","message":"Resource 'acctestsa' was disallowed by policy.","additionalInfo":[{"type":"PolicyViolation","info":{"policyDefinitionId":"/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c","policyDefinitionName":"e56962a6-4747-49cd-b67b-bf8b01975c4c","policyDefinitionDisplayName":"Allowed locations","policyDefinitionEffect":"deny","policyAssignmentId":"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/allowed-locations","policyAssignmentName":"allowed-locations","policyAssignmentDisplayName":"Allowed locations","policyAssignmentScope":"/subscriptions/00000000-0000-0000-0000-000000000000"}}]}}`
+	policyViolationErr := fmt.Errorf("creating Storage Account: unexpected status 403 (403 Forbidden) with error: RequestDisallowedByPolicy: Resource was disallowed by policy.\n\nAPI Response:\n\n----[start]----\n%s\n-----[end]-----\n", policyViolationBody)
+	otherErr := errors.New("deleting Storage Account: unexpected status 409 (409 Conflict)")
+
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+		Create: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return policyViolationErr
+		},
+		Read: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Delete: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return otherErr
+		},
+	}
+	HandleUntypedPolicyViolations(resource)
+
+	//nolint:staticcheck
+	if resource.Create != nil || resource.Delete != nil {
+		t.Fatalf("expected the Create and Delete functions to be replaced")
+	}
+	if resource.UpdateWithoutTimeout != nil {
+		t.Fatalf("expected UpdateWithoutTimeout to remain nil")
+	}
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("expected the wrapped resource to be valid but got: %+v", err)
+	}
+
+	d := resource.TestResourceData()
+
+	diags := resource.CreateWithoutTimeout(context.Background(), d, nil)
+	if len(diags) != 1 || diags[0].Severity != diag.Error {
+		t.Fatalf("expected a single error diagnostic but got %+v", diags)
+	}
+	if expected := `the request for "acctestsa" was denied by the Policy Assignment "Allowed locations"`; diags[0].Summary != expected {
+		t.Fatalf("expected the summary %q but got %q", expected, diags[0].Summary)
+	}
+	if !strings.Contains(diags[0].Detail, policyViolationErr.Error()) {
+		t.Fatalf("expected the detail to contain the original error but got %q", diags[0].Detail)
+	}
+
+	diags = resource.DeleteWithoutTimeout(context.Background(), d, nil)
+	if len(diags) != 1 || diags[0].Summary != otherErr.Error() {
+		t.Fatalf("expected an error which isn't a Policy Violation to be returned as-is but got %+v", diags)
+	}
+}
@@ -59,7 +59,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			metaData := runArgs(d, meta, rw.logger)
 			err := rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
-				return err
+				return rw.handleInterruption(ctx, "creation", err)
 			}
 			// NOTE: whilst this may look like we should use the Read
 			// functions timeout here, we're still /technically/ in the
@@ -74,7 +74,8 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		}),
 		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
-			return rw.resource.Delete().Func(ctx, metaData)
+			err := rw.resource.Delete().Func(ctx, metaData)
+			return rw.handleInterruption(ctx, "deletion", err)
 		}),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -126,7 +127,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 			err := v.Update().Func(ctx, metaData)
 			if err != nil {
-				return err
+				return rw.handleInterruption(ctx, "update", err)
 			}
 			// whilst this may look like we should use the Update timeout here
 			// we're still "technically" in the update method, so reusing the
@@ -220,12 +221,7 @@ func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, met
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		out := make([]diag.Diagnostic, 0)
 		if err := in(ctx, d, meta); err != nil {
-			out = append(out, diag.Diagnostic{
-				Severity:      diag.Error,
-				Summary:       err.Error(),
-				Detail:        err.Error(),
-				AttributePath: nil,
-			})
+			out = append(out, errorDiagnostics(ctx, meta, err)...)
 		}
 
 		if diagsLogger, ok := logger.(*DiagnosticsLogger); ok {
@@ -11,9 +11,11 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2024-05-01/configurationstores"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2024-05-01/replicas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/jackofallops/kermit/sdk/appconfiguration/1.0/appconfiguration"
 )
@@ -81,3 +83,36 @@ func appConfigurationGetKeyRefreshFunc(ctx context.Context, client *appconfigura
 		return res, "Exists", nil
 	}
 }
+
+// configurationStoreIdForEndpoint returns the ID of the Configuration Store with the Data Plane Endpoint
+// `configurationStoreEndpoint`, or nil when the Configuration Store no longer exists
+func configurationStoreIdForEndpoint(ctx context.Context, metadata sdk.ResourceMetaData, configurationStoreEndpoint string) (*configurationstores.ConfigurationStoreId, error) {
+	domainSuffix, ok := metadata.Client.Account.Environment.AppConfiguration.DomainSuffix()
+	if !ok {
+		return nil, fmt.Errorf("could not determine AppConfiguration domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+	}
+
+	subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+	configurationStoreIdRaw, err := metadata.Client.AppConfiguration.ConfigurationStoreIDFromEndpoint(ctx, subscriptionId, configurationStoreEndpoint, *domainSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("while retrieving the Resource ID of Configuration Store at Endpoint: %q: %s", configurationStoreEndpoint, err)
+	}
+	if configurationStoreIdRaw == nil {
+		return nil, nil
+	}
+
+	configurationStoreId, err := configurationstores.ParseConfigurationStoreID(*configurationStoreIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := metadata.Client.AppConfiguration.Exists(ctx, *configurationStoreId)
+	if err != nil {
+		return nil, fmt.Errorf("while checking for the existence of %s: %v", *configurationStoreId, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	return configurationStoreId, nil
+}
@@ -0,0 +1,334 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/jackofallops/kermit/sdk/appconfiguration/1.0/appconfiguration"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	KeysImportContentFormatJson = "json"
+	KeysImportContentFormatYaml = "yaml"
+)
+
+// expandKeysImportContent flattens the JSON or YAML document `content` into a map of Keys to Values, nested
+// objects and arrays are flattened by joining the path to each value with `separator`. When `separator` is
+// empty nested objects and arrays are stored as JSON encoded values of the top-level Keys instead.
+func expandKeysImportContent(content, format, separator string) (map[string]string, error) {
+	var document interface{}
+	switch format {
+	case KeysImportContentFormatJson:
+		decoder := json.NewDecoder(strings.NewReader(content))
+		decoder.UseNumber()
+		if err := decoder.Decode(&document); err != nil {
+			return nil, fmt.Errorf("decoding JSON content: %+v", err)
+		}
+	case KeysImportContentFormatYaml:
+		if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+			return nil, fmt.Errorf("decoding YAML content: %+v", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported content format %q", format)
+	}
+
+	document = normalizeKeysImportValue(document)
+	object, ok := document.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected the content to be an object but got %T", document)
+	}
+
+	output := make(map[string]string)
+	for key, value := range object {
+		if err := flattenKeysImportValue(key, value, separator, output); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// normalizeKeysImportValue converts the maps with non-string keys returned when decoding YAML into maps
+// with string keys, so that JSON and YAML documents can be flattened in the same way
+func normalizeKeysImportValue(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeKeysImportValue(item)
+		}
+		return v
+	case map[interface{}]interface{}:
+		output := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			output[fmt.Sprint(key)] = normalizeKeysImportValue(item)
+		}
+		return output
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeKeysImportValue(item)
+		}
+		return v
+	}
+
+	return input
+}
+
+func flattenKeysImportValue(path string, input interface{}, separator string, output map[string]string) error {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		if separator != "" {
+			for key, item := range v {
+				if err := flattenKeysImportValue(path+separator+key, item, separator, output); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case []interface{}:
+		if separator != "" {
+			for i, item := range v {
+				if err := flattenKeysImportValue(path+separator+strconv.Itoa(i), item, separator, output); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case nil:
+		output[path] = ""
+		return nil
+	case string:
+		output[path] = v
+		return nil
+	case json.Number, bool, int, int64, uint64, float64:
+		output[path] = fmt.Sprint(v)
+		return nil
+	}
+
+	encoded, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("encoding the value of %q: %+v", path, err)
+	}
+	output[path] = string(encoded)
+
+	return nil
+}
+
+type keysImportOperation string
+
+const (
+	keysImportOperationCreate keysImportOperation = "create"
+	keysImportOperationUpdate keysImportOperation = "update"
+	keysImportOperationDelete keysImportOperation = "delete"
+)
+
+type keysImportChange struct {
+	operation keysImportOperation
+	key       string
+	value     string
+	etag      string
+}
+
+// diffKeysImport returns the changes required to bring the Keys in the Configuration Store (`existing`) in line
+// with the `desired` Keys and Values, removing the Keys which were previously managed (`managed`) but are no
+// longer desired. Keys which are up-to-date are left untouched.
+func diffKeysImport(existing map[string]appconfiguration.KeyValue, desired map[string]string, managed []string, contentType string) []keysImportChange {
+	changes := make([]keysImportChange, 0)
+
+	for key, value := range desired {
+		current, ok := existing[key]
+		if !ok {
+			changes = append(changes, keysImportChange{
+				operation: keysImportOperationCreate,
+				key:       key,
+				value:     value,
+			})
+			continue
+		}
+
+		if pointer.From(current.Value) != value || pointer.From(current.ContentType) != contentType {
+			changes = append(changes, keysImportChange{
+				operation: keysImportOperationUpdate,
+				key:       key,
+				value:     value,
+				etag:      pointer.From(current.Etag),
+			})
+		}
+	}
+
+	for _, key := range managed {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if current, ok := existing[key]; ok {
+			changes = append(changes, keysImportChange{
+				operation: keysImportOperationDelete,
+				key:       key,
+				etag:      pointer.From(current.Etag),
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].key < changes[j].key
+	})
+
+	return changes
+}
+
+// unmanagedKeysImportKeys returns the (sorted) Keys which are desired and already exist but aren't managed
+func unmanagedKeysImportKeys(existing map[string]appconfiguration.KeyValue, desired map[string]string, managed []string) []string {
+	isManaged := make(map[string]bool, len(managed))
+	for _, key := range managed {
+		isManaged[key] = true
+	}
+
+	conflicts := make([]string, 0)
+	for key := range desired {
+		if _, ok := existing[key]; ok && !isManaged[key] {
+			conflicts = append(conflicts, key)
+		}
+	}
+	sort.Strings(conflicts)
+
+	return conflicts
+}
+
+// listKeysImportKeyValues returns the Key Values in the Configuration Store matching the Key Prefix and Label of `id`
+func listKeysImportKeyValues(ctx context.Context, client *azuresdkhacks.DataPlaneClient, id parse.KeysImportId) (map[string]appconfiguration.KeyValue, error) {
+	label := escapeKeysImportFilter(id.Label)
+	if label == "" {
+		// `\0` matches Keys without a Label
+		label = "\000"
+	}
+
+	iter, err := client.GetKeyValuesComplete(ctx, escapeKeysImportFilter(id.KeyPrefix)+"*", label, "", "", []appconfiguration.KeyValueFields{})
+	if err != nil {
+		return nil, fmt.Errorf("listing keys for %s: %+v", id, err)
+	}
+
+	output := make(map[string]appconfiguration.KeyValue)
+	for iter.NotDone() {
+		kv := iter.Value()
+		output[pointer.From(kv.Key)] = appconfiguration.KeyValue{
+			Key:         kv.Key,
+			Label:       kv.Label,
+			ContentType: kv.ContentType,
+			Value:       kv.Value,
+			Etag:        kv.Etag,
+			Locked:      kv.Locked,
+		}
+
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing keys for %s: %+v", id, err)
+		}
+	}
+
+	return output, nil
+}
+
+// escapeKeysImportFilter escapes the characters with a special meaning in Key and Label filters
+func escapeKeysImportFilter(input string) string {
+	return strings.NewReplacer(`\`, `\\`, `*`, `\*`, `,`, `\,`).Replace(input)
+}
+
+// applyKeysImportChanges applies `changes` using `parallelism` concurrent requests. Every update and delete is
+// conditional on the ETag observed when the changes were calculated and every create is conditional on the Key not
+// existing, so that Keys modified outside of Terraform in the meantime aren't overwritten.
+func applyKeysImportChanges(ctx context.Context, client *appconfiguration.BaseClient, label, contentType string, changes []keysImportChange, parallelism int) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	queue := make(chan keysImportChange, len(changes))
+	errs := make(chan error, len(changes))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(changes))
+
+	for _, change := range changes {
+		queue <- change
+	}
+	close(queue)
+
+	for i := 0; i < parallelism; i++ {
+		go func() {
+			for change := range queue {
+				if err := applyKeysImportChange(ctx, client, label, contentType, change); err != nil {
+					errs <- err
+				}
+				wg.Done()
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	if len(errs) > 0 {
+		messages := make([]string, 0)
+		for err := range errs {
+			messages = append(messages, err.Error())
+		}
+		sort.Strings(messages)
+		return fmt.Errorf("%d of %d changes failed:\n%s", len(messages), len(changes), strings.Join(messages, "\n"))
+	}
+
+	return nil
+}
+
+func applyKeysImportChange(ctx context.Context, client *appconfiguration.BaseClient, label, contentType string, change keysImportChange) error {
+	var err error
+	switch change.operation {
+	case keysImportOperationCreate, keysImportOperationUpdate:
+		entity := appconfiguration.KeyValue{
+			Key:         pointer.To(change.key),
+			Label:       pointer.To(label),
+			ContentType: pointer.To(contentType),
+			Value:       pointer.To(change.value),
+		}
+
+		ifMatch, ifNoneMatch := quoteKeysImportEtag(change.etag), ""
+		if change.operation == keysImportOperationCreate {
+			ifNoneMatch = "*"
+		}
+		_, err = client.PutKeyValue(ctx, change.key, label, &entity, ifMatch, ifNoneMatch)
+	case keysImportOperationDelete:
+		_, err = client.DeleteKeyValue(ctx, change.key, label, quoteKeysImportEtag(change.etag))
+	}
+
+	if err != nil {
+		if v, ok := err.(autorest.DetailedError); ok {
+			if response.WasStatusCode(v.Response, http.StatusPreconditionFailed) {
+				return fmt.Errorf("key %q was modified outside of Terraform whilst it was being %sd, re-run Terraform to refresh it", change.key, change.operation)
+			}
+			if response.WasConflict(v.Response) {
+				return fmt.Errorf("key %q is locked and can't be %sd", change.key, change.operation)
+			}
+		}
+		return fmt.Errorf("key %q could not be %sd: %+v", change.key, change.operation, err)
+	}
+
+	return nil
+}
+
+func quoteKeysImportEtag(etag string) string {
+	if etag == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(`"%s"`, strings.Trim(etag, `"`))
+}
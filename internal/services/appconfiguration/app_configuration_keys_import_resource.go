@@ -0,0 +1,408 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2024-05-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KeysImportResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate         = KeysImportResource{}
+	_ sdk.ResourceWithCustomizeDiff  = KeysImportResource{}
+	_ sdk.ResourceWithCustomImporter = KeysImportResource{}
+)
+
+type KeysImportResourceModel struct {
+	ConfigurationStoreId string            `tfschema:"configuration_store_id"`
+	Content              string            `tfschema:"content"`
+	ContentFormat        string            `tfschema:"content_format"`
+	ContentType          string            `tfschema:"content_type"`
+	KeyPrefix            string            `tfschema:"key_prefix"`
+	Label                string            `tfschema:"label"`
+	Parallelism          int64             `tfschema:"parallelism"`
+	Separator            string            `tfschema:"separator"`
+	Values               map[string]string `tfschema:"values"`
+	KeyValues            map[string]string `tfschema:"key_values"`
+}
+
+func (r KeysImportResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"configuration_store_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			// User-specified segments are lowercased in the API response
+			// tracked in https://github.com/Azure/azure-rest-api-specs/issues/24337
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc:     configurationstores.ValidateConfigurationStoreID,
+		},
+
+		"content": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			ExactlyOneOf: []string{"content", "values"},
+		},
+
+		"content_format": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  KeysImportContentFormatJson,
+			ValidateFunc: validation.StringInSlice([]string{
+				KeysImportContentFormatJson,
+				KeysImportContentFormatYaml,
+			}, false),
+		},
+
+		"content_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"key_prefix": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"label": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"parallelism": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 50),
+		},
+
+		"separator": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  ":",
+			ValidateFunc: validation.StringInSlice([]string{
+				"",
+				".",
+				",",
+				";",
+				"-",
+				"_",
+				"__",
+				"/",
+				":",
+			}, false),
+		},
+
+		"values": {
+			Type:         pluginsdk.TypeMap,
+			Optional:     true,
+			ExactlyOneOf: []string{"content", "values"},
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r KeysImportResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"key_values": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r KeysImportResource) ModelObject() interface{} {
+	return &KeysImportResourceModel{}
+}
+
+func (r KeysImportResource) ResourceType() string {
+	return "azurerm_app_configuration_keys_import"
+}
+
+func (r KeysImportResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.KeysImportId
+}
+
+func (r KeysImportResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KeysImportResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			configurationStoreEndpoint, err := metadata.Client.AppConfiguration.EndpointForConfigurationStore(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("retrieving Endpoint for %q: %s", *configurationStoreId, err)
+			}
+
+			id, err := parse.NewKeysImportID(*configurationStoreEndpoint, model.KeyPrefix, model.Label)
+			if err != nil {
+				return err
+			}
+
+			desired, err := expandKeysImportKeyValues(model)
+			if err != nil {
+				return err
+			}
+
+			if err := r.apply(ctx, metadata, *id, desired, []string{}, model); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KeysImportResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseKeysImportID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			configurationStoreId, err := configurationStoreIdForEndpoint(ctx, metadata, id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+			if configurationStoreId == nil {
+				// if the AppConfiguration is gone then all the data inside it is too
+				log.Printf("[DEBUG] Unable to determine the Resource ID for Configuration Store at Endpoint %q - removing from state", id.ConfigurationStoreEndpoint)
+				return metadata.MarkAsGone(id)
+			}
+
+			var state KeysImportResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			existing, err := listKeysImportKeyValues(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+
+			// only the Keys managed by this resource are tracked, any other Keys matching the Key Prefix and Label are ignored
+			keyValues := make(map[string]string)
+			for key := range state.KeyValues {
+				if kv, ok := existing[key]; ok {
+					keyValues[key] = pointer.From(kv.Value)
+				}
+			}
+
+			state.ConfigurationStoreId = configurationStoreId.ID()
+			state.KeyPrefix = id.KeyPrefix
+			state.Label = id.Label
+			state.KeyValues = keyValues
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KeysImportResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseKeysImportID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			var model KeysImportResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			desired, err := expandKeysImportKeyValues(model)
+			if err != nil {
+				return err
+			}
+
+			managed := make([]string, 0)
+			oldKeyValues, _ := metadata.ResourceData.GetChange("key_values")
+			for key := range oldKeyValues.(map[string]interface{}) {
+				managed = append(managed, key)
+			}
+
+			return r.apply(ctx, metadata, *id, desired, managed, model)
+		},
+	}
+}
+
+func (r KeysImportResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseKeysImportID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			var model KeysImportResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			managed := make([]string, 0, len(model.KeyValues))
+			for key := range model.KeyValues {
+				managed = append(managed, key)
+			}
+
+			return r.apply(ctx, metadata, *id, map[string]string{}, managed, model)
+		},
+	}
+}
+
+func (r KeysImportResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			for _, key := range []string{"content", "content_format", "key_prefix", "separator", "values"} {
+				if !rd.NewValueKnown(key) {
+					return rd.SetNewComputed("key_values")
+				}
+			}
+
+			var model KeysImportResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			desired, err := expandKeysImportKeyValues(model)
+			if err != nil {
+				return err
+			}
+
+			// the Keys and Values are exposed in the plan so that the changes to individual Keys can be reviewed
+			if !reflect.DeepEqual(desired, model.KeyValues) {
+				return rd.SetNew("key_values", desired)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KeysImportResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		id, err := parse.ParseKeysImportID(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+
+		client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+		if err != nil {
+			return err
+		}
+
+		existing, err := listKeysImportKeyValues(ctx, client, *id)
+		if err != nil {
+			return err
+		}
+
+		// when importing all of the Keys matching the Key Prefix and Label are managed by this resource
+		keyValues := make(map[string]interface{}, len(existing))
+		for key, kv := range existing {
+			keyValues[key] = pointer.From(kv.Value)
+		}
+
+		return metadata.ResourceData.Set("key_values", keyValues)
+	}
+}
+
+func (r KeysImportResource) apply(ctx context.Context, metadata sdk.ResourceMetaData, id parse.KeysImportId, desired map[string]string, managed []string, model KeysImportResourceModel) error {
+	listClient, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+	if err != nil {
+		return err
+	}
+
+	client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+	if err != nil {
+		return err
+	}
+
+	existing, err := listKeysImportKeyValues(ctx, listClient, id)
+	if err != nil {
+		return err
+	}
+
+	// Keys which already exist but aren't managed by this resource would otherwise be deleted along with this resource,
+	// so they must be imported (or removed) first
+	if conflicts := unmanagedKeysImportKeys(existing, desired, managed); len(conflicts) > 0 {
+		if metadata.ResourceData.Id() == "" {
+			return metadata.ResourceRequiresImport(r.ResourceType(), id)
+		}
+		return fmt.Errorf("the Keys %s already exist in %s but aren't managed by this resource - they must be removed before they can be managed by this resource", strings.Join(conflicts, ", "), id)
+	}
+
+	changes := diffKeysImport(existing, desired, managed, model.ContentType)
+	metadata.Logger.Infof("[DEBUG] Applying %d changes to %s", len(changes), id)
+
+	if err := applyKeysImportChanges(ctx, client, id.Label, model.ContentType, changes, int(model.Parallelism)); err != nil {
+		return fmt.Errorf("applying changes to %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// expandKeysImportKeyValues returns the Keys (including the Key Prefix) and Values which should exist in the Configuration Store
+func expandKeysImportKeyValues(model KeysImportResourceModel) (map[string]string, error) {
+	values := model.Values
+	if model.Content != "" {
+		content, err := expandKeysImportContent(model.Content, model.ContentFormat, model.Separator)
+		if err != nil {
+			return nil, err
+		}
+		values = content
+	}
+
+	output := make(map[string]string, len(values))
+	for key, value := range values {
+		output[model.KeyPrefix+key] = value
+	}
+
+	return output, nil
+}
@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/jackofallops/kermit/sdk/appconfiguration/1.0/appconfiguration"
+)
+
+type AppConfigurationKeysImportResource struct{}
+
+func TestAccAppConfigurationKeysImport_values(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys_import", "test")
+	r := AppConfigurationKeysImportResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.values(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_values.%").HasValue("2"),
+				check.That(data.ResourceName).Key("key_values.app:first").HasValue("one"),
+			),
+		},
+		data.ImportStep("values", "parallelism", "separator", "content_format"),
+	})
+}
+
+func TestAccAppConfigurationKeysImport_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys_import", "test")
+	r := AppConfigurationKeysImportResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.values(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppConfigurationKeysImport_json(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys_import", "test")
+	r := AppConfigurationKeysImportResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.json(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_values.%").HasValue("4"),
+				check.That(data.ResourceName).Key("key_values.app:database:port").HasValue("5432"),
+				check.That(data.ResourceName).Key("key_values.app:hosts:1").HasValue("b.example.com"),
+			),
+		},
+		data.ImportStep("content", "parallelism", "separator", "content_format"),
+	})
+}
+
+func TestAccAppConfigurationKeysImport_yaml(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys_import", "test")
+	r := AppConfigurationKeysImportResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.yaml(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_values.%").HasValue("2"),
+				check.That(data.ResourceName).Key("key_values.app/logging/level").HasValue("debug"),
+			),
+		},
+		data.ImportStep("content", "parallelism", "separator", "content_format"),
+	})
+}
+
+func TestAccAppConfigurationKeysImport_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_keys_import", "test")
+	r := AppConfigurationKeysImportResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.values(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("values", "parallelism", "separator", "content_format"),
+		{
+			Config: r.valuesUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_values.%").HasValue("2"),
+				check.That(data.ResourceName).Key("key_values.app:first").HasValue("uno"),
+				check.That(data.ResourceName).Key("key_values.app:third").HasValue("three"),
+			),
+		},
+		data.ImportStep("values", "parallelism", "separator", "content_format"),
+		{
+			Config: r.values(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_values.%").HasValue("2"),
+			),
+		},
+		data.ImportStep("values", "parallelism", "separator", "content_format"),
+	})
+}
+
+func (t AppConfigurationKeysImportResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseKeysImportID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing resource ID: %+v", err)
+	}
+
+	client, err := clients.AppConfiguration.DataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	for attribute := range state.Attributes {
+		key, ok := strings.CutPrefix(attribute, "key_values.")
+		if !ok || key == "%" {
+			continue
+		}
+
+		res, err := client.GetKeyValue(ctx, key, id.Label, "", "", "", []appconfiguration.KeyValueFields{})
+		if err != nil {
+			return nil, fmt.Errorf("while checking for key's %q existence: %+v", key, err)
+		}
+		if res.StatusCode != 200 {
+			return pointer.To(false), nil
+		}
+	}
+
+	return pointer.To(true), nil
+}
+
+func (t AppConfigurationKeysImportResource) values(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys_import" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key_prefix             = "app:"
+  label                  = "acctest-%d"
+  content_type           = "text/plain"
+
+  values = {
+    first  = "one"
+    second = "two"
+  }
+}
+`, AppConfigurationKeyResource{}.base(data), data.RandomInteger)
+}
+
+func (t AppConfigurationKeysImportResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys_import" "import" {
+  configuration_store_id = azurerm_app_configuration_keys_import.test.configuration_store_id
+  key_prefix             = azurerm_app_configuration_keys_import.test.key_prefix
+  label                  = azurerm_app_configuration_keys_import.test.label
+  content_type           = azurerm_app_configuration_keys_import.test.content_type
+
+  values = {
+    first = "one"
+  }
+}
+`, t.values(data))
+}
+
+func (t AppConfigurationKeysImportResource) valuesUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys_import" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key_prefix             = "app:"
+  label                  = "acctest-%d"
+  parallelism            = 1
+
+  values = {
+    first = "uno"
+    third = "three"
+  }
+}
+`, AppConfigurationKeyResource{}.base(data), data.RandomInteger)
+}
+
+func (t AppConfigurationKeysImportResource) json(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys_import" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key_prefix             = "app:"
+  content_format         = "json"
+
+  content = jsonencode({
+    database = {
+      host = "db.example.com"
+      port = 5432
+    }
+    hosts = ["a.example.com", "b.example.com"]
+  })
+}
+`, AppConfigurationKeyResource{}.base(data))
+}
+
+func (t AppConfigurationKeysImportResource) yaml(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_keys_import" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key_prefix             = "app/"
+  label                  = "acctest-%d"
+  content_format         = "yaml"
+  separator              = "/"
+
+  content = <<YAML
+logging:
+  level: debug
+enabled: true
+YAML
+}
+`, AppConfigurationKeyResource{}.base(data), data.RandomInteger)
+}
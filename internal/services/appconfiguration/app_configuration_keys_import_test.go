@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/jackofallops/kermit/sdk/appconfiguration/1.0/appconfiguration"
+)
+
+func TestExpandKeysImportContent(t *testing.T) {
+	testData := []struct {
+		Name      string
+		Content   string
+		Format    string
+		Separator string
+		Expected  map[string]string
+		Error     bool
+	}{
+		{
+			Name:      "json nested",
+			Content:   `{"a": {"b": "c", "d": 1.50}, "e": [true, null]}`,
+			Format:    KeysImportContentFormatJson,
+			Separator: ":",
+			Expected: map[string]string{
+				"a:b": "c",
+				"a:d": "1.50",
+				"e:0": "true",
+				"e:1": "",
+			},
+		},
+		{
+			Name:      "json without separator",
+			Content:   `{"a": {"b": "c"}, "e": [1, 2]}`,
+			Format:    KeysImportContentFormatJson,
+			Separator: "",
+			Expected: map[string]string{
+				"a": `{"b":"c"}`,
+				"e": "[1,2]",
+			},
+		},
+		{
+			Name:      "yaml nested",
+			Content:   "a:\n  b: c\n  1: 2\nd: [x, y]\n",
+			Format:    KeysImportContentFormatYaml,
+			Separator: ".",
+			Expected: map[string]string{
+				"a.b": "c",
+				"a.1": "2",
+				"d.0": "x",
+				"d.1": "y",
+			},
+		},
+		{
+			Name:    "not an object",
+			Content: `["a"]`,
+			Format:  KeysImportContentFormatJson,
+			Error:   true,
+		},
+		{
+			Name:    "invalid json",
+			Content: `{`,
+			Format:  KeysImportContentFormatJson,
+			Error:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := expandKeysImportContent(v.Content, v.Format, v.Separator)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestDiffKeysImport(t *testing.T) {
+	existing := map[string]appconfiguration.KeyValue{
+		"unchanged": {Value: pointer.To("1"), Etag: pointer.To("a")},
+		"changed":   {Value: pointer.To("1"), Etag: pointer.To("b")},
+		"removed":   {Value: pointer.To("1"), Etag: pointer.To("c")},
+		"unmanaged": {Value: pointer.To("1"), Etag: pointer.To("d")},
+	}
+	desired := map[string]string{
+		"unchanged": "1",
+		"changed":   "2",
+		"created":   "3",
+	}
+
+	expected := []keysImportChange{
+		{operation: keysImportOperationUpdate, key: "changed", value: "2", etag: "b"},
+		{operation: keysImportOperationCreate, key: "created", value: "3"},
+		{operation: keysImportOperationDelete, key: "removed", etag: "c"},
+	}
+
+	actual := diffKeysImport(existing, desired, []string{"unchanged", "changed", "removed"}, "")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestUnmanagedKeysImportKeys(t *testing.T) {
+	existing := map[string]appconfiguration.KeyValue{
+		"managed":   {Value: pointer.To("1")},
+		"unmanaged": {Value: pointer.To("1")},
+		"other":     {Value: pointer.To("1")},
+	}
+	desired := map[string]string{
+		"managed":   "1",
+		"unmanaged": "2",
+		"created":   "3",
+	}
+
+	expected := []string{"unmanaged"}
+	actual := unmanagedKeysImportKeys(existing, desired, []string{"managed"})
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	if actual := unmanagedKeysImportKeys(existing, map[string]string{}, []string{"managed"}); len(actual) != 0 {
+		t.Fatalf("expected no conflicts when no Keys are desired but got %+v", actual)
+	}
+}
@@ -0,0 +1,374 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2024-05-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SnapshotResource struct{}
+
+var _ sdk.Resource = SnapshotResource{}
+
+type SnapshotResourceModel struct {
+	ConfigurationStoreId     string                 `tfschema:"configuration_store_id"`
+	Name                     string                 `tfschema:"name"`
+	Filter                   []SnapshotFilterModel  `tfschema:"filter"`
+	CompositionType          string                 `tfschema:"composition_type"`
+	RetentionPeriodInSeconds int64                  `tfschema:"retention_period_in_seconds"`
+	Tags                     map[string]interface{} `tfschema:"tags"`
+	CreatedAt                string                 `tfschema:"created_at"`
+	Etag                     string                 `tfschema:"etag"`
+	ExpiresAt                string                 `tfschema:"expires_at"`
+	ItemsCount               int64                  `tfschema:"items_count"`
+	SizeInBytes              int64                  `tfschema:"size_in_bytes"`
+	Status                   string                 `tfschema:"status"`
+}
+
+type SnapshotFilterModel struct {
+	Key   string `tfschema:"key"`
+	Label string `tfschema:"label"`
+}
+
+func (r SnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"configuration_store_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			// User-specified segments are lowercased in the API response
+			// tracked in https://github.com/Azure/azure-rest-api-specs/issues/24337
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc:     configurationstores.ValidateConfigurationStoreID,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		// Snapshots are immutable, as such all of the arguments are ForceNew
+		"filter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			MaxItems: 3,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"label": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"composition_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(azuresdkhacks.SnapshotCompositionTypeKey),
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForSnapshotCompositionType(), false),
+		},
+
+		"retention_period_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(3600, 7776000),
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r SnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"created_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"etag": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"expires_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"items_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"size_in_bytes": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SnapshotResource) ModelObject() interface{} {
+	return &SnapshotResourceModel{}
+}
+
+func (r SnapshotResource) ResourceType() string {
+	return "azurerm_app_configuration_snapshot"
+}
+
+func (r SnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SnapshotId
+}
+
+func (r SnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SnapshotResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			configurationStoreEndpoint, err := metadata.Client.AppConfiguration.EndpointForConfigurationStore(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("retrieving Endpoint for snapshot %q in %q: %s", model.Name, *configurationStoreId, err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.SnapshotsDataPlaneClientWithEndpoint(*configurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			id, err := parse.NewSnapshotID(*configurationStoreEndpoint, model.Name)
+			if err != nil {
+				return err
+			}
+
+			// archived Snapshots are also returned, their name can't be reused until they've been purged
+			if _, err := client.GetSnapshot(ctx, id.Name); err != nil {
+				if v, ok := err.(autorest.DetailedError); !ok || !response.WasNotFound(v.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			} else {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			filters := make([]azuresdkhacks.SnapshotFilter, 0)
+			for _, filter := range model.Filter {
+				f := azuresdkhacks.SnapshotFilter{
+					Key: pointer.To(filter.Key),
+				}
+				if filter.Label != "" {
+					f.Label = pointer.To(filter.Label)
+				}
+				filters = append(filters, f)
+			}
+
+			snapshot := azuresdkhacks.Snapshot{
+				Filters:         &filters,
+				CompositionType: pointer.To(azuresdkhacks.SnapshotCompositionType(model.CompositionType)),
+				Tags:            tags.Expand(model.Tags),
+			}
+			if model.RetentionPeriodInSeconds != 0 {
+				snapshot.RetentionPeriod = pointer.To(model.RetentionPeriodInSeconds)
+			}
+
+			if _, err := client.CreateSnapshot(ctx, id.Name, snapshot); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return errors.New("internal-error: context had no deadline")
+			}
+
+			metadata.Logger.Infof("[DEBUG] Waiting for %s to be provisioned", id)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:      []string{string(azuresdkhacks.SnapshotStatusProvisioning)},
+				Target:       []string{string(azuresdkhacks.SnapshotStatusReady)},
+				Refresh:      appConfigurationSnapshotRefreshFunc(ctx, client, id.Name),
+				PollInterval: 10 * time.Second,
+				Timeout:      time.Until(deadline),
+			}
+			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to be provisioned: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			configurationStoreId, err := configurationStoreIdForEndpoint(ctx, metadata, id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+			if configurationStoreId == nil {
+				// if the AppConfiguration is gone then all the data inside it is too
+				log.Printf("[DEBUG] Unable to determine the Resource ID for Configuration Store at Endpoint %q - removing from state", id.ConfigurationStoreEndpoint)
+				return metadata.MarkAsGone(id)
+			}
+
+			client, err := metadata.Client.AppConfiguration.SnapshotsDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := client.GetSnapshot(ctx, id.Name)
+			if err != nil {
+				if v, ok := err.(autorest.DetailedError); ok && response.WasNotFound(v.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := SnapshotResourceModel{
+				ConfigurationStoreId:     configurationStoreId.ID(),
+				Name:                     id.Name,
+				CompositionType:          string(pointer.From(snapshot.CompositionType)),
+				RetentionPeriodInSeconds: pointer.From(snapshot.RetentionPeriod),
+				Tags:                     tags.Flatten(snapshot.Tags),
+				Etag:                     pointer.From(snapshot.Etag),
+				ItemsCount:               pointer.From(snapshot.ItemsCount),
+				SizeInBytes:              pointer.From(snapshot.Size),
+				Status:                   string(pointer.From(snapshot.Status)),
+			}
+
+			if snapshot.Filters != nil {
+				for _, filter := range *snapshot.Filters {
+					model.Filter = append(model.Filter, SnapshotFilterModel{
+						Key:   pointer.From(filter.Key),
+						Label: pointer.From(filter.Label),
+					})
+				}
+			}
+
+			if snapshot.Created != nil {
+				model.CreatedAt = snapshot.Created.Format(time.RFC3339)
+			}
+			if snapshot.Expires != nil {
+				model.ExpiresAt = snapshot.Expires.Format(time.RFC3339)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r SnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return fmt.Errorf("while parsing resource ID: %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.SnapshotsDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetSnapshot(ctx, id.Name)
+			if err != nil {
+				if v, ok := err.(autorest.DetailedError); ok && response.WasNotFound(v.Response) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// Snapshots can't be deleted, instead they're archived and then purged by the service once the
+			// retention period has elapsed
+			if pointer.From(existing.Status) != azuresdkhacks.SnapshotStatusReady {
+				return nil
+			}
+
+			input := azuresdkhacks.SnapshotUpdateParameters{
+				Status: pointer.To(azuresdkhacks.SnapshotStatusArchived),
+			}
+			if _, err := client.UpdateSnapshot(ctx, id.Name, input, ""); err != nil {
+				return fmt.Errorf("archiving %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func appConfigurationSnapshotRefreshFunc(ctx context.Context, client *azuresdkhacks.SnapshotsClient, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.GetSnapshot(ctx, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving snapshot %q: %+v", name, err)
+		}
+
+		status := pointer.From(res.Status)
+		if status == azuresdkhacks.SnapshotStatusFailed {
+			return res, string(status), fmt.Errorf("provisioning of snapshot %q failed", name)
+		}
+
+		return res, string(status), nil
+	}
+}
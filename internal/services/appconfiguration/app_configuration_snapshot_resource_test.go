@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AppConfigurationSnapshotResource struct{}
+
+func TestAccAppConfigurationSnapshot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("ready"),
+				check.That(data.ResourceName).Key("items_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationSnapshot_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppConfigurationSnapshot_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("ready"),
+				check.That(data.ResourceName).Key("items_count").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t AppConfigurationSnapshotResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseSnapshotID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing resource ID: %+v", err)
+	}
+
+	client, err := clients.AppConfiguration.SnapshotsDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSnapshot(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	// Snapshots can't be deleted, only archived
+	return pointer.To(resp.Status != nil && *resp.Status != azuresdkhacks.SnapshotStatusArchived), nil
+}
+
+func (t AppConfigurationSnapshotResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key" "first" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest-snapshot-first"
+  value                  = "first"
+}
+
+resource "azurerm_app_configuration_key" "second" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest-snapshot-second"
+  value                  = "second"
+}
+
+resource "azurerm_app_configuration_key" "labelled" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest-snapshot-first"
+  label                  = "production"
+  value                  = "labelled"
+}
+`, AppConfigurationKeyResource{}.base(data))
+}
+
+func (t AppConfigurationSnapshotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  name                   = "acctest-snapshot-%d"
+
+  filter {
+    key = "acctest-snapshot-*"
+  }
+
+  depends_on = [
+    azurerm_app_configuration_key.first,
+    azurerm_app_configuration_key.second,
+    azurerm_app_configuration_key.labelled,
+  ]
+}
+`, t.template(data), data.RandomInteger)
+}
+
+func (t AppConfigurationSnapshotResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "import" {
+  configuration_store_id = azurerm_app_configuration_snapshot.test.configuration_store_id
+  name                   = azurerm_app_configuration_snapshot.test.name
+
+  filter {
+    key = "acctest-snapshot-*"
+  }
+}
+`, t.basic(data))
+}
+
+func (t AppConfigurationSnapshotResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "test" {
+  configuration_store_id      = azurerm_app_configuration.test.id
+  name                        = "acctest-snapshot-%d"
+  composition_type            = "key_label"
+  retention_period_in_seconds = 3600
+
+  filter {
+    key = "acctest-snapshot-*"
+  }
+
+  filter {
+    key   = "acctest-snapshot-*"
+    label = "production"
+  }
+
+  tags = {
+    environment = "test"
+  }
+
+  depends_on = [
+    azurerm_app_configuration_key.first,
+    azurerm_app_configuration_key.second,
+    azurerm_app_configuration_key.labelled,
+  ]
+}
+`, t.template(data), data.RandomInteger)
+}
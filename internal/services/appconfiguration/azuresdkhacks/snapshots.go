@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/jackofallops/kermit/sdk/appconfiguration/1.0/appconfiguration"
+)
+
+// NOTE: Snapshots are only available from API Version `2023-10-01` of the Data Plane API, whereas
+// the SDK we're using targets API Version `1.0` - as such this implements the Snapshot operations
+// on top of the existing Data Plane client
+
+const snapshotsApiVersion = "2023-10-01"
+
+type SnapshotStatus string
+
+const (
+	SnapshotStatusArchived     SnapshotStatus = "archived"
+	SnapshotStatusFailed       SnapshotStatus = "failed"
+	SnapshotStatusProvisioning SnapshotStatus = "provisioning"
+	SnapshotStatusReady        SnapshotStatus = "ready"
+)
+
+type SnapshotCompositionType string
+
+const (
+	SnapshotCompositionTypeKey      SnapshotCompositionType = "key"
+	SnapshotCompositionTypeKeyLabel SnapshotCompositionType = "key_label"
+)
+
+func PossibleValuesForSnapshotCompositionType() []string {
+	return []string{
+		string(SnapshotCompositionTypeKey),
+		string(SnapshotCompositionTypeKeyLabel),
+	}
+}
+
+type Snapshot struct {
+	autorest.Response `json:"-"`
+	Name              *string                  `json:"name,omitempty"`
+	Status            *SnapshotStatus          `json:"status,omitempty"`
+	Filters           *[]SnapshotFilter        `json:"filters,omitempty"`
+	CompositionType   *SnapshotCompositionType `json:"composition_type,omitempty"`
+	Created           *date.Time               `json:"created,omitempty"`
+	Expires           *date.Time               `json:"expires,omitempty"`
+	RetentionPeriod   *int64                   `json:"retention_period,omitempty"`
+	Size              *int64                   `json:"size,omitempty"`
+	ItemsCount        *int64                   `json:"items_count,omitempty"`
+	Tags              map[string]*string       `json:"tags,omitempty"`
+	Etag              *string                  `json:"etag,omitempty"`
+}
+
+type SnapshotFilter struct {
+	Key   *string `json:"key,omitempty"`
+	Label *string `json:"label,omitempty"`
+}
+
+type SnapshotUpdateParameters struct {
+	Status *SnapshotStatus `json:"status,omitempty"`
+}
+
+type SnapshotsClient struct {
+	client *appconfiguration.BaseClient
+}
+
+func NewSnapshotsClient(client appconfiguration.BaseClient) SnapshotsClient {
+	return SnapshotsClient{
+		client: &client,
+	}
+}
+
+// CreateSnapshot creates the Snapshot `name` - the Snapshot is created asynchronously, callers should
+// poll GetSnapshot until the Snapshot is no longer provisioning
+func (c SnapshotsClient) CreateSnapshot(ctx context.Context, name string, entity Snapshot) (result Snapshot, err error) {
+	pathParameters := map[string]interface{}{
+		"name": autorest.Encode("path", name),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/vnd.microsoft.appconfig.snapshot+json"),
+		autorest.AsPut(),
+		autorest.WithCustomBaseURL("{endpoint}", c.urlParameters()),
+		autorest.WithPathParameters("/snapshots/{name}", pathParameters),
+		autorest.WithQueryParameters(c.queryParameters()),
+		autorest.WithJSON(entity))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.SnapshotsClient", "CreateSnapshot", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.SnapshotsClient", "CreateSnapshot", resp, "Failure sending request")
+		return
+	}
+
+	result, err = c.respond(resp, http.StatusCreated, http.StatusOK)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.SnapshotsClient", "CreateSnapshot", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (c SnapshotsClient) GetSnapshot(ctx context.Context, name string) (result Snapshot, err error) {
+	pathParameters := map[string]interface{}{
+		"name": autorest.Encode("path", name),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{endpoint}", c.urlParameters()),
+		autorest.WithPathParameters("/snapshots/{name}", pathParameters),
+		autorest.WithQueryParameters(c.queryParameters()))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.SnapshotsClient", "GetSnapshot", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.SnapshotsClient", "GetSnapshot", resp, "Failure sending request")
+		return
+	}
+
+	result, err = c.respond(resp, http.StatusOK)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.SnapshotsClient", "GetSnapshot", resp, "Failure responding to request")
+	}
+	return
+}
+
+// UpdateSnapshot updates the Status of the Snapshot `name`, which is the only mutable property of a Snapshot
+func (c SnapshotsClient) UpdateSnapshot(ctx context.Context, name string, entity SnapshotUpdateParameters, ifMatch string) (result Snapshot, err error) {
+	pathParameters := map[string]interface{}{
+		"name": autorest.Encode("path", name),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/merge-patch+json"),
+		autorest.AsPatch(),
+		autorest.WithCustomBaseURL("{endpoint}", c.urlParameters()),
+		autorest.WithPathParameters("/snapshots/{name}", pathParameters),
+		autorest.WithQueryParameters(c.queryParameters()),
+		autorest.WithJSON(entity))
+	if len(ifMatch) > 0 {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithHeader("If-Match", autorest.String(ifMatch)))
+	}
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.SnapshotsClient", "UpdateSnapshot", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.SnapshotsClient", "UpdateSnapshot", resp, "Failure sending request")
+		return
+	}
+
+	result, err = c.respond(resp, http.StatusOK)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azuresdkhacks.SnapshotsClient", "UpdateSnapshot", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (c SnapshotsClient) urlParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"endpoint": c.client.Endpoint,
+	}
+}
+
+func (c SnapshotsClient) queryParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"api-version": snapshotsApiVersion,
+	}
+}
+
+func (c SnapshotsClient) send(req *http.Request) (*http.Response, error) {
+	if len(c.client.SyncToken) > 0 {
+		req.Header.Set("Sync-Token", c.client.SyncToken)
+	}
+	return c.client.Send(req, autorest.DoRetryForStatusCodes(c.client.RetryAttempts, c.client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+func (c SnapshotsClient) respond(resp *http.Response, statusCodes ...int) (result Snapshot, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(statusCodes...),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
@@ -54,6 +54,16 @@ func (c *Client) LinkWorkaroundDataPlaneClientWithEndpoint(configurationStoreEnd
 	return &workaroundClient, nil
 }
 
+func (c *Client) SnapshotsDataPlaneClientWithEndpoint(configurationStoreEndpoint string) (*azuresdkhacks.SnapshotsClient, error) {
+	client, err := c.DataPlaneClientWithEndpoint(configurationStoreEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotsClient := azuresdkhacks.NewSnapshotsClient(*client)
+	return &snapshotsClient, nil
+}
+
 func NewClient(o *common.ClientOptions) (*Client, error) {
 	configurationStores, err := configurationstores.NewConfigurationStoresClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = KeysImportId{}
+
+// KeysImportId identifies the set of Keys within a Configuration Store which share a Key Prefix and Label
+type KeysImportId struct {
+	ConfigurationStoreEndpoint string
+	KeyPrefix                  string
+	Label                      string
+}
+
+func NewKeysImportID(configurationStoreEndpoint, keyPrefix, label string) (*KeysImportId, error) {
+	// configurationStoreEndpoint example: https://testappconf1.azconfig.io
+	configurationURL, err := url.ParseRequestURI(configurationStoreEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", configurationStoreEndpoint, err)
+	}
+
+	return &KeysImportId{
+		ConfigurationStoreEndpoint: configurationURL.String(),
+		KeyPrefix:                  keyPrefix,
+		Label:                      label,
+	}, nil
+}
+
+// KeyFilter returns the Key filter matching all Keys with the Key Prefix
+func (id KeysImportId) KeyFilter() string {
+	return id.KeyPrefix + "*"
+}
+
+func (id KeysImportId) ID() string {
+	// example with label: https://testappconf1.azconfig.io/kv?key=app1%2F%2A&label=testLabel
+	// example without prefix and label: https://testappconf1.azconfig.io/kv?key=%2A&label=
+	baseURL, _ := url.ParseRequestURI(id.ConfigurationStoreEndpoint)
+	u := &url.URL{
+		Scheme:   baseURL.Scheme,
+		Host:     baseURL.Host,
+		Path:     "kv",
+		RawQuery: fmt.Sprintf("key=%s&label=%s", url.QueryEscape(id.KeyFilter()), url.QueryEscape(id.Label)),
+	}
+
+	return u.String()
+}
+
+func (id KeysImportId) String() string {
+	components := []string{
+		fmt.Sprintf("Configuration Store Endpoint %q", id.ConfigurationStoreEndpoint),
+		fmt.Sprintf("Key Prefix %q", id.KeyPrefix),
+		fmt.Sprintf("Label %q", id.Label),
+	}
+	return fmt.Sprintf("AppConfiguration Keys Import %s", strings.Join(components, " / "))
+}
+
+// ParseKeysImportID parses an App Configuration Keys Import ID
+func ParseKeysImportID(input string) (*KeysImportId, error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse Azure App Configuration Keys Import ID %q: %s", input, err)
+	}
+
+	if path := strings.Trim(idURL.EscapedPath(), "/"); path != "kv" {
+		return nil, fmt.Errorf("AppConfiguration Keys Import should be in the format `{endpoint}/kv?key={prefix}*&label={label}`, got path %q", path)
+	}
+
+	queryMap := idURL.Query()
+	rawKey, ok := queryMap["key"]
+	if !ok || len(rawKey) != 1 || !strings.HasSuffix(rawKey[0], "*") {
+		return nil, fmt.Errorf("exactly one 'key' ending with '*' must be defined in Azure App Configuration Keys Import URL query, but got %q", idURL.RawQuery)
+	}
+
+	rawLabel, ok := queryMap["label"]
+	if len(queryMap) != 2 || !ok || len(rawLabel) != 1 {
+		return nil, fmt.Errorf("exactly one 'label' must be defined in Azure App Configuration Keys Import URL query, but got %q", idURL.RawQuery)
+	}
+
+	return &KeysImportId{
+		ConfigurationStoreEndpoint: fmt.Sprintf("%s://%s", idURL.Scheme, idURL.Host),
+		KeyPrefix:                  strings.TrimSuffix(rawKey[0], "*"),
+		Label:                      rawLabel[0],
+	}, nil
+}
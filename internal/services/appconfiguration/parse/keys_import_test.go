@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "testing"
+
+func TestNewKeysImportID(t *testing.T) {
+	cases := []struct {
+		ConfigurationStoreEndpoint string
+		KeyPrefix                  string
+		Label                      string
+		Expected                   string
+		ExpectError                bool
+	}{
+		{
+			ConfigurationStoreEndpoint: "",
+			ExpectError:                true,
+		},
+		{
+			ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+			Expected:                   "https://testappconf1.azconfig.io/kv?key=%2A&label=",
+		},
+		{
+			ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+			KeyPrefix:                  "app1/",
+			Label:                      "test+/123",
+			Expected:                   "https://testappconf1.azconfig.io/kv?key=app1%2F%2A&label=test%2B%2F123",
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := NewKeysImportID(tc.ConfigurationStoreEndpoint, tc.KeyPrefix, tc.Label)
+		if err != nil {
+			if !tc.ExpectError {
+				t.Fatalf("Got error for New Keys Import ID (BaseURL:%q, KeyPrefix:%q, Label:%q): %+v", tc.ConfigurationStoreEndpoint, tc.KeyPrefix, tc.Label, err)
+			}
+			continue
+		}
+		if id.ID() != tc.Expected {
+			t.Fatalf("Expected id for (BaseURL:%q, KeyPrefix:%q, Label:%q) to be %q, got %q", tc.ConfigurationStoreEndpoint, tc.KeyPrefix, tc.Label, tc.Expected, id.ID())
+		}
+	}
+}
+
+func TestParseKeysImportID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    KeysImportId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kv",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kv/testKey?label=testLabel",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kv?key=app1&label=testLabel",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kv?key=%2A",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kv?key=%2A&label=a&b=c",
+			ExpectError: true,
+		},
+		{
+			Input: "https://testappconf1.azconfig.io/kv?key=%2A&label=",
+			Expected: KeysImportId{
+				ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+				KeyPrefix:                  "",
+				Label:                      "",
+			},
+		},
+		{
+			Input: "https://testappconf1.azconfig.io/kv?key=app1%2F%2A&label=test%2B%2F123",
+			Expected: KeysImportId{
+				ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+				KeyPrefix:                  "app1/",
+				Label:                      "test+/123",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		keysImportId, err := ParseKeysImportID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID %q: %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID %q but didn't get one", tc.Input)
+		}
+
+		if tc.Expected.ConfigurationStoreEndpoint != keysImportId.ConfigurationStoreEndpoint {
+			t.Fatalf("Expected ConfigurationStoreEndpoint to be %q, got %q for ID %q", tc.Expected.ConfigurationStoreEndpoint, keysImportId.ConfigurationStoreEndpoint, tc.Input)
+		}
+
+		if tc.Expected.KeyPrefix != keysImportId.KeyPrefix {
+			t.Fatalf("Expected KeyPrefix to be %q, got %q for ID %q", tc.Expected.KeyPrefix, keysImportId.KeyPrefix, tc.Input)
+		}
+
+		if tc.Expected.Label != keysImportId.Label {
+			t.Fatalf("Expected Label to be %q, got %q for ID %q", tc.Expected.Label, keysImportId.Label, tc.Input)
+		}
+	}
+}
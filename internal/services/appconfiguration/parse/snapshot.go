@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = SnapshotId{}
+
+type SnapshotId struct {
+	ConfigurationStoreEndpoint string
+	Name                       string
+}
+
+func NewSnapshotID(configurationStoreEndpoint, name string) (*SnapshotId, error) {
+	// configurationStoreEndpoint example: https://testappconf1.azconfig.io
+	configurationURL, err := url.ParseRequestURI(configurationStoreEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", configurationStoreEndpoint, err)
+	}
+
+	return &SnapshotId{
+		ConfigurationStoreEndpoint: configurationURL.String(),
+		Name:                       name,
+	}, nil
+}
+
+func (id SnapshotId) ID() string {
+	// example: https://testappconf1.azconfig.io/snapshots/testSnapshot
+	baseURL, _ := url.ParseRequestURI(id.ConfigurationStoreEndpoint)
+	u := &url.URL{
+		Scheme:  baseURL.Scheme,
+		Host:    baseURL.Host,
+		Path:    fmt.Sprintf("snapshots/%s", id.Name),
+		RawPath: fmt.Sprintf("snapshots/%s", url.PathEscape(id.Name)),
+	}
+
+	return u.String()
+}
+
+func (id SnapshotId) String() string {
+	components := []string{
+		fmt.Sprintf("Configuration Store Endpoint %q", id.ConfigurationStoreEndpoint),
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	return fmt.Sprintf("AppConfiguration Snapshot %s", strings.Join(components, " / "))
+}
+
+// ParseSnapshotID parses an App Configuration Snapshot ID
+func ParseSnapshotID(input string) (*SnapshotId, error) {
+	// example: https://testappconf1.azconfig.io/snapshots/testSnapshot
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse Azure App Configuration Snapshot ID %q: %s", input, err)
+	}
+
+	if idURL.RawQuery != "" {
+		return nil, fmt.Errorf("Azure App Configuration Snapshot ID %q should not contain a query", input)
+	}
+
+	rawPath := idURL.EscapedPath()
+	rawPath = strings.TrimPrefix(rawPath, "/")
+	rawPath = strings.TrimSuffix(rawPath, "/")
+
+	components := strings.Split(rawPath, "/")
+	if len(components) != 2 || components[0] != "snapshots" || components[1] == "" {
+		return nil, fmt.Errorf("AppConfiguration Snapshot should be in the format `{endpoint}/snapshots/{name}`, got %q", rawPath)
+	}
+
+	name, err := url.PathUnescape(components[1])
+	if err != nil {
+		return nil, fmt.Errorf("cannot unescape Azure App Configuration Snapshot name %q: %s", components[1], err)
+	}
+
+	return &SnapshotId{
+		ConfigurationStoreEndpoint: fmt.Sprintf("%s://%s", idURL.Scheme, idURL.Host),
+		Name:                       name,
+	}, nil
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "testing"
+
+func TestNewSnapshotID(t *testing.T) {
+	cases := []struct {
+		ConfigurationStoreEndpoint string
+		Name                       string
+		Expected                   string
+		ExpectError                bool
+	}{
+		{
+			ConfigurationStoreEndpoint: "",
+			Name:                       "testSnapshot",
+			ExpectError:                true,
+		},
+		{
+			ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+			Name:                       "testSnapshot",
+			Expected:                   "https://testappconf1.azconfig.io/snapshots/testSnapshot",
+		},
+		{
+			ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+			Name:                       "test/123",
+			Expected:                   "https://testappconf1.azconfig.io/snapshots/test%2F123",
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := NewSnapshotID(tc.ConfigurationStoreEndpoint, tc.Name)
+		if err != nil {
+			if !tc.ExpectError {
+				t.Fatalf("Got error for New Snapshot ID (BaseURL:%q, Name:%q): %+v", tc.ConfigurationStoreEndpoint, tc.Name, err)
+			}
+			continue
+		}
+		if id.ID() != tc.Expected {
+			t.Fatalf("Expected id for (BaseURL:%q, Name:%q) to be %q, got %q", tc.ConfigurationStoreEndpoint, tc.Name, tc.Expected, id.ID())
+		}
+	}
+}
+
+func TestParseSnapshotID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    SnapshotId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/snapshots",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/snapshots/",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/kv/testKey",
+			ExpectError: true,
+		},
+		{
+			Input:       "https://testappconf1.azconfig.io/snapshots/testSnapshot?label=testLabel",
+			ExpectError: true,
+		},
+		{
+			Input: "https://testappconf1.azconfig.io/snapshots/testSnapshot",
+			Expected: SnapshotId{
+				ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+				Name:                       "testSnapshot",
+			},
+		},
+		{
+			Input: "https://testappconf1.azconfig.io/snapshots/test%2F123",
+			Expected: SnapshotId{
+				ConfigurationStoreEndpoint: "https://testappconf1.azconfig.io",
+				Name:                       "test/123",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		snapshotId, err := ParseSnapshotID(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Got error for ID %q: %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for ID %q but didn't get one", tc.Input)
+		}
+
+		if tc.Expected.ConfigurationStoreEndpoint != snapshotId.ConfigurationStoreEndpoint {
+			t.Fatalf("Expected ConfigurationStoreEndpoint to be %q, got %q for ID %q", tc.Expected.ConfigurationStoreEndpoint, snapshotId.ConfigurationStoreEndpoint, tc.Input)
+		}
+
+		if tc.Expected.Name != snapshotId.Name {
+			t.Fatalf("Expected Name to be %q, got %q for ID %q", tc.Expected.Name, snapshotId.Name, tc.Input)
+		}
+	}
+}
@@ -33,6 +33,8 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		KeyResource{},
 		FeatureResource{},
+		KeysImportResource{},
+		SnapshotResource{},
 	}
 }
 
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/applicationgroup"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-12-01/subscriptions"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -39,11 +40,34 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 		Read:   resourceArmRoleAssignmentRead,
 		Delete: resourceArmRoleAssignmentDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.RoleAssignmentID(id)
-			return err
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&roleassignments.ScopedRoleAssignmentId{}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			// the Tenant ID of a cross-tenant Role Assignment isn't part of the Resource ID, so when importing using the
+			// Resource Identity it needs to be appended to the ID
+			if !strings.Contains(d.Id(), "|") {
+				identity, err := d.Identity()
+				if err != nil {
+					return []*pluginsdk.ResourceData{d}, fmt.Errorf("getting identity: %+v", err)
+				}
+				if tenantId, ok := identity.GetOk("tenant_id"); ok && tenantId.(string) != "" {
+					d.SetId(parse.ConstructRoleAssignmentId(d.Id(), tenantId.(string)))
+				}
+			}
+
+			_, err := parse.RoleAssignmentID(d.Id())
+			return []*pluginsdk.ResourceData{d}, err
 		}),
 
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				identitySchema := pluginsdk.GenerateIdentitySchema(&roleassignments.ScopedRoleAssignmentId{})()
+				identitySchema["tenant_id"] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+				}
+				return identitySchema
+			},
+		},
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -303,7 +327,19 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	if model := resp.Model; model != nil {
+	roleDefinitionName := ""
+	if model := resp.Model; model != nil && model.Properties != nil {
+		roleDefinitionName, err = roleAssignmentRoleDefinitionName(ctx, roleDefinitionsClient, model.Properties.RoleDefinitionId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceArmRoleAssignmentFlatten(d, id, resp.Model, roleDefinitionName)
+}
+
+func resourceArmRoleAssignmentFlatten(d *pluginsdk.ResourceData, id *parse.ScopedRoleAssignmentId, model *roleassignments.RoleAssignment, roleDefinitionName string) error {
+	if model != nil {
 		d.Set("name", model.Name)
 
 		if props := model.Properties; props != nil {
@@ -316,31 +352,54 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("condition", props.Condition)
 			d.Set("condition_version", props.ConditionVersion)
 
-			if roleDefResourceId := props.RoleDefinitionId; roleDefResourceId != "" {
-				// Workaround for https://github.com/hashicorp/pandora/issues/3257
-				// The role definition id returned does not contain scope when the role definition was on tenant level (management group or tenant).
-				// And adding tenant id as scope will cause 404 response, so just adding a slash to parse that.
-				if strings.HasPrefix(roleDefResourceId, "/providers") {
-					roleDefResourceId = fmt.Sprintf("/%s", roleDefResourceId)
-				}
-				parsedRoleDefId, err := roledefinitions.ParseScopedRoleDefinitionID(roleDefResourceId)
-				if err != nil {
-					return fmt.Errorf("parsing %q: %+v", roleDefResourceId, err)
-				}
-				roleResp, err := roleDefinitionsClient.Get(ctx, *parsedRoleDefId)
-				if err != nil {
-					return fmt.Errorf("retrieving Role Definition %q: %+v", roleDefResourceId, err)
-				}
-				if roleResp.Model != nil && roleResp.Model.Properties != nil {
-					d.Set("role_definition_name", pointer.From(roleResp.Model.Properties.RoleName))
-				}
+			if roleDefinitionName != "" {
+				d.Set("role_definition_name", roleDefinitionName)
 			}
 		}
 	}
 
+	if err := pluginsdk.SetResourceIdentityData(d, &id.ScopedId); err != nil {
+		return err
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("getting identity: %+v", err)
+	}
+	if err := identity.Set("tenant_id", id.TenantId); err != nil {
+		return fmt.Errorf("setting `tenant_id` in resource identity: %+v", err)
+	}
+
 	return nil
 }
 
+// roleAssignmentRoleDefinitionName retrieves the name of the Role Definition referenced by a Role Assignment
+func roleAssignmentRoleDefinitionName(ctx context.Context, client *roledefinitions.RoleDefinitionsClient, roleDefinitionId string) (string, error) {
+	if roleDefinitionId == "" {
+		return "", nil
+	}
+
+	// Workaround for https://github.com/hashicorp/pandora/issues/3257
+	// The role definition id returned does not contain scope when the role definition was on tenant level (management group or tenant).
+	// And adding tenant id as scope will cause 404 response, so just adding a slash to parse that.
+	if strings.HasPrefix(roleDefinitionId, "/providers") {
+		roleDefinitionId = fmt.Sprintf("/%s", roleDefinitionId)
+	}
+	parsedRoleDefId, err := roledefinitions.ParseScopedRoleDefinitionID(roleDefinitionId)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q: %+v", roleDefinitionId, err)
+	}
+	roleResp, err := client.Get(ctx, *parsedRoleDefId)
+	if err != nil {
+		return "", fmt.Errorf("retrieving Role Definition %q: %+v", roleDefinitionId, err)
+	}
+	if roleResp.Model != nil && roleResp.Model.Properties != nil {
+		return pointer.From(roleResp.Model.Properties.RoleName), nil
+	}
+
+	return "", nil
+}
+
 func resourceArmRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Authorization.ScopedRoleAssignmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -0,0 +1,199 @@
+// Copyright IBM Corp.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type (
+	RoleAssignmentListResource struct{}
+	RoleAssignmentListModel    struct {
+		Scope              types.String `tfsdk:"scope"`
+		LimitAtScope       types.Bool   `tfsdk:"limit_at_scope"`
+		PrincipalId        types.String `tfsdk:"principal_id"`
+		RoleDefinitionId   types.String `tfsdk:"role_definition_id"`
+		RoleDefinitionName types.String `tfsdk:"role_definition_name"`
+	}
+)
+
+var _ sdk.FrameworkListWrappedResource = new(RoleAssignmentListResource)
+
+func (r RoleAssignmentListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceArmRoleAssignment()
+}
+
+func (r RoleAssignmentListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_role_assignment"
+}
+
+func (r RoleAssignmentListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+			"limit_at_scope": schema.BoolAttribute{
+				Optional: true,
+			},
+			"principal_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsUUID,
+					},
+				},
+			},
+			"role_definition_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+			"role_definition_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r RoleAssignmentListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+	roleDefinitionsClient := metadata.Client.Authorization.ScopedRoleDefinitionsClient
+
+	var data RoleAssignmentListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	scope := data.Scope.ValueString()
+	scopeId := commonids.NewScopeID(scope)
+
+	options := roleassignments.DefaultListForScopeOperationOptions()
+	// Root scope requires a filter, default to `atScope()`
+	if scope == "/" {
+		options.Filter = pointer.To("atScope()")
+	}
+	if v := data.PrincipalId.ValueString(); v != "" {
+		options.Filter = pointer.To(fmt.Sprintf("principalId eq '%s'", v))
+	}
+
+	resp, err := client.ListForScopeComplete(ctx, scopeId, options)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_role_assignment"), err)
+		return
+	}
+
+	// Role Definition names are resolved once per Role Definition, since large scopes typically contain many
+	// assignments of the same (built-in) roles
+	roleDefinitionNames := make(map[string]string)
+	roleDefinitionName := func(roleDefinitionId string) (string, error) {
+		key := strings.ToLower(roleDefinitionId)
+		if v, ok := roleDefinitionNames[key]; ok {
+			return v, nil
+		}
+
+		name, err := roleAssignmentRoleDefinitionName(ctx, roleDefinitionsClient, roleDefinitionId)
+		if err != nil {
+			return "", err
+		}
+		roleDefinitionNames[key] = name
+
+		return name, nil
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, assignment := range resp.Items {
+			props := assignment.Properties
+			if props == nil {
+				continue
+			}
+
+			// The API returns all role assignments at, above, or below the provided scope regardless of whether `atScope()` is passed as a filter.
+			if data.LimitAtScope.ValueBool() && !strings.EqualFold(scope, pointer.From(props.Scope)) {
+				continue
+			}
+
+			if v := data.RoleDefinitionId.ValueString(); v != "" && !roleDefinitionIdsMatch(v, props.RoleDefinitionId) {
+				continue
+			}
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(assignment.Name)
+
+			name, err := roleDefinitionName(props.RoleDefinitionId)
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "retrieving Role Definition for Role Assignment", err)
+				return
+			}
+
+			if v := data.RoleDefinitionName.ValueString(); v != "" && !strings.EqualFold(v, name) {
+				continue
+			}
+
+			id, err := parse.ScopedRoleAssignmentID(pointer.From(assignment.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Role Assignment ID", err)
+				return
+			}
+
+			rd := resourceArmRoleAssignment().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := resourceArmRoleAssignmentFlatten(rd, id, &assignment, name); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_role_assignment"), err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// roleDefinitionIdsMatch compares two Role Definition IDs, which can be returned by the API either scoped to a
+// subscription or at the tenant level - as such the comparison is made on the Role Definition's GUID
+func roleDefinitionIdsMatch(first, second string) bool {
+	first = first[strings.LastIndex(first, "/")+1:]
+	second = second[strings.LastIndex(second, "/")+1:]
+
+	return strings.EqualFold(first, second)
+}
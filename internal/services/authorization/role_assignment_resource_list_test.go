@@ -0,0 +1,87 @@
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccRoleAssignment_listByScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignment", "testlist")
+	r := RoleAssignmentResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.resourceGroupScoped(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicQuery(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_role_assignment.list", 1),
+					querycheck.ExpectIdentity(
+						"azurerm_role_assignment.list",
+						map[string]knownvalue.Check{
+							"name":  knownvalue.NotNull(),
+							"scope": knownvalue.StringRegexp(regexp.MustCompile(fmt.Sprintf("acctestRG-fwpolicy-RCG-%d$", data.RandomInteger))),
+						},
+					),
+				},
+			},
+			{
+				Query:  true,
+				Config: r.roleFilterQuery(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_role_assignment.list", 0),
+				},
+			},
+		},
+	})
+}
+
+func (r RoleAssignmentResource) basicQuery(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+list "azurerm_role_assignment" "list" {
+  provider = azurerm
+  config {
+    scope                = "/subscriptions/%[1]s/resourceGroups/acctestRG-fwpolicy-RCG-%[2]d"
+    limit_at_scope       = true
+    role_definition_name = "Reader"
+  }
+}
+`, data.Subscriptions.Primary, data.RandomInteger)
+}
+
+func (r RoleAssignmentResource) roleFilterQuery(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+list "azurerm_role_assignment" "list" {
+  provider = azurerm
+  config {
+    scope                = "/subscriptions/%[1]s/resourceGroups/acctestRG-fwpolicy-RCG-%[2]d"
+    limit_at_scope       = true
+    role_definition_name = "Owner"
+  }
+}
+`, data.Subscriptions.Primary, data.RandomInteger)
+}
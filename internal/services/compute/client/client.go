@@ -39,6 +39,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetrollingupgrades"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetvms"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/imagebuilder/2024-02-01/virtualmachineimagetemplate"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/standbypool/2025-03-01/standbyvirtualmachinepools"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -78,6 +79,7 @@ type Client struct {
 	VirtualMachineScaleSetRollingUpgradesClient *virtualmachinescalesetrollingupgrades.VirtualMachineScaleSetRollingUpgradesClient
 	VirtualMachineScaleSetVMsClient             *virtualmachinescalesetvms.VirtualMachineScaleSetVMsClient
 	VirtualMachineImagesClient                  *virtualmachineimages.VirtualMachineImagesClient
+	VirtualMachineImageTemplatesClient          *virtualmachineimagetemplate.VirtualMachineImageTemplateClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -267,6 +269,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(vmImageClient.Client, o.Authorizers.ResourceManager)
 
+	vmImageTemplatesClient, err := virtualmachineimagetemplate.NewVirtualMachineImageTemplateClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building VirtualMachineImageTemplate client: %+v", err)
+	}
+	o.Configure(vmImageTemplatesClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		AvailabilitySetsClient:                      availabilitySetsClient,
 		CapacityReservationsClient:                  capacityReservationsClient,
@@ -299,6 +307,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		VirtualMachineScaleSetRollingUpgradesClient: virtualMachineScaleSetRollingUpgradesClient,
 		VirtualMachineScaleSetVMsClient:             virtualMachineScaleSetVMsClient,
 		VirtualMachineImagesClient:                  vmImageClient,
+		VirtualMachineImageTemplatesClient:          vmImageTemplatesClient,
 	}, nil
 }
 
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custompoller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/snapshots"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+type snapshotCopyPoller struct {
+	client     *snapshots.SnapshotsClient
+	id         snapshots.SnapshotId
+	onProgress func(completionPercent float64)
+}
+
+var _ pollers.PollerType = &snapshotCopyPoller{}
+
+// copying a snapshot to another region can take several hours, so there's no need to poll as often as for other operations
+var pollingSnapshotCopyInProgress = pollers.PollResult{
+	Status:       pollers.PollingStatusInProgress,
+	PollInterval: 30 * time.Second,
+}
+
+// NewSnapshotCopyPoller returns a poller which waits for the background copy of a Snapshot created using the `CopyStart`
+// create option to complete, `onProgress` (if specified) is called with the `completionPercent` of the copy when polled
+func NewSnapshotCopyPoller(client *snapshots.SnapshotsClient, id snapshots.SnapshotId, onProgress func(completionPercent float64)) *snapshotCopyPoller {
+	return &snapshotCopyPoller{
+		client:     client,
+		id:         id,
+		onProgress: onProgress,
+	}
+}
+
+func (p snapshotCopyPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	resp, err := p.client.Get(ctx, p.id)
+	if err != nil {
+		return &pollingFailed, fmt.Errorf("retrieving %s: %+v", p.id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return &pollingUnknown, nil
+	}
+
+	props := resp.Model.Properties
+	if copyError := props.CopyCompletionError; copyError != nil {
+		return &pollingFailed, pollers.PollingFailedError{
+			Message: fmt.Sprintf("copying %s failed: %s (%s)", p.id, copyError.ErrorMessage, copyError.ErrorCode),
+		}
+	}
+
+	// the completion percentage may not be populated immediately after the copy has been started
+	if props.CompletionPercent == nil {
+		return &pollingUnknown, nil
+	}
+
+	completionPercent := pointer.From(props.CompletionPercent)
+	if p.onProgress != nil {
+		p.onProgress(completionPercent)
+	}
+
+	if completionPercent < 100 {
+		return &pollingSnapshotCopyInProgress, nil
+	}
+
+	return &pollingSuccess, nil
+}
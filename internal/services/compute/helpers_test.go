@@ -85,3 +85,128 @@ func TestSortVersions_invalid(t *testing.T) {
 		}
 	}
 }
+
+func TestSharedImageVersionAvailableAsLatestInRegion(t *testing.T) {
+	buildVersion := func(excludeFromLatest bool, regions ...galleryimageversions.TargetRegion) galleryimageversions.GalleryImageVersion {
+		return galleryimageversions.GalleryImageVersion{
+			Properties: &galleryimageversions.GalleryImageVersionProperties{
+				PublishingProfile: &galleryimageversions.GalleryArtifactPublishingProfileBase{
+					ExcludeFromLatest: pointer.To(excludeFromLatest),
+					TargetRegions:     pointer.To(regions),
+				},
+			},
+		}
+	}
+
+	testData := []struct {
+		name     string
+		input    galleryimageversions.GalleryImageVersion
+		expected bool
+	}{
+		{
+			name:     "no properties",
+			input:    galleryimageversions.GalleryImageVersion{},
+			expected: false,
+		},
+		{
+			name:     "replicated to region",
+			input:    buildVersion(false, galleryimageversions.TargetRegion{Name: "West Europe"}),
+			expected: true,
+		},
+		{
+			name:     "not replicated to region",
+			input:    buildVersion(false, galleryimageversions.TargetRegion{Name: "northeurope"}),
+			expected: false,
+		},
+		{
+			name:     "excluded from latest in all regions",
+			input:    buildVersion(true, galleryimageversions.TargetRegion{Name: "westeurope"}),
+			expected: false,
+		},
+		{
+			name: "excluded from latest in region",
+			input: buildVersion(false,
+				galleryimageversions.TargetRegion{Name: "northeurope"},
+				galleryimageversions.TargetRegion{Name: "westeurope", ExcludeFromLatest: pointer.To(true)},
+			),
+			expected: false,
+		},
+		{
+			name: "excluded from latest in another region",
+			input: buildVersion(false,
+				galleryimageversions.TargetRegion{Name: "northeurope", ExcludeFromLatest: pointer.To(true)},
+				galleryimageversions.TargetRegion{Name: "westeurope"},
+			),
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := sharedImageVersionAvailableAsLatestInRegion(v.input, "westeurope"); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestSharedImageVersionReplicatedToRegion(t *testing.T) {
+	buildVersion := func(provisioningState galleryimageversions.GalleryProvisioningState, summary ...galleryimageversions.RegionalReplicationStatus) galleryimageversions.GalleryImageVersion {
+		return galleryimageversions.GalleryImageVersion{
+			Properties: &galleryimageversions.GalleryImageVersionProperties{
+				ProvisioningState: pointer.To(provisioningState),
+				ReplicationStatus: &galleryimageversions.ReplicationStatus{
+					Summary: pointer.To(summary),
+				},
+			},
+		}
+	}
+
+	testData := []struct {
+		name     string
+		input    galleryimageversions.GalleryImageVersion
+		expected bool
+	}{
+		{
+			name:     "no properties",
+			input:    galleryimageversions.GalleryImageVersion{},
+			expected: false,
+		},
+		{
+			name: "replication completed",
+			input: buildVersion(galleryimageversions.GalleryProvisioningStateSucceeded,
+				galleryimageversions.RegionalReplicationStatus{Region: pointer.To("West Europe"), State: pointer.To(galleryimageversions.ReplicationStateCompleted)},
+			),
+			expected: true,
+		},
+		{
+			name: "replicating",
+			input: buildVersion(galleryimageversions.GalleryProvisioningStateSucceeded,
+				galleryimageversions.RegionalReplicationStatus{Region: pointer.To("westeurope"), State: pointer.To(galleryimageversions.ReplicationStateReplicating)},
+			),
+			expected: false,
+		},
+		{
+			name: "replication completed in another region",
+			input: buildVersion(galleryimageversions.GalleryProvisioningStateSucceeded,
+				galleryimageversions.RegionalReplicationStatus{Region: pointer.To("northeurope"), State: pointer.To(galleryimageversions.ReplicationStateCompleted)},
+			),
+			expected: false,
+		},
+		{
+			name: "still being updated",
+			input: buildVersion(galleryimageversions.GalleryProvisioningStateUpdating,
+				galleryimageversions.RegionalReplicationStatus{Region: pointer.To("westeurope"), State: pointer.To(galleryimageversions.ReplicationStateCompleted)},
+			),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := sharedImageVersionReplicatedToRegion(v.input, "westeurope"); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
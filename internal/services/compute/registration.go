@@ -90,6 +90,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		ManagedDisksDataSource{},
 		OrchestratedVirtualMachineScaleSetDataSource{},
+		SharedImageLatestVersionDataSource{},
 	}
 }
 
@@ -104,12 +105,15 @@ func (r Registration) Resources() []sdk.Resource {
 		VirtualMachineRestorePointResource{},
 		VirtualMachineGalleryApplicationAssignmentResource{},
 		VirtualMachineScaleSetStandbyPoolResource{},
+		VirtualMachineImageTemplateResource{},
 	}
 }
 
 func (r Registration) Actions() []func() action.Action {
 	return []func() action.Action{
 		newVirtualMachinePowerAction,
+		newVirtualMachineImageTemplateBuildAction,
+		newSnapshotCopyAction,
 	}
 }
 
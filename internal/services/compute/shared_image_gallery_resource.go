@@ -123,6 +123,12 @@ func resourceSharedImageGallery() *pluginsdk.Resource {
 				},
 			},
 
+			"soft_delete_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": commonschema.Tags(),
 
 			"unique_name": {
@@ -162,6 +168,9 @@ func resourceSharedImageGalleryCreate(d *pluginsdk.ResourceData, meta interface{
 		Properties: &galleries.GalleryProperties{
 			Description:    pointer.To(d.Get("description").(string)),
 			SharingProfile: sharing,
+			SoftDeletePolicy: &galleries.SoftDeletePolicy{
+				IsSoftDeleteEnabled: pointer.To(d.Get("soft_delete_enabled").(bool)),
+			},
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -224,6 +233,12 @@ func resourceSharedImageGalleryRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("unique_name", uniqueName)
 
 			d.Set("sharing", flattenSharedImageGallerySharing(props.SharingProfile))
+
+			softDeleteEnabled := false
+			if policy := props.SoftDeletePolicy; policy != nil {
+				softDeleteEnabled = pointer.From(policy.IsSoftDeleteEnabled)
+			}
+			d.Set("soft_delete_enabled", softDeleteEnabled)
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
@@ -262,6 +277,12 @@ func resourceSharedImageGalleryUpdate(d *pluginsdk.ResourceData, meta interface{
 		payload.Properties.Description = pointer.To(d.Get("description").(string))
 	}
 
+	if d.HasChange("soft_delete_enabled") {
+		payload.Properties.SoftDeletePolicy = &galleries.SoftDeletePolicy{
+			IsSoftDeleteEnabled: pointer.To(d.Get("soft_delete_enabled").(bool)),
+		}
+	}
+
 	if d.HasChange("tags") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
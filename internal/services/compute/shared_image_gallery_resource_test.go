@@ -65,6 +65,7 @@ func TestAccSharedImageGallery_complete(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("description").HasValue("Shared images and things."),
+				check.That(data.ResourceName).Key("soft_delete_enabled").HasValue("true"),
 				check.That(data.ResourceName).Key("tags.%").HasValue("2"),
 				check.That(data.ResourceName).Key("tags.Hello").HasValue("There"),
 				check.That(data.ResourceName).Key("tags.World").HasValue("Example"),
@@ -207,6 +208,7 @@ resource "azurerm_shared_image_gallery" "test" {
   resource_group_name = azurerm_resource_group.test.name
   location            = azurerm_resource_group.test.location
   description         = "Shared images and things."
+  soft_delete_enabled = true
 
   tags = {
     Hello = "There"
@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-07-03/galleryimageversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SharedImageLatestVersionDataSource struct{}
+
+var _ sdk.DataSource = SharedImageLatestVersionDataSource{}
+
+type SharedImageLatestVersionDataSourceModel struct {
+	SharedImageId        string `tfschema:"shared_image_id"`
+	Location             string `tfschema:"location"`
+	SortVersionsBySemVer bool   `tfschema:"sort_versions_by_semver"`
+	Name                 string `tfschema:"name"`
+	PublishedDate        string `tfschema:"published_date"`
+	EndOfLifeDate        string `tfschema:"end_of_life_date"`
+}
+
+func (r SharedImageLatestVersionDataSource) ResourceType() string {
+	return "azurerm_shared_image_latest_version"
+}
+
+func (r SharedImageLatestVersionDataSource) ModelObject() interface{} {
+	return &SharedImageLatestVersionDataSourceModel{}
+}
+
+func (r SharedImageLatestVersionDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"shared_image_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: galleryimageversions.ValidateGalleryImageID,
+		},
+
+		"location": commonschema.Location(),
+
+		"sort_versions_by_semver": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r SharedImageLatestVersionDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"published_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"end_of_life_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SharedImageLatestVersionDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleryImageVersionsClient
+
+			var state SharedImageLatestVersionDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			galleryImageId, err := galleryimageversions.ParseGalleryImageID(state.SharedImageId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListByGalleryImageComplete(ctx, *galleryImageId)
+			if err != nil {
+				return fmt.Errorf("listing the versions of %s: %+v", galleryImageId, err)
+			}
+
+			versions := resp.Items
+			if state.SortVersionsBySemVer {
+				var errs []error
+				versions, errs = sortSharedImageVersions(versions)
+				if len(errs) > 0 {
+					return fmt.Errorf("parsing version(s): %v", errs)
+				}
+			}
+
+			targetLocation := location.Normalize(state.Location)
+
+			// the last version in the list is the latest, so the versions are checked from the end of the list - however the
+			// replication status is only returned when retrieving a single version, so that's retrieved for each candidate
+			var latest *galleryimageversions.GalleryImageVersion
+			for i := len(versions) - 1; i >= 0 && latest == nil; i-- {
+				if !sharedImageVersionAvailableAsLatestInRegion(versions[i], targetLocation) {
+					continue
+				}
+
+				id, err := galleryimageversions.ParseImageVersionIDInsensitively(pointer.From(versions[i].Id))
+				if err != nil {
+					return err
+				}
+
+				options := galleryimageversions.GetOperationOptions{
+					Expand: pointer.To(galleryimageversions.ReplicationStatusTypesReplicationStatus),
+				}
+				version, err := client.Get(ctx, *id, options)
+				if err != nil {
+					return fmt.Errorf("retrieving the replication status of %s: %+v", id, err)
+				}
+
+				if version.Model != nil && sharedImageVersionReplicatedToRegion(*version.Model, targetLocation) {
+					latest = version.Model
+				}
+			}
+
+			if latest == nil {
+				return fmt.Errorf("a version of %s which is fully replicated to %q and isn't excluded from latest was not found", galleryImageId, targetLocation)
+			}
+
+			id, err := galleryimageversions.ParseImageVersionIDInsensitively(pointer.From(latest.Id))
+			if err != nil {
+				return err
+			}
+
+			state.Name = id.VersionName
+			state.Location = targetLocation
+			if props := latest.Properties; props != nil && props.PublishingProfile != nil {
+				state.PublishedDate = pointer.From(props.PublishingProfile.PublishedDate)
+				state.EndOfLifeDate = pointer.From(props.PublishingProfile.EndOfLifeDate)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// sharedImageVersionAvailableAsLatestInRegion returns whether the version is replicated to the region and isn't excluded
+// from being the latest version - either for all regions or for the specific region
+func sharedImageVersionAvailableAsLatestInRegion(version galleryimageversions.GalleryImageVersion, targetLocation string) bool {
+	if version.Properties == nil || version.Properties.PublishingProfile == nil {
+		return false
+	}
+
+	profile := version.Properties.PublishingProfile
+	if pointer.From(profile.ExcludeFromLatest) {
+		return false
+	}
+
+	for _, region := range pointer.From(profile.TargetRegions) {
+		if location.Normalize(region.Name) == targetLocation {
+			return !pointer.From(region.ExcludeFromLatest)
+		}
+	}
+
+	return false
+}
+
+// sharedImageVersionReplicatedToRegion returns whether the replication of the version to the region has completed, which
+// requires the version to have been retrieved with the replication status expanded
+func sharedImageVersionReplicatedToRegion(version galleryimageversions.GalleryImageVersion, targetLocation string) bool {
+	props := version.Properties
+	if props == nil || pointer.From(props.ProvisioningState) != galleryimageversions.GalleryProvisioningStateSucceeded || props.ReplicationStatus == nil {
+		return false
+	}
+
+	for _, status := range pointer.From(props.ReplicationStatus.Summary) {
+		if location.Normalize(pointer.From(status.Region)) == targetLocation {
+			return pointer.From(status.State) == galleryimageversions.ReplicationStateCompleted
+		}
+	}
+
+	return false
+}
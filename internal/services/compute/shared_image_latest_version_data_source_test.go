@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SharedImageLatestVersionDataSource struct{}
+
+func TestAccDataSourceSharedImageLatestVersion_excludedInRegion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_shared_image_latest_version", "test")
+	r := SharedImageLatestVersionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			// need to create a vm and then reference it in the image creation
+			Config: SharedImageVersionResource{}.setup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(ImageResource{}.virtualMachineExists, "azurerm_virtual_machine.testsource"),
+				data.CheckWithClientForResource(ImageResource{}.generalizeVirtualMachine(data), "azurerm_virtual_machine.testsource"),
+			),
+		},
+		{
+			Config: r.excludedInRegion(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue("0.0.1"),
+				check.That(data.ResourceName).Key("published_date").Exists(),
+				check.That("data.azurerm_shared_image_latest_version.secondary").Key("name").HasValue("0.0.2"),
+			),
+		},
+	})
+}
+
+func (SharedImageLatestVersionDataSource) excludedInRegion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version" "first" {
+  name                = "0.0.1"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  image_name          = azurerm_shared_image.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  managed_image_id    = azurerm_image.test.id
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 1
+  }
+
+  target_region {
+    name                   = "%[2]s"
+    regional_replica_count = 1
+  }
+}
+
+resource "azurerm_shared_image_version" "second" {
+  name                = "0.0.2"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  image_name          = azurerm_shared_image.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  managed_image_id    = azurerm_image.test.id
+
+  target_region {
+    name                        = azurerm_resource_group.test.location
+    regional_replica_count      = 1
+    exclude_from_latest_enabled = true
+  }
+
+  target_region {
+    name                   = "%[2]s"
+    regional_replica_count = 1
+  }
+
+  depends_on = [azurerm_shared_image_version.first]
+}
+
+data "azurerm_shared_image_latest_version" "test" {
+  shared_image_id         = azurerm_shared_image.test.id
+  location                = azurerm_resource_group.test.location
+  sort_versions_by_semver = true
+
+  depends_on = [azurerm_shared_image_version.second]
+}
+
+data "azurerm_shared_image_latest_version" "secondary" {
+  shared_image_id         = azurerm_shared_image.test.id
+  location                = "%[2]s"
+  sort_versions_by_semver = true
+
+  depends_on = [azurerm_shared_image_version.second]
+}
+`, SharedImageVersionResource{}.provision(data), data.Locations.Secondary)
+}
@@ -186,7 +186,6 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 			"deletion_of_replicated_locations_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
-				ForceNew: true,
 				Default:  false,
 			},
 
@@ -278,8 +277,32 @@ func resourceSharedImageVersionCreate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, id, version); err != nil {
-		return fmt.Errorf("creating %s: %+v", id, err)
+	result, err := client.CreateOrUpdate(ctx, id, version)
+	if err != nil {
+		// a Soft Deleted Image Version with the same name causes a conflict, which we recover from when
+		// `recover_soft_deleted_image_versions` is enabled
+		if !meta.(*clients.Client).Features.SharedImageGallery.RecoverSoftDeletedImageVersions || !response.WasConflict(result.HttpResponse) {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
+
+		log.Printf("[DEBUG] Recovering Soft Deleted %s..", id)
+		recovery := galleryimageversions.GalleryImageVersion{
+			Location: version.Location,
+			Properties: &galleryimageversions.GalleryImageVersionProperties{
+				StorageProfile: galleryimageversions.GalleryImageVersionStorageProfile{},
+			},
+			Tags: version.Tags,
+		}
+		if err := client.CreateOrUpdateThenPoll(ctx, id, recovery); err != nil {
+			return fmt.Errorf("recovering Soft Deleted %s: %+v", id, err)
+		}
+
+		// the recovered Image Version keeps its original source, so only the updatable properties can be applied
+		d.SetId(id.ID())
+		return resourceSharedImageVersionUpdate(d, meta)
+	}
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
@@ -337,6 +360,13 @@ func resourceSharedImageVersionUpdate(d *pluginsdk.ResourceData, meta interface{
 		payload.Properties.PublishingProfile.ExcludeFromLatest = pointer.To(d.Get("exclude_from_latest").(bool))
 	}
 
+	if d.HasChange("deletion_of_replicated_locations_enabled") {
+		if payload.Properties.SafetyProfile == nil {
+			payload.Properties.SafetyProfile = &galleryimageversions.GalleryImageVersionSafetyProfile{}
+		}
+		payload.Properties.SafetyProfile.AllowDeletionOfReplicatedLocations = pointer.To(d.Get("deletion_of_replicated_locations_enabled").(bool))
+	}
+
 	if d.HasChange("tags") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
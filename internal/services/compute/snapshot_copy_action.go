@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/snapshots"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type SnapshotCopyAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &SnapshotCopyAction{}
+
+func newSnapshotCopyAction() action.Action {
+	return &SnapshotCopyAction{}
+}
+
+type SnapshotCopyActionModel struct {
+	SourceSnapshotId types.String `tfsdk:"source_snapshot_id"`
+	TargetSnapshotId types.String `tfsdk:"target_snapshot_id"`
+	TargetLocation   types.String `tfsdk:"target_location"`
+	Timeout          types.String `tfsdk:"timeout"`
+}
+
+func (s *SnapshotCopyAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"source_snapshot_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the incremental Snapshot which should be copied.",
+				MarkdownDescription: "The ID of the incremental Snapshot which should be copied.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: snapshots.ValidateSnapshotID,
+					},
+				},
+			},
+
+			"target_snapshot_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Snapshot which should be created as a copy of the source Snapshot.",
+				MarkdownDescription: "The ID of the Snapshot which should be created as a copy of the source Snapshot.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: snapshots.ValidateSnapshotID,
+					},
+				},
+			},
+
+			"target_location": schema.StringAttribute{
+				Required:            true,
+				Description:         "The Azure Region where the copy of the Snapshot should be created.",
+				MarkdownDescription: "The Azure Region where the copy of the Snapshot should be created.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the copy to complete. Defaults to `4h`.",
+				MarkdownDescription: "Timeout duration for the copy to complete. Defaults to `4h`.",
+			},
+		},
+	}
+}
+
+func (s *SnapshotCopyAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_snapshot_copy"
+}
+
+func (s *SnapshotCopyAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := s.Client.Compute.SnapshotsClient
+
+	model := SnapshotCopyActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 4 * time.Hour
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	sourceId, err := snapshots.ParseSnapshotID(model.SourceSnapshotId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing `source_snapshot_id`", err)
+		return
+	}
+
+	targetId, err := snapshots.ParseSnapshotID(model.TargetSnapshotId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing `target_snapshot_id`", err)
+		return
+	}
+
+	source, err := client.Get(ctx, *sourceId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "retrieving source snapshot", fmt.Sprintf("retrieving %s: %+v", sourceId, err))
+		return
+	}
+	if source.Model == nil || source.Model.Properties == nil {
+		sdk.SetResponseErrorDiagnostic(response, "retrieving source snapshot", fmt.Sprintf("retrieving %s: `model` or `properties` was nil", sourceId))
+		return
+	}
+	if !pointer.From(source.Model.Properties.Incremental) {
+		sdk.SetResponseErrorDiagnostic(response, "invalid source snapshot", fmt.Sprintf("only incremental Snapshots can be copied but %s is not incremental", sourceId))
+		return
+	}
+
+	// a copy which was started previously (e.g. by an invocation which timed out) is waited on rather than started again
+	existing, err := s.existingSnapshot(ctx, *targetId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "retrieving target snapshot", err)
+		return
+	}
+
+	if existing != nil {
+		props := existing.Properties
+		if props == nil || props.CreationData.CreateOption != snapshots.DiskCreateOptionCopyStart || !strings.EqualFold(pointer.From(props.CreationData.SourceResourceId), sourceId.ID()) {
+			sdk.SetResponseErrorDiagnostic(response, "target snapshot already exists", fmt.Sprintf("%s already exists and isn't a copy of %s", targetId, sourceId))
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("waiting for the existing copy of %s to %s", sourceId, targetId),
+		})
+	} else {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("starting a copy of %s to %s", sourceId, targetId),
+		})
+
+		payload := snapshots.Snapshot{
+			Location: location.Normalize(model.TargetLocation.ValueString()),
+			Properties: &snapshots.SnapshotProperties{
+				CreationData: snapshots.CreationData{
+					CreateOption:     snapshots.DiskCreateOptionCopyStart,
+					SourceResourceId: pointer.To(sourceId.ID()),
+				},
+				Incremental: pointer.To(true),
+			},
+		}
+
+		if err := client.CreateOrUpdateThenPoll(ctx, *targetId, payload); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting the copy of %s to %s: %+v", sourceId, targetId, err))
+			return
+		}
+	}
+
+	if err := waitForSnapshotCopy(ctx, client, *targetId, func(completionPercent float64) {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("copy of %s is %.1f%% complete", sourceId, completionPercent),
+		})
+	}); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("copied %s to %s", sourceId, targetId),
+	})
+}
+
+// existingSnapshot returns the Snapshot, or nil when it doesn't exist
+func (s *SnapshotCopyAction) existingSnapshot(ctx context.Context, id snapshots.SnapshotId) (*snapshots.Snapshot, error) {
+	resp, err := s.Client.Compute.SnapshotsClient.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("checking for an existing %s: %+v", id, err)
+	}
+
+	return resp.Model, nil
+}
+
+func (s *SnapshotCopyAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	s.Defaults(ctx, request, response)
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type SnapshotCopyAction struct{}
+
+func TestAccSnapshotCopyAction_crossRegion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_snapshot_copy", "test")
+	a := SnapshotCopyAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.crossRegion(data),
+			},
+		},
+	})
+}
+
+func (a *SnapshotCopyAction) crossRegion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+action "azurerm_snapshot_copy" "test" {
+  config {
+    source_snapshot_id = azurerm_snapshot.test.id
+    target_snapshot_id = "${azurerm_resource_group.test.id}/providers/Microsoft.Compute/snapshots/acctestss_copy_%[2]d"
+    target_location    = "%[3]s"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_snapshot.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_snapshot_copy.test]
+    }
+  }
+}
+`, SnapshotResource{}.incrementalEnabled(data), data.RandomInteger, data.Locations.Secondary)
+}
@@ -5,6 +5,7 @@ package compute
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -16,8 +17,10 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/diskaccesses"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/snapshots"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/custompoller"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -67,6 +70,7 @@ func resourceSnapshot() *pluginsdk.Resource {
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(snapshots.DiskCreateOptionCopy),
+					string(snapshots.DiskCreateOptionCopyStart),
 					string(snapshots.DiskCreateOptionImport),
 				}, false),
 			},
@@ -132,6 +136,11 @@ func resourceSnapshot() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"copy_completion_percent": {
+				Type:     pluginsdk.TypeFloat,
+				Computed: true,
+			},
+
 			"tags": commonschema.Tags(),
 		},
 
@@ -140,6 +149,19 @@ func resourceSnapshot() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("encryption_settings", func(ctx context.Context, old, new, meta interface{}) bool {
 				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
 			}),
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+				// a `CopyStart` copies an incremental Snapshot, which can be in another region, in the background
+				if diff.Get("create_option").(string) != string(snapshots.DiskCreateOptionCopyStart) {
+					return nil
+				}
+				if diff.NewValueKnown("source_resource_id") && diff.Get("source_resource_id").(string) == "" {
+					return fmt.Errorf("`source_resource_id` must be set to the ID of an incremental Snapshot when `create_option` is `CopyStart`")
+				}
+				if !diff.Get("incremental_enabled").(bool) {
+					return fmt.Errorf("`incremental_enabled` must be `true` when `create_option` is `CopyStart`")
+				}
+				return nil
+			},
 		),
 	}
 }
@@ -166,6 +188,13 @@ func resourceSnapshotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_snapshot", id.ID())
 		}
+	} else if createOption == string(snapshots.DiskCreateOptionCopyStart) && d.Get("copy_completion_percent").(float64) < 100 {
+		// the copy didn't complete within the `create` timeout, so wait for it to complete before updating the Snapshot
+		if err := waitForSnapshotCopy(ctx, client, id, func(completionPercent float64) {
+			log.Printf("[DEBUG] Copy of %s is %.1f%% complete", id, completionPercent)
+		}); err != nil {
+			return err
+		}
 	}
 
 	properties := snapshots.Snapshot{
@@ -215,8 +244,23 @@ func resourceSnapshotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
+	// the snapshot exists once it has been created, so the ID is set before waiting for the copy (which can take hours)
+	// to complete, so that the snapshot is tracked should the copy fail
 	d.SetId(id.ID())
 
+	if d.IsNewResource() && createOption == string(snapshots.DiskCreateOptionCopyStart) {
+		if err := waitForSnapshotCopy(ctx, client, id, func(completionPercent float64) {
+			log.Printf("[DEBUG] Copy of %s is %.1f%% complete", id, completionPercent)
+		}); err != nil {
+			// the copy continues in the background when the `create` timeout is reached - rather than tainting the Snapshot
+			// the progress is exposed in `copy_completion_percent` and the next update waits for the copy to complete
+			if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return err
+			}
+			log.Printf("[WARN] %+v - the copy is still in progress, the `create` timeout may need to be increased for this Snapshot", err)
+		}
+	}
+
 	return resourceSnapshotRead(d, meta)
 }
 
@@ -286,6 +330,9 @@ func resourceSnapshotRead(d *pluginsdk.ResourceData, meta interface{}) error {
 				trustedLaunchEnabled = *securityProfile.SecurityType == snapshots.DiskSecurityTypesTrustedLaunch
 			}
 			d.Set("trusted_launch_enabled", trustedLaunchEnabled)
+
+			// `completionPercent` is only returned for Snapshots created using `CopyStart`, for which it's `100` once the copy is complete
+			d.Set("copy_completion_percent", pointer.From(props.CompletionPercent))
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
@@ -312,3 +359,14 @@ func resourceSnapshotDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// waitForSnapshotCopy waits for the background copy of a Snapshot created using the `CopyStart` create option to complete
+func waitForSnapshotCopy(ctx context.Context, client *snapshots.SnapshotsClient, id snapshots.SnapshotId, onProgress func(completionPercent float64)) error {
+	pollerType := custompoller.NewSnapshotCopyPoller(client, id, onProgress)
+	poller := pollers.NewPoller(pollerType, 30*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("waiting for the copy of %s to complete: %+v", id, err)
+	}
+
+	return nil
+}
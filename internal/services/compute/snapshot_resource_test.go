@@ -192,6 +192,23 @@ func TestAccSnapshot_incrementalEnabled(t *testing.T) {
 	})
 }
 
+func TestAccSnapshot_copyStart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_snapshot", "test")
+	r := SnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.copyStart(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("incremental_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("copy_completion_percent").HasValue("100"),
+			),
+		},
+		data.ImportStep("source_resource_id"),
+	})
+}
+
 func TestAccSnapshot_trustedLaunch(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_snapshot", "test")
 	r := SnapshotResource{}
@@ -701,6 +718,50 @@ resource "azurerm_snapshot" "test" {
 `, data.Locations.Primary, data.RandomInteger)
 }
 
+func (SnapshotResource) copyStart(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[3]d"
+  location = "%[1]s"
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestmd-%[3]d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "10"
+}
+
+resource "azurerm_snapshot" "source" {
+  name                = "acctestss_source_%[3]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  create_option       = "Copy"
+  source_uri          = azurerm_managed_disk.test.id
+  incremental_enabled = true
+}
+
+resource "azurerm_snapshot" "test" {
+  name                = "acctestss_%[3]d"
+  location            = "%[2]s"
+  resource_group_name = azurerm_resource_group.test.name
+  create_option       = "CopyStart"
+  source_resource_id  = azurerm_snapshot.source.id
+  incremental_enabled = true
+
+  timeouts {
+    create = "4h"
+  }
+}
+`, data.Locations.Primary, data.Locations.Secondary, data.RandomInteger)
+}
+
 func (SnapshotResource) trustedLaunch(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/imagebuilder/2024-02-01/virtualmachineimagetemplate"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type VirtualMachineImageTemplateBuildAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &VirtualMachineImageTemplateBuildAction{}
+
+func newVirtualMachineImageTemplateBuildAction() action.Action {
+	return &VirtualMachineImageTemplateBuildAction{}
+}
+
+type VirtualMachineImageTemplateBuildActionModel struct {
+	VirtualMachineImageTemplateId types.String `tfsdk:"virtual_machine_image_template_id"`
+	Timeout                       types.String `tfsdk:"timeout"`
+}
+
+func (v *VirtualMachineImageTemplateBuildAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"virtual_machine_image_template_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Virtual Machine Image Template to build.",
+				MarkdownDescription: "The ID of the Virtual Machine Image Template to build.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: virtualmachineimagetemplate.ValidateImageTemplateID,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the build to complete. Defaults to `4h`.",
+				MarkdownDescription: "Timeout duration for the build to complete. Defaults to `4h`.",
+			},
+		},
+	}
+}
+
+func (v *VirtualMachineImageTemplateBuildAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_virtual_machine_image_template_build"
+}
+
+func (v *VirtualMachineImageTemplateBuildAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := v.Client.Compute.VirtualMachineImageTemplatesClient
+
+	model := VirtualMachineImageTemplateBuildActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// the default build timeout for an Image Template is 4 hours
+	ctxTimeout := 4 * time.Hour
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := virtualmachineimagetemplate.ParseImageTemplateID(model.VirtualMachineImageTemplateId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("starting a build of %s", id),
+	})
+
+	if err := client.RunThenPoll(ctx, *id); err != nil {
+		err = sdk.CancelInterruptedOperation(ctx, err, func(ctx context.Context) error {
+			return client.CancelThenPoll(ctx, *id)
+		})
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("building %s: %+v%s", id, err, v.lastRunStatus(ctx, *id)))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("build of %s completed: %s", id, strings.TrimPrefix(v.lastRunStatus(ctx, *id), "\n\n")),
+	})
+
+	runOutputs, err := client.ListRunOutputsComplete(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("listing the Run Outputs for %s: %+v", id, err))
+		return
+	}
+
+	for _, runOutput := range runOutputs.Items {
+		if props := runOutput.Properties; props != nil {
+			artifact := pointer.From(props.ArtifactId)
+			if artifact == "" {
+				artifact = pointer.From(props.ArtifactUri)
+			}
+
+			response.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("run output %q of %s: %s", pointer.From(runOutput.Name), id, artifact),
+			})
+		}
+	}
+}
+
+// lastRunStatus returns a description of the last build of the Image Template, which contains the reason for a failed
+// build - any error retrieving the status is ignored since this is only used to supplement other messages
+func (v *VirtualMachineImageTemplateBuildAction) lastRunStatus(ctx context.Context, id virtualmachineimagetemplate.ImageTemplateId) string {
+	if ctx.Err() != nil {
+		return ""
+	}
+
+	resp, err := v.Client.Compute.VirtualMachineImageTemplatesClient.Get(ctx, id)
+	if err != nil || resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.LastRunStatus == nil {
+		return ""
+	}
+
+	status := resp.Model.Properties.LastRunStatus
+	result := fmt.Sprintf("\n\nLast Run State: %s", pointer.From(status.RunState))
+	if subState := pointer.From(status.RunSubState); subState != "" {
+		result += fmt.Sprintf(" (%s)", subState)
+	}
+	if message := pointer.From(status.Message); message != "" {
+		result += fmt.Sprintf("\nMessage: %s", message)
+	}
+
+	return result
+}
+
+func (v *VirtualMachineImageTemplateBuildAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	v.Defaults(ctx, request, response)
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type VirtualMachineImageTemplateBuildAction struct{}
+
+func TestAccVirtualMachineImageTemplateBuildAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_image_template_build", "test")
+	a := VirtualMachineImageTemplateBuildAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *VirtualMachineImageTemplateBuildAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_virtual_machine_image_template.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_virtual_machine_image_template_build.test]
+    }
+  }
+}
+
+action "azurerm_virtual_machine_image_template_build" "test" {
+  config {
+    virtual_machine_image_template_id = azurerm_virtual_machine_image_template.test.id
+    timeout                           = "3h"
+  }
+}
+`, VirtualMachineImageTemplateResource{}.basic(data))
+}
@@ -0,0 +1,1057 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/images"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/imagebuilder/2024-02-01/virtualmachineimagetemplate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	virtualMachineImageTemplateCustomizerTypeFile          = "File"
+	virtualMachineImageTemplateCustomizerTypePowerShell    = "PowerShell"
+	virtualMachineImageTemplateCustomizerTypeShell         = "Shell"
+	virtualMachineImageTemplateCustomizerTypeWindowsUpdate = "WindowsUpdate"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_machine_image_template -service-package-name compute -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
+type VirtualMachineImageTemplateResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate   = VirtualMachineImageTemplateResource{}
+	_ sdk.ResourceWithIdentity = VirtualMachineImageTemplateResource{}
+)
+
+func (r VirtualMachineImageTemplateResource) Identity() resourceids.ResourceId {
+	return &virtualmachineimagetemplate.ImageTemplateId{}
+}
+
+type VirtualMachineImageTemplateModel struct {
+	Name                     string                                              `tfschema:"name"`
+	ResourceGroupName        string                                              `tfschema:"resource_group_name"`
+	Location                 string                                              `tfschema:"location"`
+	Identity                 []identity.ModelUserAssigned                        `tfschema:"identity"`
+	PlatformImageSource      []VirtualMachineImageTemplatePlatformImageSource    `tfschema:"platform_image_source"`
+	SharedImageVersionSource []VirtualMachineImageTemplateSharedImageSource      `tfschema:"shared_image_version_source"`
+	ManagedImageSource       []VirtualMachineImageTemplateManagedImageSource     `tfschema:"managed_image_source"`
+	Customizer               []VirtualMachineImageTemplateCustomizer             `tfschema:"customizer"`
+	SharedImageDistribution  []VirtualMachineImageTemplateSharedImageDistribute  `tfschema:"shared_image_distribution"`
+	ManagedImageDistribution []VirtualMachineImageTemplateManagedImageDistribute `tfschema:"managed_image_distribution"`
+	VhdDistribution          []VirtualMachineImageTemplateVhdDistribute          `tfschema:"vhd_distribution"`
+	BuildTimeoutInMinutes    int64                                               `tfschema:"build_timeout_in_minutes"`
+	BuildVirtualMachine      []VirtualMachineImageTemplateBuildVirtualMachine    `tfschema:"build_virtual_machine"`
+	StagingResourceGroupId   string                                              `tfschema:"staging_resource_group_id"`
+	Tags                     map[string]string                                   `tfschema:"tags"`
+}
+
+type VirtualMachineImageTemplatePlatformImageSource struct {
+	Publisher string `tfschema:"publisher"`
+	Offer     string `tfschema:"offer"`
+	Sku       string `tfschema:"sku"`
+	Version   string `tfschema:"version"`
+}
+
+type VirtualMachineImageTemplateSharedImageSource struct {
+	ImageVersionId string `tfschema:"image_version_id"`
+}
+
+type VirtualMachineImageTemplateManagedImageSource struct {
+	ImageId string `tfschema:"image_id"`
+}
+
+type VirtualMachineImageTemplateCustomizer struct {
+	Type           string   `tfschema:"type"`
+	Name           string   `tfschema:"name"`
+	Inline         []string `tfschema:"inline"`
+	ScriptUri      string   `tfschema:"script_uri"`
+	SourceUri      string   `tfschema:"source_uri"`
+	Destination    string   `tfschema:"destination"`
+	Sha256Checksum string   `tfschema:"sha256_checksum"`
+	RunElevated    bool     `tfschema:"run_elevated"`
+	RunAsSystem    bool     `tfschema:"run_as_system"`
+	ValidExitCodes []int64  `tfschema:"valid_exit_codes"`
+	SearchCriteria string   `tfschema:"search_criteria"`
+	Filters        []string `tfschema:"filters"`
+	UpdateLimit    int64    `tfschema:"update_limit"`
+}
+
+type VirtualMachineImageTemplateSharedImageDistribute struct {
+	GalleryImageId     string                                    `tfschema:"gallery_image_id"`
+	RunOutputName      string                                    `tfschema:"run_output_name"`
+	TargetRegion       []VirtualMachineImageTemplateTargetRegion `tfschema:"target_region"`
+	ExcludeFromLatest  bool                                      `tfschema:"exclude_from_latest"`
+	StorageAccountType string                                    `tfschema:"storage_account_type"`
+	ArtifactTags       map[string]string                         `tfschema:"artifact_tags"`
+}
+
+type VirtualMachineImageTemplateTargetRegion struct {
+	Name               string `tfschema:"name"`
+	ReplicaCount       int64  `tfschema:"replica_count"`
+	StorageAccountType string `tfschema:"storage_account_type"`
+}
+
+type VirtualMachineImageTemplateManagedImageDistribute struct {
+	ImageId       string            `tfschema:"image_id"`
+	Location      string            `tfschema:"location"`
+	RunOutputName string            `tfschema:"run_output_name"`
+	ArtifactTags  map[string]string `tfschema:"artifact_tags"`
+}
+
+type VirtualMachineImageTemplateVhdDistribute struct {
+	RunOutputName string            `tfschema:"run_output_name"`
+	Uri           string            `tfschema:"uri"`
+	ArtifactTags  map[string]string `tfschema:"artifact_tags"`
+}
+
+type VirtualMachineImageTemplateBuildVirtualMachine struct {
+	Size                      string   `tfschema:"size"`
+	OsDiskSizeGB              int64    `tfschema:"os_disk_size_gb"`
+	IdentityIds               []string `tfschema:"identity_ids"`
+	SubnetId                  string   `tfschema:"subnet_id"`
+	ProxyVMSize               string   `tfschema:"proxy_vm_size"`
+	ContainerInstanceSubnetId string   `tfschema:"container_instance_subnet_id"`
+}
+
+func (r VirtualMachineImageTemplateResource) Arguments() map[string]*pluginsdk.Schema {
+	sources := []string{"platform_image_source", "shared_image_version_source", "managed_image_source"}
+	distributions := []string{"shared_image_distribution", "managed_image_distribution", "vhd_distribution"}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,63}$`),
+				"`name` must be between 1 and 64 characters, start with a letter or number and contain only letters, numbers, underscores, periods and hyphens",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.UserAssignedIdentityRequired(),
+
+		"platform_image_source": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sources,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"offer": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sku": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      "latest",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"shared_image_version_source": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sources,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					// this can either be the ID of a Gallery Image Version, or the ID of a Gallery Image to use the latest version
+					"image_version_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"managed_image_source": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sources,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"image_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: images.ValidateImageID,
+					},
+				},
+			},
+		},
+
+		"customizer": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							virtualMachineImageTemplateCustomizerTypeFile,
+							virtualMachineImageTemplateCustomizerTypePowerShell,
+							virtualMachineImageTemplateCustomizerTypeShell,
+							virtualMachineImageTemplateCustomizerTypeWindowsUpdate,
+						}, false),
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"inline": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+
+					"source_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+
+					"destination": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sha256_checksum": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"run_elevated": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"run_as_system": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"valid_exit_codes": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeInt,
+						},
+					},
+
+					"search_criteria": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"filters": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"update_limit": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+
+		"shared_image_distribution": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			AtLeastOneOf: distributions,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"gallery_image_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"run_output_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"target_region": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": commonschema.LocationWithoutForceNew(),
+
+								"replica_count": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      1,
+									ValidateFunc: validation.IntBetween(1, 100),
+								},
+
+								"storage_account_type": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(virtualmachineimagetemplate.SharedImageStorageAccountTypeStandardLRS),
+									ValidateFunc: validation.StringInSlice(virtualmachineimagetemplate.PossibleValuesForSharedImageStorageAccountType(), false),
+								},
+							},
+						},
+					},
+
+					"exclude_from_latest": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"storage_account_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(virtualmachineimagetemplate.SharedImageStorageAccountTypeStandardLRS),
+						ValidateFunc: validation.StringInSlice(virtualmachineimagetemplate.PossibleValuesForSharedImageStorageAccountType(), false),
+					},
+
+					"artifact_tags": commonschema.Tags(),
+				},
+			},
+		},
+
+		"managed_image_distribution": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			AtLeastOneOf: distributions,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"image_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"location": commonschema.LocationWithoutForceNew(),
+
+					"run_output_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"artifact_tags": commonschema.Tags(),
+				},
+			},
+		},
+
+		"vhd_distribution": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			AtLeastOneOf: distributions,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"run_output_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+
+					"artifact_tags": commonschema.Tags(),
+				},
+			},
+		},
+
+		"build_timeout_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      240,
+			ValidateFunc: validation.IntBetween(0, 960),
+		},
+
+		"build_virtual_machine": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"size": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"os_disk_size_gb": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"identity_ids": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+						},
+					},
+
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: commonids.ValidateSubnetID,
+					},
+
+					"proxy_vm_size": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						RequiredWith: []string{"build_virtual_machine.0.subnet_id"},
+					},
+
+					"container_instance_subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: commonids.ValidateSubnetID,
+						RequiredWith: []string{"build_virtual_machine.0.subnet_id"},
+					},
+				},
+			},
+		},
+
+		"staging_resource_group_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateResourceGroupID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r VirtualMachineImageTemplateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VirtualMachineImageTemplateResource) ModelObject() interface{} {
+	return &VirtualMachineImageTemplateModel{}
+}
+
+func (r VirtualMachineImageTemplateResource) ResourceType() string {
+	return "azurerm_virtual_machine_image_template"
+}
+
+func (r VirtualMachineImageTemplateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return virtualmachineimagetemplate.ValidateImageTemplateID
+}
+
+func (r VirtualMachineImageTemplateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineImageTemplatesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config VirtualMachineImageTemplateModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := virtualmachineimagetemplate.NewImageTemplateID(subscriptionId, config.ResourceGroupName, config.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandUserAssignedMapFromModel(config.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			customizers, err := expandVirtualMachineImageTemplateCustomizers(config.Customizer)
+			if err != nil {
+				return err
+			}
+
+			payload := virtualmachineimagetemplate.ImageTemplate{
+				Identity: pointer.From(expandedIdentity),
+				Location: location.Normalize(config.Location),
+				Properties: &virtualmachineimagetemplate.ImageTemplateProperties{
+					BuildTimeoutInMinutes: pointer.To(config.BuildTimeoutInMinutes),
+					Customize:             customizers,
+					Distribute:            expandVirtualMachineImageTemplateDistributors(config),
+					Source:                expandVirtualMachineImageTemplateSource(config),
+					VMProfile:             expandVirtualMachineImageTemplateVMProfile(config.BuildVirtualMachine),
+				},
+				Tags: pointer.To(config.Tags),
+			}
+
+			if config.StagingResourceGroupId != "" {
+				payload.Properties.StagingResourceGroup = pointer.To(config.StagingResourceGroupId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
+		},
+	}
+}
+
+func (r VirtualMachineImageTemplateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineImageTemplatesClient
+
+			id, err := virtualmachineimagetemplate.ParseImageTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualMachineImageTemplateModel{
+				Name:              id.ImageTemplateName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				flattenedIdentity, err := identity.FlattenUserAssignedMapToModel(&model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(flattenedIdentity)
+
+				if props := model.Properties; props != nil {
+					state.BuildTimeoutInMinutes = pointer.From(props.BuildTimeoutInMinutes)
+					state.StagingResourceGroupId = pointer.From(props.StagingResourceGroup)
+					state.Customizer = flattenVirtualMachineImageTemplateCustomizers(props.Customize)
+					state.BuildVirtualMachine = flattenVirtualMachineImageTemplateVMProfile(props.VMProfile)
+
+					flattenVirtualMachineImageTemplateSource(props.Source, &state)
+					flattenVirtualMachineImageTemplateDistributors(props.Distribute, &state)
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualMachineImageTemplateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineImageTemplatesClient
+
+			id, err := virtualmachineimagetemplate.ParseImageTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config VirtualMachineImageTemplateModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := virtualmachineimagetemplate.ImageTemplateUpdateParameters{}
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandUserAssignedMapFromModel(config.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChanges("shared_image_distribution", "managed_image_distribution", "vhd_distribution", "build_virtual_machine") {
+				payload.Properties = &virtualmachineimagetemplate.ImageTemplateUpdateParametersProperties{}
+
+				if metadata.ResourceData.HasChanges("shared_image_distribution", "managed_image_distribution", "vhd_distribution") {
+					payload.Properties.Distribute = pointer.To(expandVirtualMachineImageTemplateDistributors(config))
+				}
+
+				if metadata.ResourceData.HasChange("build_virtual_machine") {
+					payload.Properties.VMProfile = expandVirtualMachineImageTemplateVMProfile(config.BuildVirtualMachine)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineImageTemplateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineImageTemplatesClient
+
+			id, err := virtualmachineimagetemplate.ParseImageTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandVirtualMachineImageTemplateSource(input VirtualMachineImageTemplateModel) virtualmachineimagetemplate.ImageTemplateSource {
+	if len(input.PlatformImageSource) > 0 {
+		v := input.PlatformImageSource[0]
+		return virtualmachineimagetemplate.ImageTemplatePlatformImageSource{
+			Publisher: pointer.To(v.Publisher),
+			Offer:     pointer.To(v.Offer),
+			Sku:       pointer.To(v.Sku),
+			Version:   pointer.To(v.Version),
+		}
+	}
+
+	if len(input.SharedImageVersionSource) > 0 {
+		return virtualmachineimagetemplate.ImageTemplateSharedImageVersionSource{
+			ImageVersionId: input.SharedImageVersionSource[0].ImageVersionId,
+		}
+	}
+
+	if len(input.ManagedImageSource) > 0 {
+		return virtualmachineimagetemplate.ImageTemplateManagedImageSource{
+			ImageId: input.ManagedImageSource[0].ImageId,
+		}
+	}
+
+	return nil
+}
+
+func flattenVirtualMachineImageTemplateSource(input virtualmachineimagetemplate.ImageTemplateSource, state *VirtualMachineImageTemplateModel) {
+	switch v := input.(type) {
+	case virtualmachineimagetemplate.ImageTemplatePlatformImageSource:
+		state.PlatformImageSource = []VirtualMachineImageTemplatePlatformImageSource{
+			{
+				Publisher: pointer.From(v.Publisher),
+				Offer:     pointer.From(v.Offer),
+				Sku:       pointer.From(v.Sku),
+				Version:   pointer.From(v.Version),
+			},
+		}
+
+	case virtualmachineimagetemplate.ImageTemplateSharedImageVersionSource:
+		state.SharedImageVersionSource = []VirtualMachineImageTemplateSharedImageSource{
+			{
+				ImageVersionId: v.ImageVersionId,
+			},
+		}
+
+	case virtualmachineimagetemplate.ImageTemplateManagedImageSource:
+		state.ManagedImageSource = []VirtualMachineImageTemplateManagedImageSource{
+			{
+				ImageId: v.ImageId,
+			},
+		}
+	}
+}
+
+func expandVirtualMachineImageTemplateCustomizers(input []VirtualMachineImageTemplateCustomizer) (*[]virtualmachineimagetemplate.ImageTemplateCustomizer, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	customizers := make([]virtualmachineimagetemplate.ImageTemplateCustomizer, 0)
+	for i, v := range input {
+		var name *string
+		if v.Name != "" {
+			name = pointer.To(v.Name)
+		}
+
+		switch v.Type {
+		case virtualMachineImageTemplateCustomizerTypeShell, virtualMachineImageTemplateCustomizerTypePowerShell:
+			if (len(v.Inline) == 0) == (v.ScriptUri == "") {
+				return nil, fmt.Errorf("exactly one of `inline` or `script_uri` must be specified for the %s `customizer` at index %d", v.Type, i)
+			}
+
+			var inline *[]string
+			if len(v.Inline) > 0 {
+				inline = pointer.To(v.Inline)
+			}
+			var scriptUri, checksum *string
+			if v.ScriptUri != "" {
+				scriptUri = pointer.To(v.ScriptUri)
+			}
+			if v.Sha256Checksum != "" {
+				checksum = pointer.To(v.Sha256Checksum)
+			}
+
+			if v.Type == virtualMachineImageTemplateCustomizerTypeShell {
+				customizers = append(customizers, virtualmachineimagetemplate.ImageTemplateShellCustomizer{
+					Name:           name,
+					Inline:         inline,
+					ScriptUri:      scriptUri,
+					Sha256Checksum: checksum,
+				})
+				continue
+			}
+
+			customizer := virtualmachineimagetemplate.ImageTemplatePowerShellCustomizer{
+				Name:           name,
+				Inline:         inline,
+				ScriptUri:      scriptUri,
+				Sha256Checksum: checksum,
+				RunElevated:    pointer.To(v.RunElevated),
+				RunAsSystem:    pointer.To(v.RunAsSystem),
+			}
+			if len(v.ValidExitCodes) > 0 {
+				customizer.ValidExitCodes = pointer.To(v.ValidExitCodes)
+			}
+			customizers = append(customizers, customizer)
+
+		case virtualMachineImageTemplateCustomizerTypeFile:
+			if v.SourceUri == "" || v.Destination == "" {
+				return nil, fmt.Errorf("`source_uri` and `destination` must be specified for the File `customizer` at index %d", i)
+			}
+
+			customizer := virtualmachineimagetemplate.ImageTemplateFileCustomizer{
+				Name:        name,
+				SourceUri:   pointer.To(v.SourceUri),
+				Destination: pointer.To(v.Destination),
+			}
+			if v.Sha256Checksum != "" {
+				customizer.Sha256Checksum = pointer.To(v.Sha256Checksum)
+			}
+			customizers = append(customizers, customizer)
+
+		case virtualMachineImageTemplateCustomizerTypeWindowsUpdate:
+			customizer := virtualmachineimagetemplate.ImageTemplateWindowsUpdateCustomizer{
+				Name: name,
+			}
+			if v.SearchCriteria != "" {
+				customizer.SearchCriteria = pointer.To(v.SearchCriteria)
+			}
+			if len(v.Filters) > 0 {
+				customizer.Filters = pointer.To(v.Filters)
+			}
+			if v.UpdateLimit > 0 {
+				customizer.UpdateLimit = pointer.To(v.UpdateLimit)
+			}
+			customizers = append(customizers, customizer)
+		}
+	}
+
+	return &customizers, nil
+}
+
+func flattenVirtualMachineImageTemplateCustomizers(input *[]virtualmachineimagetemplate.ImageTemplateCustomizer) []VirtualMachineImageTemplateCustomizer {
+	output := make([]VirtualMachineImageTemplateCustomizer, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		switch v := item.(type) {
+		case virtualmachineimagetemplate.ImageTemplateShellCustomizer:
+			output = append(output, VirtualMachineImageTemplateCustomizer{
+				Type:           virtualMachineImageTemplateCustomizerTypeShell,
+				Name:           pointer.From(v.Name),
+				Inline:         pointer.From(v.Inline),
+				ScriptUri:      pointer.From(v.ScriptUri),
+				Sha256Checksum: pointer.From(v.Sha256Checksum),
+			})
+
+		case virtualmachineimagetemplate.ImageTemplatePowerShellCustomizer:
+			output = append(output, VirtualMachineImageTemplateCustomizer{
+				Type:           virtualMachineImageTemplateCustomizerTypePowerShell,
+				Name:           pointer.From(v.Name),
+				Inline:         pointer.From(v.Inline),
+				ScriptUri:      pointer.From(v.ScriptUri),
+				Sha256Checksum: pointer.From(v.Sha256Checksum),
+				RunElevated:    pointer.From(v.RunElevated),
+				RunAsSystem:    pointer.From(v.RunAsSystem),
+				ValidExitCodes: pointer.From(v.ValidExitCodes),
+			})
+
+		case virtualmachineimagetemplate.ImageTemplateFileCustomizer:
+			output = append(output, VirtualMachineImageTemplateCustomizer{
+				Type:           virtualMachineImageTemplateCustomizerTypeFile,
+				Name:           pointer.From(v.Name),
+				SourceUri:      pointer.From(v.SourceUri),
+				Destination:    pointer.From(v.Destination),
+				Sha256Checksum: pointer.From(v.Sha256Checksum),
+			})
+
+		case virtualmachineimagetemplate.ImageTemplateWindowsUpdateCustomizer:
+			output = append(output, VirtualMachineImageTemplateCustomizer{
+				Type:           virtualMachineImageTemplateCustomizerTypeWindowsUpdate,
+				Name:           pointer.From(v.Name),
+				SearchCriteria: pointer.From(v.SearchCriteria),
+				Filters:        pointer.From(v.Filters),
+				UpdateLimit:    pointer.From(v.UpdateLimit),
+			})
+		}
+	}
+
+	return output
+}
+
+func expandVirtualMachineImageTemplateDistributors(input VirtualMachineImageTemplateModel) []virtualmachineimagetemplate.ImageTemplateDistributor {
+	distributors := make([]virtualmachineimagetemplate.ImageTemplateDistributor, 0)
+
+	for _, v := range input.SharedImageDistribution {
+		targetRegions := make([]virtualmachineimagetemplate.TargetRegion, 0)
+		for _, region := range v.TargetRegion {
+			targetRegions = append(targetRegions, virtualmachineimagetemplate.TargetRegion{
+				Name:               location.Normalize(region.Name),
+				ReplicaCount:       pointer.To(region.ReplicaCount),
+				StorageAccountType: pointer.To(virtualmachineimagetemplate.SharedImageStorageAccountType(region.StorageAccountType)),
+			})
+		}
+
+		distributors = append(distributors, virtualmachineimagetemplate.ImageTemplateSharedImageDistributor{
+			ArtifactTags:       pointer.To(v.ArtifactTags),
+			ExcludeFromLatest:  pointer.To(v.ExcludeFromLatest),
+			GalleryImageId:     v.GalleryImageId,
+			RunOutputName:      v.RunOutputName,
+			StorageAccountType: pointer.To(virtualmachineimagetemplate.SharedImageStorageAccountType(v.StorageAccountType)),
+			TargetRegions:      pointer.To(targetRegions),
+		})
+	}
+
+	for _, v := range input.ManagedImageDistribution {
+		distributors = append(distributors, virtualmachineimagetemplate.ImageTemplateManagedImageDistributor{
+			ArtifactTags:  pointer.To(v.ArtifactTags),
+			ImageId:       v.ImageId,
+			Location:      location.Normalize(v.Location),
+			RunOutputName: v.RunOutputName,
+		})
+	}
+
+	for _, v := range input.VhdDistribution {
+		distributor := virtualmachineimagetemplate.ImageTemplateVhdDistributor{
+			ArtifactTags:  pointer.To(v.ArtifactTags),
+			RunOutputName: v.RunOutputName,
+		}
+		if v.Uri != "" {
+			distributor.Uri = pointer.To(v.Uri)
+		}
+		distributors = append(distributors, distributor)
+	}
+
+	return distributors
+}
+
+func flattenVirtualMachineImageTemplateDistributors(input []virtualmachineimagetemplate.ImageTemplateDistributor, state *VirtualMachineImageTemplateModel) {
+	state.SharedImageDistribution = make([]VirtualMachineImageTemplateSharedImageDistribute, 0)
+	state.ManagedImageDistribution = make([]VirtualMachineImageTemplateManagedImageDistribute, 0)
+	state.VhdDistribution = make([]VirtualMachineImageTemplateVhdDistribute, 0)
+
+	for _, item := range input {
+		switch v := item.(type) {
+		case virtualmachineimagetemplate.ImageTemplateSharedImageDistributor:
+			targetRegions := make([]VirtualMachineImageTemplateTargetRegion, 0)
+			for _, region := range pointer.From(v.TargetRegions) {
+				targetRegions = append(targetRegions, VirtualMachineImageTemplateTargetRegion{
+					Name:               location.Normalize(region.Name),
+					ReplicaCount:       pointer.From(region.ReplicaCount),
+					StorageAccountType: string(pointer.From(region.StorageAccountType)),
+				})
+			}
+
+			state.SharedImageDistribution = append(state.SharedImageDistribution, VirtualMachineImageTemplateSharedImageDistribute{
+				ArtifactTags:       pointer.From(v.ArtifactTags),
+				ExcludeFromLatest:  pointer.From(v.ExcludeFromLatest),
+				GalleryImageId:     v.GalleryImageId,
+				RunOutputName:      v.RunOutputName,
+				StorageAccountType: string(pointer.From(v.StorageAccountType)),
+				TargetRegion:       targetRegions,
+			})
+
+		case virtualmachineimagetemplate.ImageTemplateManagedImageDistributor:
+			state.ManagedImageDistribution = append(state.ManagedImageDistribution, VirtualMachineImageTemplateManagedImageDistribute{
+				ArtifactTags:  pointer.From(v.ArtifactTags),
+				ImageId:       v.ImageId,
+				Location:      location.Normalize(v.Location),
+				RunOutputName: v.RunOutputName,
+			})
+
+		case virtualmachineimagetemplate.ImageTemplateVhdDistributor:
+			state.VhdDistribution = append(state.VhdDistribution, VirtualMachineImageTemplateVhdDistribute{
+				ArtifactTags:  pointer.From(v.ArtifactTags),
+				RunOutputName: v.RunOutputName,
+				Uri:           pointer.From(v.Uri),
+			})
+		}
+	}
+}
+
+func expandVirtualMachineImageTemplateVMProfile(input []VirtualMachineImageTemplateBuildVirtualMachine) *virtualmachineimagetemplate.ImageTemplateVMProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	profile := virtualmachineimagetemplate.ImageTemplateVMProfile{
+		UserAssignedIdentities: pointer.To(v.IdentityIds),
+	}
+
+	if v.Size != "" {
+		profile.VMSize = pointer.To(v.Size)
+	}
+
+	if v.OsDiskSizeGB > 0 {
+		profile.OsDiskSizeGB = pointer.To(v.OsDiskSizeGB)
+	}
+
+	if v.SubnetId != "" {
+		profile.VnetConfig = &virtualmachineimagetemplate.VirtualNetworkConfig{
+			SubnetId: pointer.To(v.SubnetId),
+		}
+		if v.ProxyVMSize != "" {
+			profile.VnetConfig.ProxyVMSize = pointer.To(v.ProxyVMSize)
+		}
+		if v.ContainerInstanceSubnetId != "" {
+			profile.VnetConfig.ContainerInstanceSubnetId = pointer.To(v.ContainerInstanceSubnetId)
+		}
+	}
+
+	return &profile
+}
+
+func flattenVirtualMachineImageTemplateVMProfile(input *virtualmachineimagetemplate.ImageTemplateVMProfile) []VirtualMachineImageTemplateBuildVirtualMachine {
+	if input == nil {
+		return []VirtualMachineImageTemplateBuildVirtualMachine{}
+	}
+
+	output := VirtualMachineImageTemplateBuildVirtualMachine{
+		Size:         pointer.From(input.VMSize),
+		OsDiskSizeGB: pointer.From(input.OsDiskSizeGB),
+		IdentityIds:  pointer.From(input.UserAssignedIdentities),
+	}
+
+	if vnet := input.VnetConfig; vnet != nil {
+		output.SubnetId = pointer.From(vnet.SubnetId)
+		output.ProxyVMSize = pointer.From(vnet.ProxyVMSize)
+		output.ContainerInstanceSubnetId = pointer.From(vnet.ContainerInstanceSubnetId)
+	}
+
+	return []VirtualMachineImageTemplateBuildVirtualMachine{output}
+}
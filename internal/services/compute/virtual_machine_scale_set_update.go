@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetrollingupgrades"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetvms"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
 )
 
@@ -109,6 +110,10 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForAutomati
 
 	log.Printf("[DEBUG] Updating instances for %s %s", metadata.OSType, id)
 	if err := rollingUpgradesClient.StartOSUpgradeThenPoll(ctx, virtualMachineScaleSetId); err != nil {
+		// the OS Upgrade is a Rolling Upgrade, so it can be cancelled - unlike an update to the model
+		err = sdk.CancelInterruptedOperation(ctx, err, func(ctx context.Context) error {
+			return metadata.Client.CancelRollingUpgradesBeforeDeletion(ctx, *id)
+		})
 		return fmt.Errorf("updating instances for %s %s: %+v", metadata.OSType, id, err)
 	}
 	log.Printf("[DEBUG] Updated instances for %s %s.", metadata.OSType, id)
@@ -0,0 +1,323 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package ccepolicy
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+
+	// NOTE: tar2ext4 is the implementation ACI (and `az confcom`) use to convert layers to ext4 file systems, which is
+	// required for the dm-verity root hashes to match - the ext4 writer is internal to hcsshim so this can't be narrowed
+	// further, and it's the only reason `containerd/cgroups`, `go.opencensus.io`, `golang/groupcache` and `pkg/errors` are vendored
+	"github.com/Microsoft/hcsshim/ext4/tar2ext4"
+)
+
+const (
+	mediaTypeOCIIndex            = "application/vnd.oci.image.index.v1+json"
+	mediaTypeOCIManifest         = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeDockerManifestList  = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeDockerManifest      = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerLayer         = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+	mediaTypeOCILayer            = "application/vnd.oci.image.layer.v1.tar"
+	mediaTypeOCILayerGzip        = "application/vnd.oci.image.layer.v1.tar+gzip"
+	defaultRegistry              = "docker.io"
+	defaultRegistryHost          = "registry-1.docker.io"
+	defaultTag                   = "latest"
+	confidentialContainerOS      = "linux"
+	confidentialContainerArchAMD = "amd64"
+)
+
+// ImageReference is a parsed container image reference, such as `mcr.microsoft.com/azuredocs/aci-helloworld:latest`.
+type ImageReference struct {
+	// Original is the image reference as specified in the configuration
+	Original string
+
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// Reference returns the tag or digest of the image.
+func (r ImageReference) Reference() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+	return r.Tag
+}
+
+// ParseImageReference parses `input` following the same defaulting as the Docker CLI, where images without a
+// registry are pulled from Docker Hub and images without a tag or digest use the `latest` tag.
+func ParseImageReference(input string) (*ImageReference, error) {
+	if input == "" {
+		return nil, fmt.Errorf("image reference was empty")
+	}
+
+	out := ImageReference{
+		Original: input,
+	}
+
+	remainder := input
+	if i := strings.Index(remainder, "@"); i >= 0 {
+		out.Digest = remainder[i+1:]
+		remainder = remainder[:i]
+		if !strings.HasPrefix(out.Digest, "sha256:") {
+			return nil, fmt.Errorf("image reference %q contains an unsupported digest, only `sha256` digests are supported", input)
+		}
+	}
+
+	// a tag can only be specified after the final `/`, otherwise the `:` denotes a registry port
+	if i := strings.LastIndex(remainder, ":"); i > strings.LastIndex(remainder, "/") {
+		out.Tag = remainder[i+1:]
+		remainder = remainder[:i]
+	}
+
+	segments := strings.SplitN(remainder, "/", 2)
+	if len(segments) == 2 && (strings.ContainsAny(segments[0], ".:") || segments[0] == "localhost") {
+		out.Registry = segments[0]
+		out.Repository = segments[1]
+	} else {
+		out.Registry = defaultRegistry
+		out.Repository = remainder
+	}
+
+	if out.Repository == "" {
+		return nil, fmt.Errorf("image reference %q did not contain a repository", input)
+	}
+
+	if out.Registry == defaultRegistry && !strings.Contains(out.Repository, "/") {
+		out.Repository = "library/" + out.Repository
+	}
+
+	if out.Tag == "" && out.Digest == "" {
+		out.Tag = defaultTag
+	}
+
+	return &out, nil
+}
+
+// ImageConfig is the subset of an image's configuration which is used to build the policy for a container.
+type ImageConfig struct {
+	// Layers are the dm-verity root hashes of the layers of the image once converted to ext4 file systems (which is
+	// how the layers are mounted within a Confidential container group), in order
+	Layers []string
+
+	Entrypoint           []string
+	Command              []string
+	EnvironmentVariables []string
+	WorkingDir           string
+	User                 string
+}
+
+// ImageResolver retrieves the ImageConfig for an image.
+type ImageResolver interface {
+	Resolve(ctx context.Context, image ImageReference) (*ImageConfig, error)
+}
+
+// ChainedResolver tries each resolver in order, returning the first ImageConfig which is found.
+type ChainedResolver []ImageResolver
+
+var _ ImageResolver = ChainedResolver{}
+
+func (c ChainedResolver) Resolve(ctx context.Context, image ImageReference) (*ImageConfig, error) {
+	errs := make([]string, 0)
+	for _, resolver := range c {
+		config, err := resolver.Resolve(ctx, image)
+		if err == nil {
+			return config, nil
+		}
+		errs = append(errs, err.Error())
+	}
+
+	return nil, fmt.Errorf("resolving image %q: %s", image.Original, strings.Join(errs, "; "))
+}
+
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Platform    *struct {
+		OS           string `json:"os"`
+		Architecture string `json:"architecture"`
+	} `json:"platform,omitempty"`
+}
+
+type manifest struct {
+	MediaType string       `json:"mediaType"`
+	Config    descriptor   `json:"config"`
+	Layers    []descriptor `json:"layers"`
+
+	// Manifests is populated when this is an image index (or Docker manifest list)
+	Manifests []descriptor `json:"manifests"`
+}
+
+type imageConfigBlob struct {
+	Config struct {
+		Entrypoint []string `json:"Entrypoint"`
+		Cmd        []string `json:"Cmd"`
+		Env        []string `json:"Env"`
+		WorkingDir string   `json:"WorkingDir"`
+		User       string   `json:"User"`
+	} `json:"config"`
+	RootFS struct {
+		DiffIDs []string `json:"diff_ids"`
+	} `json:"rootfs"`
+}
+
+// blobFetcher retrieves the content of a manifest (when `isManifest` is true) or blob by its digest
+type blobFetcher func(ctx context.Context, digest string, isManifest bool) ([]byte, error)
+
+// layerOpener opens the (unverified) content of a layer blob by its digest, layers are streamed rather than read into
+// memory since they can be considerably larger than manifests and configs
+type layerOpener func(ctx context.Context, digest string) (io.ReadCloser, error)
+
+// resolveManifest parses the (verified) manifest `raw`, following image indexes to the manifest for the platform
+// used by Confidential Containers, and returns the ImageConfig built from the image's config blob and layers.
+func resolveManifest(ctx context.Context, raw []byte, fetch blobFetcher, open layerOpener) (*ImageConfig, error) {
+	var m manifest
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, fmt.Errorf("parsing manifest: %+v", err)
+	}
+
+	if len(m.Manifests) > 0 || m.MediaType == mediaTypeOCIIndex || m.MediaType == mediaTypeDockerManifestList {
+		for _, item := range m.Manifests {
+			if item.Platform != nil && (item.Platform.OS != confidentialContainerOS || item.Platform.Architecture != confidentialContainerArchAMD) {
+				continue
+			}
+
+			nested, err := fetch(ctx, item.Digest, true)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving manifest %q: %+v", item.Digest, err)
+			}
+			return resolveManifest(ctx, nested, fetch, open)
+		}
+
+		return nil, fmt.Errorf("the image index did not contain a manifest for %s/%s", confidentialContainerOS, confidentialContainerArchAMD)
+	}
+
+	if m.Config.Digest == "" {
+		return nil, fmt.Errorf("the manifest did not contain a config")
+	}
+
+	rawConfig, err := fetch(ctx, m.Config.Digest, false)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving image config %q: %+v", m.Config.Digest, err)
+	}
+
+	var config imageConfigBlob
+	if err := json.Unmarshal(rawConfig, &config); err != nil {
+		return nil, fmt.Errorf("parsing image config %q: %+v", m.Config.Digest, err)
+	}
+
+	if len(m.Layers) != len(config.RootFS.DiffIDs) {
+		return nil, fmt.Errorf("the manifest contained %d layers but the image config %q contained %d diff IDs", len(m.Layers), m.Config.Digest, len(config.RootFS.DiffIDs))
+	}
+
+	layers := make([]string, 0, len(m.Layers))
+	for i, layer := range m.Layers {
+		rootHash, err := layerRootHash(ctx, layer, config.RootFS.DiffIDs[i], open)
+		if err != nil {
+			return nil, fmt.Errorf("computing the dm-verity root hash of layer %q: %+v", layer.Digest, err)
+		}
+		layers = append(layers, rootHash)
+	}
+
+	return &ImageConfig{
+		Layers:               layers,
+		Entrypoint:           config.Config.Entrypoint,
+		Command:              config.Config.Cmd,
+		EnvironmentVariables: config.Config.Env,
+		WorkingDir:           config.Config.WorkingDir,
+		User:                 config.Config.User,
+	}, nil
+}
+
+// layerRootHash returns the dm-verity root hash of the layer, computed in the same way as `az confcom` by converting the
+// layer's (uncompressed) tar to an ext4 file system and building the merkle tree of it. The layer is verified against
+// both its digest and `diffId` (the digest of the uncompressed tar) once it has been read.
+func layerRootHash(ctx context.Context, layer descriptor, diffId string, open layerOpener) (string, error) {
+	rc, err := open(ctx, layer.Digest)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	compressedHash, err := newDigestHash(layer.Digest)
+	if err != nil {
+		return "", err
+	}
+	compressed := io.TeeReader(rc, compressedHash)
+
+	var uncompressed io.Reader
+	switch layer.MediaType {
+	case mediaTypeOCILayerGzip, mediaTypeDockerLayer:
+		gz, err := gzip.NewReader(compressed)
+		if err != nil {
+			return "", fmt.Errorf("decompressing layer: %+v", err)
+		}
+		defer gz.Close()
+		uncompressed = gz
+	case mediaTypeOCILayer:
+		uncompressed = compressed
+	default:
+		return "", fmt.Errorf("unsupported layer media type %q, only uncompressed and gzip compressed layers are supported", layer.MediaType)
+	}
+
+	diffIdHash, err := newDigestHash(diffId)
+	if err != nil {
+		return "", err
+	}
+	tarStream := io.TeeReader(uncompressed, diffIdHash)
+
+	rootHash, err := tar2ext4.ConvertAndComputeRootDigest(tarStream)
+	if err != nil {
+		return "", err
+	}
+
+	// the conversion stops reading at the end of the tar archive, so any padding is read to complete the digests
+	if _, err := io.Copy(io.Discard, tarStream); err != nil {
+		return "", fmt.Errorf("reading layer: %+v", err)
+	}
+	if _, err := io.Copy(io.Discard, compressed); err != nil {
+		return "", fmt.Errorf("reading layer: %+v", err)
+	}
+
+	if "sha256:"+hex.EncodeToString(compressedHash.Sum(nil)) != layer.Digest {
+		return "", fmt.Errorf("the content retrieved for %q did not match its digest", layer.Digest)
+	}
+	if "sha256:"+hex.EncodeToString(diffIdHash.Sum(nil)) != diffId {
+		return "", fmt.Errorf("the uncompressed content of %q did not match the diff ID %q", layer.Digest, diffId)
+	}
+
+	return rootHash, nil
+}
+
+func newDigestHash(digest string) (hash.Hash, error) {
+	if !strings.HasPrefix(digest, "sha256:") {
+		return nil, fmt.Errorf("unsupported digest %q, only `sha256` digests are supported", digest)
+	}
+	return sha256.New(), nil
+}
+
+// verifyDigest ensures that `content` matches the (sha256) `digest` it was retrieved by.
+func verifyDigest(digest string, content []byte) error {
+	expected, ok := strings.CutPrefix(digest, "sha256:")
+	if !ok {
+		return fmt.Errorf("unsupported digest %q, only `sha256` digests are supported", digest)
+	}
+
+	actual := sha256.Sum256(content)
+	if hex.EncodeToString(actual[:]) != expected {
+		return fmt.Errorf("the content retrieved for %q did not match its digest", digest)
+	}
+
+	return nil
+}
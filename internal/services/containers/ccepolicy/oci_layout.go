@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package ccepolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	annotationRefName       = "org.opencontainers.image.ref.name"
+	annotationContainerdRef = "io.containerd.image.name"
+)
+
+// OCILayoutResolver resolves images from a local OCI Image Layout (e.g. as produced by `docker buildx build
+// --output type=oci` or `skopeo copy`), allowing the policy to be generated without access to the registry.
+type OCILayoutResolver struct {
+	Path string
+}
+
+var _ ImageResolver = OCILayoutResolver{}
+
+func (r OCILayoutResolver) Resolve(ctx context.Context, image ImageReference) (*ImageConfig, error) {
+	rawIndex, err := os.ReadFile(filepath.Join(r.Path, "index.json"))
+	if err != nil {
+		return nil, fmt.Errorf("reading OCI layout index: %+v", err)
+	}
+
+	var index manifest
+	if err := json.Unmarshal(rawIndex, &index); err != nil {
+		return nil, fmt.Errorf("parsing OCI layout index: %+v", err)
+	}
+
+	var match *descriptor
+	for i, item := range index.Manifests {
+		if layoutEntryMatches(item, image) {
+			match = &index.Manifests[i]
+			break
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("image %q was not found in the OCI layout at %q", image.Original, r.Path)
+	}
+
+	raw, err := r.blob(ctx, match.Digest, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveManifest(ctx, raw, r.blob, r.layer)
+}
+
+func (r OCILayoutResolver) blob(_ context.Context, digest string, _ bool) ([]byte, error) {
+	path, err := r.blobPath(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading blob %q from OCI layout: %+v", digest, err)
+	}
+
+	if err := verifyDigest(digest, content); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+func (r OCILayoutResolver) layer(_ context.Context, digest string) (io.ReadCloser, error) {
+	path, err := r.blobPath(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening blob %q from OCI layout: %+v", digest, err)
+	}
+
+	return file, nil
+}
+
+func (r OCILayoutResolver) blobPath(digest string) (string, error) {
+	algorithm, encoded, ok := strings.Cut(digest, ":")
+	if !ok {
+		return "", fmt.Errorf("invalid digest %q", digest)
+	}
+
+	return filepath.Join(r.Path, "blobs", algorithm, encoded), nil
+}
+
+// layoutEntryMatches returns whether an entry in the layout's index refers to `image` - either by digest, by its
+// full name or (per the OCI Image Layout specification) by its tag alone.
+func layoutEntryMatches(item descriptor, image ImageReference) bool {
+	if image.Digest != "" {
+		return item.Digest == image.Digest
+	}
+
+	for _, key := range []string{annotationContainerdRef, annotationRefName} {
+		name := item.Annotations[key]
+		if name == "" {
+			continue
+		}
+
+		if name == image.Tag || name == image.Original {
+			return true
+		}
+
+		if parsed, err := ParseImageReference(name); err == nil && parsed.Registry == image.Registry && parsed.Repository == image.Repository && parsed.Tag == image.Tag {
+			return true
+		}
+	}
+
+	return false
+}
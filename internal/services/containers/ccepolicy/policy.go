@@ -0,0 +1,416 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package ccepolicy
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	apiVersion       = "0.10.0"
+	frameworkVersion = "0.2.3"
+
+	// pauseContainerImage is the pause container which ACI runs within each Confidential container group, and which
+	// must be allowed by every policy
+	pauseContainerImage = "mcr.microsoft.com/oss/kubernetes/pause:3.6"
+
+	// pauseContainerLayer is the dm-verity root hash of the single (linux/amd64) layer of `pauseContainerImage`. This is
+	// the value used for the pause container by the `confcom` Azure CLI extension (in `azext_confcom/data/internal_config.json`)
+	// and is recomputed from the registry by TestAccPauseContainerLayer.
+	pauseContainerLayer = "16b514057a06ad665f92c02863aca074fd5976c755d26bff16365299169e8415"
+)
+
+// VolumeType is the type of volume a Mount refers to, which determines the source path ACI mounts it from.
+type VolumeType string
+
+const (
+	VolumeTypeAzureFile VolumeType = "azureFileVolume"
+	VolumeTypeEmptyDir  VolumeType = "emptyDir"
+	VolumeTypeGitRepo   VolumeType = "gitRepoVolume"
+	VolumeTypeSecret    VolumeType = "secretsVolume"
+)
+
+// Mount is a volume mounted into a Container.
+type Mount struct {
+	MountPath  string
+	ReadOnly   bool
+	VolumeType VolumeType
+}
+
+// Container is the definition of a (init) container within the container group, from which the policy is built.
+type Container struct {
+	Name  string
+	Image string
+
+	// Command overrides the entrypoint and command of the image when specified
+	Command []string
+
+	EnvironmentVariables map[string]string
+
+	// SecureEnvironmentVariableNames are the names of the secure environment variables, the values of which are
+	// deliberately omitted from the policy (which is not confidential) and allowed to take any value
+	SecureEnvironmentVariableNames []string
+
+	Mounts     []Mount
+	Privileged bool
+}
+
+// Options configures the generated policy.
+type Options struct {
+	// AllowStdioAccess allows the container's standard input/output to be accessed (e.g. for logging)
+	AllowStdioAccess bool
+
+	// CustomFragment is Rego appended to the generated policy, which can be used to add further rules
+	CustomFragment string
+}
+
+// Generate builds the Confidential Computing Enforcement (CCE) policy for `containers`, resolving each container's
+// image using `resolver`, and returns the Rego policy.
+func Generate(ctx context.Context, resolver ImageResolver, containers []Container, options Options) (string, error) {
+	policyContainers := make([]policyContainer, 0, len(containers)+1)
+	for _, c := range containers {
+		image, err := ParseImageReference(c.Image)
+		if err != nil {
+			return "", fmt.Errorf("parsing image for container %q: %+v", c.Name, err)
+		}
+
+		config, err := resolver.Resolve(ctx, *image)
+		if err != nil {
+			return "", fmt.Errorf("resolving image for container %q: %+v", c.Name, err)
+		}
+
+		policyContainers = append(policyContainers, buildPolicyContainer(c, *config, options))
+	}
+
+	policyContainers = append(policyContainers, pauseContainer())
+
+	return render(policyContainers, options)
+}
+
+// GenerateBase64 returns the policy generated by Generate, base64 encoded as required by the API.
+func GenerateBase64(ctx context.Context, resolver ImageResolver, containers []Container, options Options) (string, error) {
+	policy, err := Generate(ctx, resolver, containers, options)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString([]byte(policy)), nil
+}
+
+type policyContainer struct {
+	AllowElevated        bool               `json:"allow_elevated"`
+	AllowStdioAccess     bool               `json:"allow_stdio_access"`
+	Capabilities         policyCapabilities `json:"capabilities"`
+	Command              []string           `json:"command"`
+	EnvRules             []policyEnvRule    `json:"env_rules"`
+	ExecProcesses        []interface{}      `json:"exec_processes"`
+	Id                   string             `json:"id"`
+	Layers               []string           `json:"layers"`
+	Mounts               []policyMount      `json:"mounts"`
+	Name                 string             `json:"name"`
+	NoNewPrivileges      bool               `json:"no_new_privileges"`
+	SeccompProfileSha256 string             `json:"seccomp_profile_sha256"`
+	Signals              []interface{}      `json:"signals"`
+	User                 policyUser         `json:"user"`
+	WorkingDir           string             `json:"working_dir"`
+}
+
+type policyCapabilities struct {
+	Ambient     []string `json:"ambient"`
+	Bounding    []string `json:"bounding"`
+	Effective   []string `json:"effective"`
+	Inheritable []string `json:"inheritable"`
+	Permitted   []string `json:"permitted"`
+}
+
+type policyEnvRule struct {
+	Pattern  string `json:"pattern"`
+	Required bool   `json:"required"`
+	Strategy string `json:"strategy"`
+}
+
+type policyMount struct {
+	Destination string   `json:"destination"`
+	Options     []string `json:"options"`
+	Source      string   `json:"source"`
+	Type        string   `json:"type"`
+}
+
+type policyIdName struct {
+	Pattern  string `json:"pattern"`
+	Strategy string `json:"strategy"`
+}
+
+type policyUser struct {
+	GroupIdNames []policyIdName `json:"group_idnames"`
+	Umask        string         `json:"umask"`
+	UserIdName   policyIdName   `json:"user_idname"`
+}
+
+func buildPolicyContainer(c Container, config ImageConfig, options Options) policyContainer {
+	command := c.Command
+	if len(command) == 0 {
+		command = append(append([]string{}, config.Entrypoint...), config.Command...)
+	}
+
+	workingDir := config.WorkingDir
+	if workingDir == "" {
+		workingDir = "/"
+	}
+
+	capabilities := defaultCapabilities()
+	if c.Privileged {
+		capabilities = privilegedCapabilities()
+	}
+
+	return policyContainer{
+		AllowElevated:    c.Privileged,
+		AllowStdioAccess: options.AllowStdioAccess,
+		Capabilities:     capabilities,
+		Command:          nonNil(command),
+		EnvRules:         buildEnvRules(c, config),
+		ExecProcesses:    []interface{}{},
+		Id:               c.Image,
+		Layers:           nonNil(config.Layers),
+		Mounts:           buildMounts(c.Mounts),
+		Name:             c.Name,
+		Signals:          []interface{}{},
+		User:             anyUser(),
+		WorkingDir:       workingDir,
+	}
+}
+
+func buildEnvRules(c Container, config ImageConfig) []policyEnvRule {
+	rules := make([]policyEnvRule, 0)
+
+	// environment variables specified on the container override those defined by the image
+	for _, v := range config.EnvironmentVariables {
+		name, _, _ := strings.Cut(v, "=")
+		if _, overridden := c.EnvironmentVariables[name]; overridden {
+			continue
+		}
+		rules = append(rules, policyEnvRule{Pattern: v, Required: false, Strategy: "string"})
+	}
+
+	names := make([]string, 0, len(c.EnvironmentVariables))
+	for name := range c.EnvironmentVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		rules = append(rules, policyEnvRule{Pattern: fmt.Sprintf("%s=%s", name, c.EnvironmentVariables[name]), Required: false, Strategy: "string"})
+	}
+
+	secureNames := append([]string{}, c.SecureEnvironmentVariableNames...)
+	sort.Strings(secureNames)
+	for _, name := range secureNames {
+		rules = append(rules, policyEnvRule{Pattern: fmt.Sprintf("%s=.+", name), Required: false, Strategy: "re2"})
+	}
+
+	return append(rules, runtimeEnvRules()...)
+}
+
+// runtimeEnvRules returns the rules for the environment variables which are injected into every container by ACI
+func runtimeEnvRules() []policyEnvRule {
+	return []policyEnvRule{
+		{Pattern: "TERM=xterm", Required: false, Strategy: "string"},
+		{Pattern: "((?i)FABRIC)_.+=.+", Required: false, Strategy: "re2"},
+		{Pattern: "HOSTNAME=.+", Required: false, Strategy: "re2"},
+		{Pattern: "T(E)?MP=.+", Required: false, Strategy: "re2"},
+		{Pattern: "FabricPackageFileName=.+", Required: false, Strategy: "re2"},
+		{Pattern: "HostedServiceName=.+", Required: false, Strategy: "re2"},
+		{Pattern: "IDENTITY_API_VERSION=.+", Required: false, Strategy: "re2"},
+		{Pattern: "IDENTITY_HEADER=.+", Required: false, Strategy: "re2"},
+		{Pattern: "IDENTITY_SERVER_THUMBPRINT=.+", Required: false, Strategy: "re2"},
+		{Pattern: "azurecontainerinstance_restarted_by=.+", Required: false, Strategy: "re2"},
+	}
+}
+
+func buildMounts(input []Mount) []policyMount {
+	mounts := []policyMount{
+		{
+			Destination: "/etc/resolv.conf",
+			Options:     []string{"rbind", "rshared", "rw"},
+			Source:      "sandbox:///tmp/atlas/resolvconf/.+",
+			Type:        "bind",
+		},
+	}
+
+	sorted := append([]Mount{}, input...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].MountPath < sorted[j].MountPath
+	})
+
+	for _, m := range sorted {
+		access := "rw"
+		if m.ReadOnly {
+			access = "ro"
+		}
+
+		mounts = append(mounts, policyMount{
+			Destination: m.MountPath,
+			Options:     []string{"rbind", "rshared", access},
+			Source:      fmt.Sprintf("sandbox:///tmp/atlas/%s/.+", m.VolumeType),
+			Type:        "bind",
+		})
+	}
+
+	return mounts
+}
+
+func pauseContainer() policyContainer {
+	return policyContainer{
+		AllowElevated:    false,
+		AllowStdioAccess: false,
+		Capabilities: policyCapabilities{
+			Ambient:     []string{},
+			Bounding:    []string{},
+			Effective:   []string{},
+			Inheritable: []string{},
+			Permitted:   []string{},
+		},
+		Command:         []string{"/pause"},
+		EnvRules:        []policyEnvRule{{Pattern: "PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin", Required: true, Strategy: "string"}, {Pattern: "TERM=xterm", Required: false, Strategy: "string"}},
+		ExecProcesses:   []interface{}{},
+		Id:              pauseContainerImage,
+		Layers:          []string{pauseContainerLayer},
+		Mounts:          []policyMount{},
+		Name:            "pause-container",
+		NoNewPrivileges: true,
+		Signals:         []interface{}{},
+		User: policyUser{
+			GroupIdNames: []policyIdName{{Pattern: "", Strategy: "any"}},
+			Umask:        "0022",
+			UserIdName:   policyIdName{Pattern: "", Strategy: "any"},
+		},
+		WorkingDir: "/",
+	}
+}
+
+func anyUser() policyUser {
+	return policyUser{
+		GroupIdNames: []policyIdName{{Pattern: "", Strategy: "any"}},
+		Umask:        "0022",
+		UserIdName:   policyIdName{Pattern: "", Strategy: "any"},
+	}
+}
+
+func defaultCapabilities() policyCapabilities {
+	defaults := []string{
+		"CAP_AUDIT_WRITE", "CAP_CHOWN", "CAP_DAC_OVERRIDE", "CAP_FOWNER", "CAP_FSETID", "CAP_KILL", "CAP_MKNOD",
+		"CAP_NET_BIND_SERVICE", "CAP_NET_RAW", "CAP_SETFCAP", "CAP_SETGID", "CAP_SETPCAP", "CAP_SETUID", "CAP_SYS_CHROOT",
+	}
+	return policyCapabilities{
+		Ambient:     []string{},
+		Bounding:    defaults,
+		Effective:   defaults,
+		Inheritable: []string{},
+		Permitted:   defaults,
+	}
+}
+
+func privilegedCapabilities() policyCapabilities {
+	all := []string{
+		"CAP_AUDIT_CONTROL", "CAP_AUDIT_READ", "CAP_AUDIT_WRITE", "CAP_BLOCK_SUSPEND", "CAP_BPF", "CAP_CHECKPOINT_RESTORE",
+		"CAP_CHOWN", "CAP_DAC_OVERRIDE", "CAP_DAC_READ_SEARCH", "CAP_FOWNER", "CAP_FSETID", "CAP_IPC_LOCK", "CAP_IPC_OWNER",
+		"CAP_KILL", "CAP_LEASE", "CAP_LINUX_IMMUTABLE", "CAP_MAC_ADMIN", "CAP_MAC_OVERRIDE", "CAP_MKNOD", "CAP_NET_ADMIN",
+		"CAP_NET_BIND_SERVICE", "CAP_NET_BROADCAST", "CAP_NET_RAW", "CAP_PERFMON", "CAP_SETFCAP", "CAP_SETGID",
+		"CAP_SETPCAP", "CAP_SETUID", "CAP_SYSLOG", "CAP_SYS_ADMIN", "CAP_SYS_BOOT", "CAP_SYS_CHROOT", "CAP_SYS_MODULE",
+		"CAP_SYS_NICE", "CAP_SYS_PACCT", "CAP_SYS_PTRACE", "CAP_SYS_RAWIO", "CAP_SYS_RESOURCE", "CAP_SYS_TIME",
+		"CAP_SYS_TTY_CONFIG", "CAP_WAKE_ALARM",
+	}
+	return policyCapabilities{
+		Ambient:     []string{},
+		Bounding:    all,
+		Effective:   all,
+		Inheritable: all,
+		Permitted:   all,
+	}
+}
+
+func nonNil(input []string) []string {
+	if input == nil {
+		return []string{}
+	}
+	return input
+}
+
+func render(containers []policyContainer, options Options) (string, error) {
+	encodedContainers, err := marshalIndented(containers)
+	if err != nil {
+		return "", fmt.Errorf("encoding containers: %+v", err)
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, `package policy
+
+import future.keywords.every
+import future.keywords.in
+
+api_version := %q
+framework_version := %q
+
+fragments := [
+  {
+    "feed": "mcr.microsoft.com/aci/aci-cc-infra-fragment",
+    "includes": ["containers", "fragments"],
+    "issuer": "did:x509:0:sha256:I__iuL25oXEVFdTP_aBLx_eT1RPHbCQ_ECBQfYZpt9s::eku:1.3.6.1.4.1.311.76.59.1.3",
+    "minimum_svn": "1"
+  }
+]
+
+containers := %s
+
+allow_properties_access := true
+allow_dump_stacks := false
+allow_runtime_logging := false
+allow_environment_variable_dropping := true
+allow_unencrypted_scratch := false
+allow_capability_dropping := true
+
+mount_device := data.framework.mount_device
+unmount_device := data.framework.unmount_device
+mount_overlay := data.framework.mount_overlay
+unmount_overlay := data.framework.unmount_overlay
+create_container := data.framework.create_container
+exec_in_container := data.framework.exec_in_container
+exec_external := data.framework.exec_external
+shutdown_container := data.framework.shutdown_container
+signal_container_process := data.framework.signal_container_process
+plan9_mount := data.framework.plan9_mount
+plan9_unmount := data.framework.plan9_unmount
+get_properties := data.framework.get_properties
+dump_stacks := data.framework.dump_stacks
+runtime_logging := data.framework.runtime_logging
+load_fragment := data.framework.load_fragment
+scratch_mount := data.framework.scratch_mount
+scratch_unmount := data.framework.scratch_unmount
+
+reason := {"errors": data.framework.errors}
+`, apiVersion, frameworkVersion, encodedContainers)
+
+	if fragment := strings.TrimSpace(options.CustomFragment); fragment != "" {
+		fmt.Fprintf(buf, "\n%s\n", fragment)
+	}
+
+	return buf.String(), nil
+}
+
+func marshalIndented(input interface{}) (string, error) {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(input); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
@@ -0,0 +1,443 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package ccepolicy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testLayerRootHashes are the dm-verity root hashes of the layers written by writeTestImage, which differ from the
+// layers' diff IDs since they're computed from the ext4 file system each layer is converted to
+var testLayerRootHashes = []string{
+	"73e6bee0689d6f8b099c6aab73a960fa0617927c9a743365f748c48c73dd95a0",
+	"47ba5a28b36c28f60eb59dca2dde237c8517d6c21c694f2d1672147a91d17b62",
+}
+
+func TestParseImageReference(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *ImageReference
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input: "nginx",
+			Expected: &ImageReference{
+				Registry:   "docker.io",
+				Repository: "library/nginx",
+				Tag:        "latest",
+			},
+		},
+		{
+			Input: "bitnami/nginx:1.25",
+			Expected: &ImageReference{
+				Registry:   "docker.io",
+				Repository: "bitnami/nginx",
+				Tag:        "1.25",
+			},
+		},
+		{
+			Input: "mcr.microsoft.com/azuredocs/aci-helloworld:latest",
+			Expected: &ImageReference{
+				Registry:   "mcr.microsoft.com",
+				Repository: "azuredocs/aci-helloworld",
+				Tag:        "latest",
+			},
+		},
+		{
+			Input: "localhost:5000/app",
+			Expected: &ImageReference{
+				Registry:   "localhost:5000",
+				Repository: "app",
+				Tag:        "latest",
+			},
+		},
+		{
+			Input: "example.azurecr.io/app@sha256:abc123",
+			Expected: &ImageReference{
+				Registry:   "example.azurecr.io",
+				Repository: "app",
+				Digest:     "sha256:abc123",
+			},
+		},
+		{
+			Input:    "example.azurecr.io/app@md5:abc123",
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseImageReference(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+			t.Fatalf("expected a value but got an error: %+v", err)
+		}
+		if v.Expected == nil {
+			t.Fatalf("expected an error but got %+v", *actual)
+		}
+
+		v.Expected.Original = v.Input
+		if *actual != *v.Expected {
+			t.Fatalf("expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestOCILayoutResolver(t *testing.T) {
+	path := t.TempDir()
+	manifestDigest, diffIds := writeTestImage(t, func(digest string, content []byte) {
+		algorithm, encoded, _ := strings.Cut(digest, ":")
+		if err := os.MkdirAll(filepath.Join(path, "blobs", algorithm), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(path, "blobs", algorithm, encoded), content, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	index := fmt.Sprintf(`{"schemaVersion":2,"manifests":[{"mediaType":%q,"digest":%q,"annotations":{%q:"example.azurecr.io/app:v1"}}]}`, mediaTypeOCIManifest, manifestDigest, annotationContainerdRef)
+	if err := os.WriteFile(filepath.Join(path, "index.json"), []byte(index), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resolver := OCILayoutResolver{Path: path}
+	for _, input := range []string{"example.azurecr.io/app:v1", "example.azurecr.io/app@" + manifestDigest} {
+		image, err := ParseImageReference(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		config, err := resolver.Resolve(context.Background(), *image)
+		if err != nil {
+			t.Fatalf("resolving %q: %+v", input, err)
+		}
+		assertTestImageConfig(t, config, diffIds)
+	}
+
+	image, _ := ParseImageReference("example.azurecr.io/app:v2")
+	if _, err := resolver.Resolve(context.Background(), *image); err == nil {
+		t.Fatalf("expected an error resolving an image which isn't in the layout")
+	}
+}
+
+func TestRegistryResolver(t *testing.T) {
+	blobs := make(map[string][]byte)
+	manifestDigest, diffIds := writeTestImage(t, func(digest string, content []byte) {
+		blobs[digest] = content
+	})
+
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"token":"example-token"}`))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer example-token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="example"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		reference := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		if reference == "v1" {
+			reference = manifestDigest
+		}
+		content, ok := blobs[reference]
+		if !ok || !strings.HasPrefix(r.URL.Path, "/v2/app/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	host, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	image, err := ParseImageReference(host.Host + "/app:v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolver := RegistryResolver{
+		HTTPClient: server.Client(),
+		Credentials: map[string]RegistryCredential{
+			host.Host: {Username: "user", Password: "pass"},
+		},
+	}
+	config, err := resolver.Resolve(context.Background(), *image)
+	if err != nil {
+		t.Fatalf("resolving image: %+v", err)
+	}
+	assertTestImageConfig(t, config, diffIds)
+
+	resolver.Credentials = nil
+	if _, err := resolver.Resolve(context.Background(), *image); err == nil {
+		t.Fatalf("expected an error resolving an image without credentials")
+	}
+}
+
+func TestLayerRootHashVerifiesContent(t *testing.T) {
+	layer, diffId := testLayer(t, true, map[string]string{"app": "content"})
+	sum := sha256.Sum256(layer)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+	open := func(_ context.Context, _ string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(layer)), nil
+	}
+
+	if _, err := layerRootHash(context.Background(), descriptor{MediaType: mediaTypeOCILayerGzip, Digest: digest}, diffId, open); err != nil {
+		t.Fatalf("computing root hash: %+v", err)
+	}
+
+	otherDigest := "sha256:" + strings.Repeat("0", 64)
+	if _, err := layerRootHash(context.Background(), descriptor{MediaType: mediaTypeOCILayerGzip, Digest: otherDigest}, diffId, open); err == nil {
+		t.Fatalf("expected an error when the layer doesn't match its digest")
+	}
+	if _, err := layerRootHash(context.Background(), descriptor{MediaType: mediaTypeOCILayerGzip, Digest: digest}, otherDigest, open); err == nil {
+		t.Fatalf("expected an error when the layer doesn't match its diff ID")
+	}
+	if _, err := layerRootHash(context.Background(), descriptor{MediaType: "application/vnd.oci.image.layer.v1.tar+zstd", Digest: digest}, diffId, open); err == nil {
+		t.Fatalf("expected an error for an unsupported media type")
+	}
+}
+
+func TestAccPauseContainerLayer(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("TF_ACC not set")
+	}
+
+	image, err := ParseImageReference(pauseContainerImage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	config, err := RegistryResolver{}.Resolve(ctx, *image)
+	if err != nil {
+		t.Fatalf("resolving %q: %+v", pauseContainerImage, err)
+	}
+	if len(config.Layers) != 1 || config.Layers[0] != pauseContainerLayer {
+		t.Fatalf("expected the layers of %q to be [%q] but got %+v", pauseContainerImage, pauseContainerLayer, config.Layers)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	resolver := staticResolver{
+		Layers:               []string{"layer1", "layer2"},
+		Entrypoint:           []string{"/bin/sh"},
+		Command:              []string{"-c", "serve"},
+		EnvironmentVariables: []string{"PATH=/usr/bin", "MODE=default"},
+	}
+
+	containers := []Container{
+		{
+			Name:  "app",
+			Image: "mcr.microsoft.com/azuredocs/aci-helloworld:latest",
+			EnvironmentVariables: map[string]string{
+				"MODE":  "production",
+				"DEBUG": "false",
+			},
+			SecureEnvironmentVariableNames: []string{"PASSWORD"},
+			Mounts: []Mount{
+				{MountPath: "/data", VolumeType: VolumeTypeAzureFile, ReadOnly: true},
+			},
+		},
+	}
+	options := Options{
+		AllowStdioAccess: true,
+		CustomFragment:   "allow_dump_stacks_override := true",
+	}
+
+	policy, err := Generate(context.Background(), resolver, containers, options)
+	if err != nil {
+		t.Fatalf("generating policy: %+v", err)
+	}
+
+	again, err := Generate(context.Background(), resolver, containers, options)
+	if err != nil {
+		t.Fatalf("generating policy: %+v", err)
+	}
+	if policy != again {
+		t.Fatalf("expected the generated policy to be deterministic")
+	}
+
+	for _, expected := range []string{
+		"package policy",
+		`"layers": [
+      "layer1",
+      "layer2"
+    ]`,
+		`"/bin/sh",
+      "-c",
+      "serve"`,
+		`"pattern": "MODE=production"`,
+		`"pattern": "DEBUG=false"`,
+		`"pattern": "PASSWORD=.+"`,
+		`"pattern": "PATH=/usr/bin"`,
+		`"source": "sandbox:///tmp/atlas/azureFileVolume/.+"`,
+		`"allow_stdio_access": true`,
+		`"name": "pause-container"`,
+		"allow_dump_stacks_override := true",
+	} {
+		if !strings.Contains(policy, expected) {
+			t.Fatalf("expected the policy to contain %q but got:\n%s", expected, policy)
+		}
+	}
+
+	if strings.Contains(policy, "MODE=default") {
+		t.Fatalf("expected the image's environment variable to be overridden by the container's")
+	}
+}
+
+type staticResolver ImageConfig
+
+func (r staticResolver) Resolve(_ context.Context, _ ImageReference) (*ImageConfig, error) {
+	config := ImageConfig(r)
+	return &config, nil
+}
+
+// writeTestImage writes the blobs for an image index containing a single linux/amd64 image using `write`, returning
+// the digest of the index and the diff IDs of the image's layers
+func writeTestImage(t *testing.T, write func(digest string, content []byte)) (string, []string) {
+	store := func(content []byte) string {
+		sum := sha256.Sum256(content)
+		digest := "sha256:" + hex.EncodeToString(sum[:])
+		write(digest, content)
+		return digest
+	}
+	mustMarshal := func(input interface{}) []byte {
+		out, err := json.Marshal(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+
+	gzipLayer, gzipDiffId := testLayer(t, true, map[string]string{"app": "#!/bin/sh\necho hello\n"})
+	tarLayer, tarDiffId := testLayer(t, false, map[string]string{"srv/config.json": `{"mode":"production"}`, "app": "#!/bin/sh\necho world\n"})
+	diffIds := []string{gzipDiffId, tarDiffId}
+	config := store(mustMarshal(map[string]interface{}{
+		"config": map[string]interface{}{
+			"Entrypoint": []string{"/app"},
+			"Env":        []string{"PATH=/usr/bin"},
+			"WorkingDir": "/srv",
+		},
+		"rootfs": map[string]interface{}{
+			"type":     "layers",
+			"diff_ids": diffIds,
+		},
+	}))
+	image := store(mustMarshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     mediaTypeOCIManifest,
+		"config":        map[string]interface{}{"digest": config},
+		"layers": []interface{}{
+			map[string]interface{}{"mediaType": mediaTypeOCILayerGzip, "digest": store(gzipLayer)},
+			map[string]interface{}{"mediaType": mediaTypeOCILayer, "digest": store(tarLayer)},
+		},
+	}))
+	index := store(mustMarshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     mediaTypeOCIIndex,
+		"manifests": []interface{}{
+			map[string]interface{}{"digest": "sha256:0000", "platform": map[string]string{"os": "linux", "architecture": "arm64"}},
+			map[string]interface{}{"digest": image, "platform": map[string]string{"os": "linux", "architecture": "amd64"}},
+		},
+	}))
+
+	return index, diffIds
+}
+
+// testLayer returns a (deterministic) layer containing `files`, and the diff ID of the layer
+func testLayer(t *testing.T, compress bool, files map[string]string) ([]byte, string) {
+	uncompressed := &bytes.Buffer{}
+	tw := tar.NewWriter(uncompressed)
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if dir := filepath.Dir(name); dir != "." {
+			if err := tw.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: dir + "/", Mode: 0o755, ModTime: time.Unix(0, 0)}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Mode: 0o755, Size: int64(len(files[name])), ModTime: time.Unix(0, 0)}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(files[name])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256(uncompressed.Bytes())
+	diffId := "sha256:" + hex.EncodeToString(sum[:])
+	if !compress {
+		return uncompressed.Bytes(), diffId
+	}
+
+	compressed := &bytes.Buffer{}
+	gw := gzip.NewWriter(compressed)
+	if _, err := gw.Write(uncompressed.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return compressed.Bytes(), diffId
+}
+
+func assertTestImageConfig(t *testing.T, config *ImageConfig, diffIds []string) {
+	if strings.Join(config.Layers, ",") != strings.Join(testLayerRootHashes, ",") {
+		t.Fatalf("expected layers %v but got %v", testLayerRootHashes, config.Layers)
+	}
+	for i, diffId := range diffIds {
+		if strings.TrimPrefix(diffId, "sha256:") == config.Layers[i] {
+			t.Fatalf("expected the layer to be the dm-verity root hash rather than the diff ID %q", diffId)
+		}
+	}
+	if strings.Join(config.Entrypoint, " ") != "/app" {
+		t.Fatalf("expected entrypoint `/app` but got %v", config.Entrypoint)
+	}
+	if config.WorkingDir != "/srv" {
+		t.Fatalf("expected working dir `/srv` but got %q", config.WorkingDir)
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package ccepolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RegistryCredential is the username and password used to authenticate to a container registry.
+type RegistryCredential struct {
+	Username string
+	Password string
+}
+
+// RegistryResolver resolves images from their container registry using the OCI Distribution API, authenticating
+// with the credentials for the registry (from `image_registry_credential`) when specified, or anonymously otherwise.
+type RegistryResolver struct {
+	// Credentials is a map of registry server names to the credentials used to authenticate with them
+	Credentials map[string]RegistryCredential
+
+	// HTTPClient is the client used to communicate with the registry, defaults to a client without an overall timeout
+	// (since downloading the layers of large images can take some time) which is bounded by the context instead
+	HTTPClient *http.Client
+}
+
+var _ ImageResolver = RegistryResolver{}
+
+func (r RegistryResolver) Resolve(ctx context.Context, image ImageReference) (*ImageConfig, error) {
+	session := &registrySession{
+		client:     r.HTTPClient,
+		image:      image,
+		credential: r.Credentials[image.Registry],
+	}
+	if session.client == nil {
+		session.client = &http.Client{
+			Transport: &http.Transport{
+				Proxy:                 http.ProxyFromEnvironment,
+				TLSHandshakeTimeout:   10 * time.Second,
+				ResponseHeaderTimeout: time.Minute,
+			},
+		}
+	}
+
+	raw, err := session.fetch(ctx, "manifests", image.Reference())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving manifest for %q: %+v", image.Original, err)
+	}
+	if image.Digest != "" {
+		if err := verifyDigest(image.Digest, raw); err != nil {
+			return nil, err
+		}
+	}
+
+	return resolveManifest(ctx, raw, func(ctx context.Context, digest string, isManifest bool) ([]byte, error) {
+		kind := "blobs"
+		if isManifest {
+			kind = "manifests"
+		}
+
+		content, err := session.fetch(ctx, kind, digest)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := verifyDigest(digest, content); err != nil {
+			return nil, err
+		}
+
+		return content, nil
+	}, func(ctx context.Context, digest string) (io.ReadCloser, error) {
+		return session.open(ctx, "blobs", digest)
+	})
+}
+
+type registrySession struct {
+	client     *http.Client
+	image      ImageReference
+	credential RegistryCredential
+
+	// token is the bearer token obtained from the registry's token service, once a challenge has been received
+	token string
+}
+
+func (s *registrySession) fetch(ctx context.Context, kind, reference string) ([]byte, error) {
+	body, err := s.open(ctx, kind, reference)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
+
+// open returns the body of the response for the manifest or blob, which must be closed by the caller
+func (s *registrySession) open(ctx context.Context, kind, reference string) (io.ReadCloser, error) {
+	host := s.image.Registry
+	if host == defaultRegistry {
+		host = defaultRegistryHost
+	}
+	uri := fmt.Sprintf("https://%s/v2/%s/%s/%s", host, s.image.Repository, kind, reference)
+
+	resp, err := s.do(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := s.authenticate(ctx, challenge); err != nil {
+			return nil, err
+		}
+
+		resp, err = s.do(ctx, uri)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %d retrieving %q", resp.StatusCode, uri)
+	}
+
+	return resp.Body, nil
+}
+
+func (s *registrySession) do(ctx context.Context, uri string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	req.Header.Set("Accept", strings.Join([]string{mediaTypeOCIIndex, mediaTypeOCIManifest, mediaTypeDockerManifestList, mediaTypeDockerManifest}, ", "))
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	} else if s.credential.Username != "" {
+		req.SetBasicAuth(s.credential.Username, s.credential.Password)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("performing request to %q: %+v", uri, err)
+	}
+
+	return resp, nil
+}
+
+// authenticate obtains a bearer token from the token service specified in the registry's `WWW-Authenticate` challenge
+func (s *registrySession) authenticate(ctx context.Context, challenge string) error {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return fmt.Errorf("the registry %q requires authentication, but credentials were not specified in `image_registry_credential`", s.image.Registry)
+	}
+
+	values := parseChallengeParams(params)
+	realm := values["realm"]
+	if realm == "" {
+		return fmt.Errorf("the authentication challenge from %q did not contain a realm", s.image.Registry)
+	}
+
+	tokenUri, err := url.Parse(realm)
+	if err != nil {
+		return fmt.Errorf("parsing token realm %q: %+v", realm, err)
+	}
+	query := tokenUri.Query()
+	if v := values["service"]; v != "" {
+		query.Set("service", v)
+	}
+	scope := values["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", s.image.Repository)
+	}
+	query.Set("scope", scope)
+	tokenUri.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenUri.String(), nil)
+	if err != nil {
+		return fmt.Errorf("building token request: %+v", err)
+	}
+	if s.credential.Username != "" {
+		req.SetBasicAuth(s.credential.Username, s.credential.Password)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("retrieving token from %q: %+v", tokenUri.Host, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d retrieving token from %q", resp.StatusCode, tokenUri.Host)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return fmt.Errorf("parsing token from %q: %+v", tokenUri.Host, err)
+	}
+
+	s.token = token.Token
+	if s.token == "" {
+		s.token = token.AccessToken
+	}
+	if s.token == "" {
+		return fmt.Errorf("the token service %q did not return a token", tokenUri.Host)
+	}
+
+	return nil
+}
+
+// parseChallengeParams parses the comma separated `key="value"` parameters of a `WWW-Authenticate` header
+func parseChallengeParams(input string) map[string]string {
+	out := make(map[string]string)
+	for input != "" {
+		key, rest, ok := strings.Cut(strings.TrimLeft(input, " ,"), "=")
+		if !ok {
+			break
+		}
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				value, rest = rest[1:], ""
+			} else {
+				value, rest = rest[1:end+1], rest[end+2:]
+			}
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+
+		out[strings.ToLower(strings.TrimSpace(key))] = value
+		input = rest
+	}
+
+	return out
+}
@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerinstance/2025-09-01/containerinstance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/ccepolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func containerGroupCcePolicyGenerationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"cce_policy"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"oci_layout_path": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"registry_pull_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"custom_policy_fragment": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"allow_stdio_access": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffContainerGroupCcePolicy validates the Confidential Computing Enforcement policy configuration and, when
+// `cce_policy_generation` is specified, generates the policy so that any change to it is shown in the plan
+func customizeDiffContainerGroupCcePolicy(ctx context.Context, d *pluginsdk.ResourceDiff) error {
+	generationRaw := d.Get("cce_policy_generation").([]interface{})
+	_, policySpecified := d.GetOk("cce_policy")
+	if len(generationRaw) == 0 && (!policySpecified || !d.HasChange("cce_policy")) {
+		return nil
+	}
+
+	if d.Get("sku").(string) != string(containerinstance.ContainerGroupSkuConfidential) {
+		return fmt.Errorf("`sku` must be `%s` when `cce_policy` or `cce_policy_generation` is specified", containerinstance.ContainerGroupSkuConfidential)
+	}
+
+	if len(generationRaw) == 0 {
+		return nil
+	}
+
+	// generating the policy requires downloading every layer of every image, so the policy in the state is kept unless
+	// the containers, registry credentials or generation options have changed
+	if d.Id() != "" && !d.HasChanges("container", "init_container", "image_registry_credential", "cce_policy_generation") {
+		return nil
+	}
+
+	// the policy can only be generated once the containers and their images are known, until then it's unknown
+	for _, key := range []string{"container", "init_container", "image_registry_credential", "cce_policy_generation"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("cce_policy")
+		}
+	}
+
+	containers := make([]ccepolicy.Container, 0)
+	for _, key := range []string{"init_container", "container"} {
+		for i, raw := range d.Get(key).([]interface{}) {
+			for _, field := range []string{"image", "commands", "environment_variables", "secure_environment_variables", "volume"} {
+				if !d.NewValueKnown(fmt.Sprintf("%s.%d.%s", key, i, field)) {
+					return d.SetNewComputed("cce_policy")
+				}
+			}
+
+			containers = append(containers, expandContainerGroupCcePolicyContainer(raw.(map[string]interface{})))
+		}
+	}
+
+	generation := generationRaw[0].(map[string]interface{})
+	resolver := ccepolicy.ChainedResolver{}
+	if path := generation["oci_layout_path"].(string); path != "" {
+		resolver = append(resolver, ccepolicy.OCILayoutResolver{
+			Path: path,
+		})
+	}
+
+	// pulling every layer of every image from the registry whilst planning is expensive, so this must be opted into
+	if generation["registry_pull_enabled"].(bool) {
+		resolver = append(resolver, ccepolicy.RegistryResolver{
+			Credentials: expandContainerGroupCcePolicyRegistryCredentials(d.Get("image_registry_credential").([]interface{})),
+		})
+	}
+
+	if len(resolver) == 0 {
+		return fmt.Errorf("at least one of `oci_layout_path` or `registry_pull_enabled` must be specified within `cce_policy_generation`")
+	}
+
+	policy, err := ccepolicy.GenerateBase64(ctx, resolver, containers, ccepolicy.Options{
+		AllowStdioAccess: generation["allow_stdio_access"].(bool),
+		CustomFragment:   generation["custom_policy_fragment"].(string),
+	})
+	if err != nil {
+		return fmt.Errorf("generating `cce_policy`: %+v", err)
+	}
+
+	if d.Get("cce_policy").(string) != policy {
+		return d.SetNew("cce_policy", policy)
+	}
+
+	return nil
+}
+
+func expandContainerGroupCcePolicyContainer(input map[string]interface{}) ccepolicy.Container {
+	output := ccepolicy.Container{
+		Name:                 input["name"].(string),
+		Image:                input["image"].(string),
+		EnvironmentVariables: make(map[string]string),
+	}
+
+	for _, v := range input["commands"].([]interface{}) {
+		output.Command = append(output.Command, v.(string))
+	}
+
+	for k, v := range input["environment_variables"].(map[string]interface{}) {
+		output.EnvironmentVariables[k] = v.(string)
+	}
+
+	for k := range input["secure_environment_variables"].(map[string]interface{}) {
+		output.SecureEnvironmentVariableNames = append(output.SecureEnvironmentVariableNames, k)
+	}
+
+	for _, raw := range input["volume"].([]interface{}) {
+		volume := raw.(map[string]interface{})
+
+		volumeType := ccepolicy.VolumeTypeAzureFile
+		switch {
+		case volume["empty_dir"].(bool):
+			volumeType = ccepolicy.VolumeTypeEmptyDir
+		case len(volume["git_repo"].([]interface{})) > 0:
+			volumeType = ccepolicy.VolumeTypeGitRepo
+		case len(volume["secret"].(map[string]interface{})) > 0:
+			volumeType = ccepolicy.VolumeTypeSecret
+		}
+
+		output.Mounts = append(output.Mounts, ccepolicy.Mount{
+			MountPath:  volume["mount_path"].(string),
+			ReadOnly:   volume["read_only"].(bool),
+			VolumeType: volumeType,
+		})
+	}
+
+	if security := input["security"].([]interface{}); len(security) > 0 && security[0] != nil {
+		output.Privileged = security[0].(map[string]interface{})["privilege_enabled"].(bool)
+	}
+
+	return output
+}
+
+// expandContainerGroupCcePolicyRegistryCredentials returns the username/password credentials for each registry, images
+// from registries using a User Assigned Identity are pulled anonymously (or must be present in `oci_layout_path`)
+func expandContainerGroupCcePolicyRegistryCredentials(input []interface{}) map[string]ccepolicy.RegistryCredential {
+	output := make(map[string]ccepolicy.RegistryCredential)
+	for _, raw := range input {
+		credential := raw.(map[string]interface{})
+		if username := credential["username"].(string); username != "" {
+			output[credential["server"].(string)] = ccepolicy.RegistryCredential{
+				Username: username,
+				Password: credential["password"].(string),
+			}
+		}
+	}
+
+	return output
+}
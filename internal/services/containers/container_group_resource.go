@@ -508,6 +508,16 @@ func resourceContainerGroup() *pluginsdk.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(containerinstance.PossibleValuesForContainerGroupPriority(), false),
 			},
+
+			"cce_policy": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+
+			"cce_policy_generation": containerGroupCcePolicyGenerationSchema(),
 		},
 		CustomizeDiff: func(ctx context.Context, d *pluginsdk.ResourceDiff, i interface{}) error {
 			if p := d.Get("priority").(string); p == string(containerinstance.ContainerGroupPrioritySpot) {
@@ -515,7 +525,7 @@ func resourceContainerGroup() *pluginsdk.Resource {
 					return fmt.Errorf("`ip_address_type` has to be `None` when `priority` is set to `Spot`")
 				}
 			}
-			return nil
+			return customizeDiffContainerGroupCcePolicy(ctx, d)
 		},
 	}
 
@@ -713,6 +723,12 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 	containerGroup.Identity = expandedIdentity
 
+	if ccePolicy := d.Get("cce_policy").(string); ccePolicy != "" {
+		containerGroup.Properties.ConfidentialComputeProperties = &containerinstance.ConfidentialComputeProperties{
+			CcePolicy: pointer.To(ccePolicy),
+		}
+	}
+
 	if IPAddressType != "None" {
 		containerGroup.Properties.IPAddress = &containerinstance.IPAddress{
 			Ports: containerGroupPorts,
@@ -892,6 +908,12 @@ func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 		d.Set("priority", priority)
 
+		var ccePolicy string
+		if v := props.ConfidentialComputeProperties; v != nil {
+			ccePolicy = pointer.From(v.CcePolicy)
+		}
+		d.Set("cce_policy", ccePolicy)
+
 		containerConfigs := flattenContainerGroupContainers(d, &props.Containers, props.Volumes)
 		if err := d.Set("container", containerConfigs); err != nil {
 			return fmt.Errorf("setting `container`: %+v", err)
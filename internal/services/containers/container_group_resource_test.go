@@ -678,6 +678,30 @@ func TestAccContainerGroup_securityContextPrivilegedDisabled(t *testing.T) {
 	})
 }
 
+func TestAccContainerGroup_ccePolicyGeneration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_group", "test")
+	r := ContainerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ccePolicyGeneration(data, "hello"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cce_policy").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("cce_policy_generation"),
+		{
+			Config: r.ccePolicyGeneration(data, "world"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cce_policy").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("cce_policy_generation"),
+	})
+}
+
 func TestAccContainerGroup_priorityRegular(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_group", "test")
 	r := ContainerGroupResource{}
@@ -2601,6 +2625,48 @@ resource "azurerm_container_group" "test" {
 `, data.RandomInteger, data.Locations.Primary, v)
 }
 
+func (ContainerGroupResource) ccePolicyGeneration(data acceptance.TestData, greeting string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_container_group" "test" {
+  name                = "acctestcontainergroup-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  ip_address_type     = "Public"
+  os_type             = "Linux"
+  sku                 = "Confidential"
+
+  container {
+    name   = "hw"
+    image  = "mcr.microsoft.com/azuredocs/aci-helloworld:latest"
+    cpu    = "0.5"
+    memory = "0.5"
+    ports {
+      port     = 80
+      protocol = "TCP"
+    }
+
+    environment_variables = {
+      GREETING = "%[3]s"
+    }
+  }
+
+  cce_policy_generation {
+    custom_policy_fragment = "allow_test_fragment := true"
+    registry_pull_enabled  = true
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, greeting)
+}
+
 func (ContainerGroupResource) priority(data acceptance.TestData, priority string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
@@ -2062,6 +2063,9 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 
 	err = client.CreateOrUpdateThenPoll(ctx, id, parameters, managedclusters.DefaultCreateOrUpdateOperationOptions())
 	if err != nil {
+		err = sdk.CancelInterruptedOperation(ctx, err, func(ctx context.Context) error {
+			return client.AbortLatestOperationThenPoll(ctx, id)
+		})
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -2650,6 +2654,9 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		log.Printf("[DEBUG] Updating %s..", *id)
 		err = clusterClient.CreateOrUpdateThenPoll(ctx, *id, *existing.Model, managedclusters.DefaultCreateOrUpdateOperationOptions())
 		if err != nil {
+			err = sdk.CancelInterruptedOperation(ctx, err, func(ctx context.Context) error {
+				return clusterClient.AbortLatestOperationThenPoll(ctx, *id)
+			})
 			return fmt.Errorf("updating %s: %+v", *id, err)
 		}
 
@@ -2672,6 +2679,9 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 		err = clusterClient.CreateOrUpdateThenPoll(ctx, *id, *existing.Model, managedclusters.DefaultCreateOrUpdateOperationOptions())
 		if err != nil {
+			err = sdk.CancelInterruptedOperation(ctx, err, func(ctx context.Context) error {
+				return clusterClient.AbortLatestOperationThenPoll(ctx, *id)
+			})
 			return fmt.Errorf("updating Kubernetes Version for %s: %+v", *id, err)
 		}
 
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// DNSSEC Configs and the DS and TLSA Record Types are only available in API Version `2023-07-01-preview`, which
+// isn't available in `go-azure-sdk` - these clients can be removed once the SDK contains a newer API Version.
+
+const defaultApiVersion = "2023-07-01-preview"
+
+type DnssecConfigsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewDnssecConfigsClientWithBaseURI(sdkApi sdkEnv.Api) (*DnssecConfigsClient, error) {
+	client, err := resourcemanager.NewClient(sdkApi, "dnssecconfigs", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating DnssecConfigsClient: %+v", err)
+	}
+
+	return &DnssecConfigsClient{
+		Client: client,
+	}, nil
+}
+
+type RecordSetsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewRecordSetsClientWithBaseURI(sdkApi sdkEnv.Api) (*RecordSetsClient, error) {
+	client, err := resourcemanager.NewClient(sdkApi, "recordsets", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating RecordSetsClient: %+v", err)
+	}
+
+	return &RecordSetsClient{
+		Client: client,
+	}, nil
+}
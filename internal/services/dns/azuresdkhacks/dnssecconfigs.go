@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type DnssecConfig struct {
+	Etag       *string           `json:"etag,omitempty"`
+	Id         *string           `json:"id,omitempty"`
+	Name       *string           `json:"name,omitempty"`
+	Properties *DnssecProperties `json:"properties,omitempty"`
+	Type       *string           `json:"type,omitempty"`
+}
+
+type DnssecProperties struct {
+	ProvisioningState *string       `json:"provisioningState,omitempty"`
+	SigningKeys       *[]SigningKey `json:"signingKeys,omitempty"`
+}
+
+type SigningKey struct {
+	DelegationSignerInfo  *[]DelegationSignerInfo `json:"delegationSignerInfo,omitempty"`
+	Flags                 *int64                  `json:"flags,omitempty"`
+	KeyTag                *int64                  `json:"keyTag,omitempty"`
+	Protocol              *int64                  `json:"protocol,omitempty"`
+	PublicKey             *string                 `json:"publicKey,omitempty"`
+	SecurityAlgorithmType *int64                  `json:"securityAlgorithmType,omitempty"`
+}
+
+type DelegationSignerInfo struct {
+	DigestAlgorithmType *int64  `json:"digestAlgorithmType,omitempty"`
+	DigestValue         *string `json:"digestValue,omitempty"`
+	Record              *string `json:"record,omitempty"`
+}
+
+type DnssecConfigsCreateOrUpdateOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *DnssecConfig
+}
+
+// CreateOrUpdate ...
+func (c DnssecConfigsClient) CreateOrUpdate(ctx context.Context, id DnssecConfigId) (result DnssecConfigsCreateOrUpdateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c DnssecConfigsClient) CreateOrUpdateThenPoll(ctx context.Context, id DnssecConfigId) error {
+	result, err := c.CreateOrUpdate(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+type DnssecConfigsGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *DnssecConfig
+}
+
+// Get ...
+func (c DnssecConfigsClient) Get(ctx context.Context, id DnssecConfigId) (result DnssecConfigsGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model DnssecConfig
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type DnssecConfigsDeleteOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+// Delete ...
+func (c DnssecConfigsClient) Delete(ctx context.Context, id DnssecConfigId) (result DnssecConfigsDeleteOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c DnssecConfigsClient) DeleteThenPoll(ctx context.Context, id DnssecConfigId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
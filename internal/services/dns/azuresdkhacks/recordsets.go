@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type RecordSet struct {
+	Etag       *string              `json:"etag,omitempty"`
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *RecordSetProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
+
+type RecordSetProperties struct {
+	DSRecords         *[]DsRecord        `json:"DSRecords,omitempty"`
+	Fqdn              *string            `json:"fqdn,omitempty"`
+	Metadata          *map[string]string `json:"metadata,omitempty"`
+	ProvisioningState *string            `json:"provisioningState,omitempty"`
+	TLSARecords       *[]TlsaRecord      `json:"TLSARecords,omitempty"`
+	TTL               *int64             `json:"TTL,omitempty"`
+}
+
+type DsRecord struct {
+	Algorithm *int64  `json:"algorithm,omitempty"`
+	Digest    *Digest `json:"digest,omitempty"`
+	KeyTag    *int64  `json:"keyTag,omitempty"`
+}
+
+type Digest struct {
+	AlgorithmType *int64  `json:"algorithmType,omitempty"`
+	Value         *string `json:"value,omitempty"`
+}
+
+type TlsaRecord struct {
+	CertAssociationData *string `json:"certAssociationData,omitempty"`
+	MatchingType        *int64  `json:"matchingType,omitempty"`
+	Selector            *int64  `json:"selector,omitempty"`
+	Usage               *int64  `json:"usage,omitempty"`
+}
+
+type RecordSetsCreateOrUpdateOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *RecordSet
+}
+
+// CreateOrUpdate ...
+func (c RecordSetsClient) CreateOrUpdate(ctx context.Context, id RecordTypeId, input RecordSet) (result RecordSetsCreateOrUpdateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model RecordSet
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type RecordSetsGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *RecordSet
+}
+
+// Get ...
+func (c RecordSetsClient) Get(ctx context.Context, id RecordTypeId) (result RecordSetsGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model RecordSet
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type RecordSetsDeleteOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+// Delete ...
+func (c RecordSetsClient) Delete(ctx context.Context, id RecordTypeId) (result RecordSetsDeleteOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+type RecordSetsListByTypeOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]RecordSet
+}
+
+type RecordSetsListByTypeCompleteResult struct {
+	LatestHttpResponse *http.Response
+	Items              []RecordSet
+}
+
+type RecordSetsListByTypeCustomPager struct {
+	NextLink *odata.Link `json:"nextLink"`
+}
+
+func (p *RecordSetsListByTypeCustomPager) NextPageLink() *odata.Link {
+	defer func() {
+		p.NextLink = nil
+	}()
+
+	return p.NextLink
+}
+
+// ListByType ...
+func (c RecordSetsClient) ListByType(ctx context.Context, id zones.DnsZoneId, recordType RecordType) (result RecordSetsListByTypeOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Pager:      &RecordSetsListByTypeCustomPager{},
+		Path:       fmt.Sprintf("%s/%s", id.ID(), string(recordType)),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.ExecutePaged(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var values struct {
+		Values *[]RecordSet `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return
+	}
+
+	result.Model = values.Values
+
+	return
+}
+
+// ListByTypeComplete retrieves all the results into a single object
+func (c RecordSetsClient) ListByTypeComplete(ctx context.Context, id zones.DnsZoneId, recordType RecordType) (result RecordSetsListByTypeCompleteResult, err error) {
+	items := make([]RecordSet, 0)
+
+	resp, err := c.ListByType(ctx, id, recordType)
+	if err != nil {
+		result.LatestHttpResponse = resp.HttpResponse
+		err = fmt.Errorf("loading results: %+v", err)
+		return
+	}
+	if resp.Model != nil {
+		items = append(items, *resp.Model...)
+	}
+
+	result = RecordSetsListByTypeCompleteResult{
+		LatestHttpResponse: resp.HttpResponse,
+		Items:              items,
+	}
+	return
+}
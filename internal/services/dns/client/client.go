@@ -4,17 +4,45 @@
 package client
 
 import (
+	"fmt"
+
 	dns_v2018_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/azuresdkhacks"
 )
 
-func NewClient(o *common.ClientOptions) (*dns_v2018_05_01.Client, error) {
+type Client struct {
+	*dns_v2018_05_01.Client
+
+	DnssecConfigs *azuresdkhacks.DnssecConfigsClient
+	// RecordSetsPreview is used for the DS and TLSA Record Types, which aren't available in API Version `2018-05-01`
+	RecordSetsPreview *azuresdkhacks.RecordSetsClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
 	client, err := dns_v2018_05_01.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
 		o.Configure(c, o.Authorizers.ResourceManager)
 	})
 	if err != nil {
 		return nil, err
 	}
-	return client, nil
+
+	dnssecConfigsClient, err := azuresdkhacks.NewDnssecConfigsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building DnssecConfigs client: %+v", err)
+	}
+	o.Configure(dnssecConfigsClient.Client, o.Authorizers.ResourceManager)
+
+	recordSetsPreviewClient, err := azuresdkhacks.NewRecordSetsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building RecordSets client: %+v", err)
+	}
+	o.Configure(recordSetsPreviewClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		Client:            client,
+		DnssecConfigs:     dnssecConfigsClient,
+		RecordSetsPreview: recordSetsPreviewClient,
+	}, nil
 }
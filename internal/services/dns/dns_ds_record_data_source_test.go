@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DnsDsRecordDataSource struct{}
+
+func TestAccDataSourceDnsDsRecord_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_ds_record", "test")
+	r := DnsDsRecordDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").Exists(),
+				check.That(data.ResourceName).Key("resource_group_name").Exists(),
+				check.That(data.ResourceName).Key("zone_name").Exists(),
+				check.That(data.ResourceName).Key("record.#").HasValue("1"),
+				check.That(data.ResourceName).Key("ttl").Exists(),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+				check.That(data.ResourceName).Key("tags.%").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (DnsDsRecordDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dns_ds_record" "test" {
+  name                = azurerm_dns_ds_record.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+}
+`, DnsDsRecordResource{}.basic(data))
+}
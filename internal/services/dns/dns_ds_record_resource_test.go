@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsDsRecordResource struct{}
+
+func TestAccDnsDsRecord_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_ds_record", "test")
+	r := DnsDsRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsDsRecord_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_ds_record", "test")
+	r := DnsDsRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_dns_ds_record"),
+		},
+	})
+}
+
+func TestAccDnsDsRecord_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_ds_record", "test")
+	r := DnsDsRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("2"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DnsDsRecordResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParseRecordTypeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Dns.RecordSetsPreview.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (DnsDsRecordResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DnsDsRecordResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_ds_record" "test" {
+  name                = "mydsrecord%d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    key_tag     = 12345
+    algorithm   = 13
+    digest_type = 2
+    digest      = "2BB183AF5F22588179A53B0A98631FAD1A292118F0E8A2A4A5F3D2A2C8B8C4B1"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DnsDsRecordResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_ds_record" "import" {
+  name                = azurerm_dns_ds_record.test.name
+  resource_group_name = azurerm_dns_ds_record.test.resource_group_name
+  zone_name           = azurerm_dns_ds_record.test.zone_name
+  ttl                 = 300
+
+  record {
+    key_tag     = 12345
+    algorithm   = 13
+    digest_type = 2
+    digest      = "2BB183AF5F22588179A53B0A98631FAD1A292118F0E8A2A4A5F3D2A2C8B8C4B1"
+  }
+}
+`, r.basic(data))
+}
+
+func (r DnsDsRecordResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_ds_record" "test" {
+  name                = "mydsrecord%d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 3600
+
+  record {
+    key_tag     = 12345
+    algorithm   = 13
+    digest_type = 2
+    digest      = "2BB183AF5F22588179A53B0A98631FAD1A292118F0E8A2A4A5F3D2A2C8B8C4B1"
+  }
+
+  record {
+    key_tag     = 54321
+    algorithm   = 8
+    digest_type = 1
+    digest      = "A1B2C3D4E5F60718293A4B5C6D7E8F9012345678"
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
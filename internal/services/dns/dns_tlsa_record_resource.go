@@ -0,0 +1,273 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dns_tlsa_record -properties "name,dns_zone_name:zone_name,resource_group_name" -compare-values "record_type:id"
+
+func resourceDnsTlsaRecord() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDnsTlsaRecordCreateUpdate,
+		Read:   resourceDnsTlsaRecordRead,
+		Update: resourceDnsTlsaRecordCreateUpdate,
+		Delete: resourceDnsTlsaRecordDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&azuresdkhacks.RecordTypeId{}, resourceDnsTlsaRecordImporter),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&azuresdkhacks.RecordTypeId{}),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"zone_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"record": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"usage": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 3),
+						},
+
+						"selector": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 1),
+						},
+
+						"matching_type": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 2),
+						},
+
+						"certificate_association_data": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9A-Fa-f]{2})+$`), "`certificate_association_data` must be a hexadecimal string"),
+						},
+					},
+				},
+				Set: resourceDnsTlsaRecordHash,
+			},
+
+			"ttl": {
+				Type:     pluginsdk.TypeInt,
+				Required: true,
+			},
+
+			"fqdn": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourceDnsTlsaRecordImporter(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	id, err := azuresdkhacks.ParseRecordTypeID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+	if id.RecordType != azuresdkhacks.RecordTypeTLSA {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("importing %s wrong type received: expected %s received %s", id, azuresdkhacks.RecordTypeTLSA, id.RecordType)
+	}
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+func resourceDnsTlsaRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsPreview
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := azuresdkhacks.NewRecordTypeID(subscriptionId, d.Get("resource_group_name").(string), d.Get("zone_name").(string), azuresdkhacks.RecordTypeTLSA, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_dns_tlsa_record", id.ID())
+		}
+	}
+
+	parameters := azuresdkhacks.RecordSet{
+		Name: pointer.To(id.RelativeRecordSetName),
+		Properties: &azuresdkhacks.RecordSetProperties{
+			Metadata:    tags.Expand(d.Get("tags").(map[string]interface{})),
+			TTL:         pointer.To(int64(d.Get("ttl").(int))),
+			TLSARecords: expandAzureRmDnsTlsaRecords(d.Get("record").(*pluginsdk.Set).List()),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
+	return resourceDnsTlsaRecordRead(d, meta)
+}
+
+func resourceDnsTlsaRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsPreview
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azuresdkhacks.ParseRecordTypeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return resourceDnsTlsaRecordFlatten(d, id, resp.Model)
+}
+
+func resourceDnsTlsaRecordFlatten(d *pluginsdk.ResourceData, id *azuresdkhacks.RecordTypeId, model *azuresdkhacks.RecordSet) error {
+	d.Set("name", id.RelativeRecordSetName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("zone_name", id.DnsZoneName)
+
+	if model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("ttl", props.TTL)
+			d.Set("fqdn", props.Fqdn)
+
+			if err := d.Set("record", flattenAzureRmDnsTlsaRecords(props.TLSARecords)); err != nil {
+				return fmt.Errorf("setting `record`: %+v", err)
+			}
+
+			if err := tags.FlattenAndSet(d, props.Metadata); err != nil {
+				return err
+			}
+		}
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
+}
+
+func resourceDnsTlsaRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSetsPreview
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azuresdkhacks.ParseRecordTypeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenAzureRmDnsTlsaRecords(records *[]azuresdkhacks.TlsaRecord) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0)
+	if records == nil {
+		return results
+	}
+
+	for _, record := range *records {
+		results = append(results, map[string]interface{}{
+			"usage":                        pointer.From(record.Usage),
+			"selector":                     pointer.From(record.Selector),
+			"matching_type":                pointer.From(record.MatchingType),
+			"certificate_association_data": pointer.From(record.CertAssociationData),
+		})
+	}
+
+	return results
+}
+
+func expandAzureRmDnsTlsaRecords(input []interface{}) *[]azuresdkhacks.TlsaRecord {
+	records := make([]azuresdkhacks.TlsaRecord, 0)
+
+	for _, v := range input {
+		record := v.(map[string]interface{})
+
+		records = append(records, azuresdkhacks.TlsaRecord{
+			Usage:               pointer.To(int64(record["usage"].(int))),
+			Selector:            pointer.To(int64(record["selector"].(int))),
+			MatchingType:        pointer.To(int64(record["matching_type"].(int))),
+			CertAssociationData: pointer.To(record["certificate_association_data"].(string)),
+		})
+	}
+
+	return &records
+}
+
+func resourceDnsTlsaRecordHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%d-", m["usage"].(int)))
+		buf.WriteString(fmt.Sprintf("%d-", m["selector"].(int)))
+		buf.WriteString(fmt.Sprintf("%d-", m["matching_type"].(int)))
+		buf.WriteString(fmt.Sprintf("%s-", m["certificate_association_data"].(string)))
+	}
+
+	return pluginsdk.HashString(buf.String())
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type (
+	DnsTlsaRecordListResource struct{}
+	DnsTlsaRecordListModel    struct {
+		DnsZoneId types.String `tfsdk:"dns_zone_id"`
+	}
+)
+
+var _ sdk.FrameworkListWrappedResource = new(DnsTlsaRecordListResource)
+
+func (r DnsTlsaRecordListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceDnsTlsaRecord()
+}
+
+func (r DnsTlsaRecordListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_dns_tlsa_record"
+}
+
+func (r DnsTlsaRecordListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dns_zone_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: zones.ValidateDnsZoneID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DnsTlsaRecordListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Dns.RecordSetsPreview
+
+	var data DnsTlsaRecordListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	zoneId, err := zones.ParseDnsZoneID(data.DnsZoneId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing `dns_zone_id`", err)
+		return
+	}
+
+	resp, err := client.ListByTypeComplete(ctx, *zoneId, azuresdkhacks.RecordTypeTLSA)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_dns_tlsa_record"), err)
+		return
+	}
+
+	results := resp.Items
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, record := range results {
+			result := request.NewListResult(ctx)
+
+			result.DisplayName = pointer.From(record.Name)
+
+			rd := resourceDnsTlsaRecord().Data(&terraform.InstanceState{})
+
+			id, err := azuresdkhacks.ParseRecordTypeIDInsensitively(pointer.From(record.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing DNS TLSA Record ID", err)
+				return
+			}
+
+			rd.SetId(id.ID())
+
+			if err := resourceDnsTlsaRecordFlatten(rd, id, &record); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_dns_tlsa_record"), err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
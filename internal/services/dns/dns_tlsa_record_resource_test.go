@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsTlsaRecordResource struct{}
+
+func TestAccDnsTlsaRecord_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_tlsa_record", "test")
+	r := DnsTlsaRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsTlsaRecord_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_tlsa_record", "test")
+	r := DnsTlsaRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_dns_tlsa_record"),
+		},
+	})
+}
+
+func TestAccDnsTlsaRecord_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_tlsa_record", "test")
+	r := DnsTlsaRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("2"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DnsTlsaRecordResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParseRecordTypeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Dns.RecordSetsPreview.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (DnsTlsaRecordResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DnsTlsaRecordResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_tlsa_record" "test" {
+  name                = "_443._tcp.www%d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    usage                        = 3
+    selector                     = 1
+    matching_type                = 1
+    certificate_association_data = "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DnsTlsaRecordResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_tlsa_record" "import" {
+  name                = azurerm_dns_tlsa_record.test.name
+  resource_group_name = azurerm_dns_tlsa_record.test.resource_group_name
+  zone_name           = azurerm_dns_tlsa_record.test.zone_name
+  ttl                 = 300
+
+  record {
+    usage                        = 3
+    selector                     = 1
+    matching_type                = 1
+    certificate_association_data = "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"
+  }
+}
+`, r.basic(data))
+}
+
+func (r DnsTlsaRecordResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_tlsa_record" "test" {
+  name                = "_443._tcp.www%d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 3600
+
+  record {
+    usage                        = 3
+    selector                     = 1
+    matching_type                = 1
+    certificate_association_data = "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"
+  }
+
+  record {
+    usage                        = 2
+    selector                     = 0
+    matching_type                = 2
+    certificate_association_data = "E244A693F935E94F2284FA5810D7EB120BFD5EE6C827C6C37280487FB66BF534A62A8E8D8592A13E4C74302A905B02FBFE60474F04405C486F19F10DC7B3E295"
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -41,11 +41,13 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_dns_aaaa_record":  dataSourceDnsAAAARecord(),
 		"azurerm_dns_caa_record":   dataSourceDnsCaaRecord(),
 		"azurerm_dns_cname_record": dataSourceDnsCNameRecord(),
+		"azurerm_dns_ds_record":    dataSourceDnsDsRecord(),
 		"azurerm_dns_mx_record":    dataSourceDnsMxRecord(),
 		"azurerm_dns_ns_record":    dataSourceDnsNsRecord(),
 		"azurerm_dns_ptr_record":   dataSourceDnsPtrRecord(),
 		"azurerm_dns_soa_record":   dataSourceDnsSoaRecord(),
 		"azurerm_dns_srv_record":   dataSourceDnsSrvRecord(),
+		"azurerm_dns_tlsa_record":  dataSourceDnsTlsaRecord(),
 		"azurerm_dns_txt_record":   dataSourceDnsTxtRecord(),
 	}
 }
@@ -57,10 +59,12 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_dns_aaaa_record":  resourceDnsAAAARecord(),
 		"azurerm_dns_caa_record":   resourceDnsCaaRecord(),
 		"azurerm_dns_cname_record": resourceDnsCNameRecord(),
+		"azurerm_dns_ds_record":    resourceDnsDsRecord(),
 		"azurerm_dns_mx_record":    resourceDnsMxRecord(),
 		"azurerm_dns_ns_record":    resourceDnsNsRecord(),
 		"azurerm_dns_ptr_record":   resourceDnsPtrRecord(),
 		"azurerm_dns_srv_record":   resourceDnsSrvRecord(),
+		"azurerm_dns_tlsa_record":  resourceDnsTlsaRecord(),
 		"azurerm_dns_txt_record":   resourceDnsTxtRecord(),
 	}
 }
@@ -76,6 +80,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		DnsZoneResource{},
+		DnsZoneDnssecConfigResource{},
 	}
 }
 
@@ -96,5 +101,8 @@ func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
-	return []sdk.FrameworkListWrappedResource{}
+	return []sdk.FrameworkListWrappedResource{
+		DnsDsRecordListResource{},
+		DnsTlsaRecordListResource{},
+	}
 }
@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/cacertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceCaCertificateResource{}
+
+type EventGridNamespaceCaCertificateResource struct{}
+
+type EventGridNamespaceCaCertificateResourceModel struct {
+	Name                 string `tfschema:"name"`
+	EventgridNamespaceId string `tfschema:"eventgrid_namespace_id"`
+	EncodedCertificate   string `tfschema:"encoded_certificate"`
+	Description          string `tfschema:"description"`
+	ExpiryTime           string `tfschema:"expiry_time"`
+	IssueTime            string `tfschema:"issue_time"`
+}
+
+func (r EventGridNamespaceCaCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z0-9-]{3,50}$"),
+				"Event Grid Namespace CA Certificate name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"eventgrid_namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: cacertificates.ValidateNamespaceID,
+		},
+
+		// the certificate of an existing CA Certificate can't be replaced
+		"encoded_certificate": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 256),
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"expiry_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"issue_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) ModelObject() interface{} {
+	return &EventGridNamespaceCaCertificateResourceModel{}
+}
+
+func (r EventGridNamespaceCaCertificateResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_ca_certificate"
+}
+
+func (r EventGridNamespaceCaCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return cacertificates.ValidateCaCertificateID
+}
+
+func (r EventGridNamespaceCaCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.CaCertificates
+
+			var model EventGridNamespaceCaCertificateResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := cacertificates.ParseNamespaceID(model.EventgridNamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := cacertificates.NewCaCertificateID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := cacertificates.CaCertificate{
+				Properties: &cacertificates.CaCertificateProperties{
+					EncodedCertificate: pointer.To(model.EncodedCertificate),
+				},
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.CaCertificates
+
+			id, err := cacertificates.ParseCaCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceCaCertificateResourceModel{
+				Name:                 id.CaCertificateName,
+				EventgridNamespaceId: cacertificates.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EncodedCertificate = pointer.From(props.EncodedCertificate)
+					state.Description = pointer.From(props.Description)
+					state.ExpiryTime = pointer.From(props.ExpiryTimeInUtc)
+					state.IssueTime = pointer.From(props.IssueTimeInUtc)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.CaCertificates
+
+			id, err := cacertificates.ParseCaCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceCaCertificateResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := cacertificates.CaCertificate{
+				Properties: &cacertificates.CaCertificateProperties{
+					EncodedCertificate: existing.Model.Properties.EncodedCertificate,
+					Description:        existing.Model.Properties.Description,
+				},
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceCaCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.CaCertificates
+
+			id, err := cacertificates.ParseCaCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
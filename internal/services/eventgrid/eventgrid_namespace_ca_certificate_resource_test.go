@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/cacertificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventGridNamespaceCaCertificateResource struct{}
+
+func TestAccEventGridNamespaceCaCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("expiry_time").IsSet(),
+				check.That(data.ResourceName).Key("issue_time").IsSet(),
+			),
+		},
+		data.ImportStep("encoded_certificate"),
+	})
+}
+
+func TestAccEventGridNamespaceCaCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventGridNamespaceCaCertificate_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("encoded_certificate"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("encoded_certificate"),
+	})
+}
+
+func (r EventGridNamespaceCaCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := cacertificates.ParseCaCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.CaCertificates.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r EventGridNamespaceCaCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "test" {
+  name                   = "acctest-egnca-%d"
+  eventgrid_namespace_id = azurerm_eventgrid_namespace.test.id
+  encoded_certificate    = tls_self_signed_cert.test.cert_pem
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventGridNamespaceCaCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "import" {
+  name                   = azurerm_eventgrid_namespace_ca_certificate.test.name
+  eventgrid_namespace_id = azurerm_eventgrid_namespace_ca_certificate.test.eventgrid_namespace_id
+  encoded_certificate    = azurerm_eventgrid_namespace_ca_certificate.test.encoded_certificate
+}
+`, r.basic(data))
+}
+
+func (r EventGridNamespaceCaCertificateResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "test" {
+  name                   = "acctest-egnca-%d"
+  eventgrid_namespace_id = azurerm_eventgrid_namespace.test.id
+  encoded_certificate    = tls_self_signed_cert.test.cert_pem
+  description            = "Device CA"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventGridNamespaceCaCertificateResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "tls_private_key" "test" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+
+resource "tls_self_signed_cert" "test" {
+  private_key_pem       = tls_private_key.test.private_key_pem
+  is_ca_certificate     = true
+  validity_period_hours = 24
+
+  subject {
+    common_name = "acctest-ca-%s"
+  }
+
+  allowed_uses = [
+    "cert_signing",
+    "crl_signing",
+  ]
+}
+`, eventGridNamespaceMqttTemplate(data), data.RandomString)
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/permissionbindings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventGridNamespacePermissionBindingResource struct{}
+
+func TestAccEventGridNamespacePermissionBinding_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespacePermissionBinding_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventGridNamespacePermissionBinding_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r EventGridNamespacePermissionBindingResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := permissionbindings.ParsePermissionBindingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.PermissionBindings.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r EventGridNamespacePermissionBindingResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_permission_binding" "test" {
+  name                   = "acctest-egnpb-%d"
+  eventgrid_namespace_id = azurerm_eventgrid_namespace.test.id
+  client_group_name      = azurerm_eventgrid_namespace_client_group.test.name
+  topic_space_name       = azurerm_eventgrid_namespace_topic_space.test.name
+  permission             = "Publisher"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventGridNamespacePermissionBindingResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_permission_binding" "import" {
+  name                   = azurerm_eventgrid_namespace_permission_binding.test.name
+  eventgrid_namespace_id = azurerm_eventgrid_namespace_permission_binding.test.eventgrid_namespace_id
+  client_group_name      = azurerm_eventgrid_namespace_permission_binding.test.client_group_name
+  topic_space_name       = azurerm_eventgrid_namespace_permission_binding.test.topic_space_name
+  permission             = azurerm_eventgrid_namespace_permission_binding.test.permission
+}
+`, r.basic(data))
+}
+
+func (r EventGridNamespacePermissionBindingResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_permission_binding" "test" {
+  name                   = "acctest-egnpb-%d"
+  eventgrid_namespace_id = azurerm_eventgrid_namespace.test.id
+  client_group_name      = azurerm_eventgrid_namespace_client_group.test.name
+  topic_space_name       = azurerm_eventgrid_namespace_topic_space.test.name
+  permission             = "Subscriber"
+  description            = "Allow sensors to subscribe to telemetry"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventGridNamespacePermissionBindingResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_eventgrid_namespace_client_group" "test" {
+  name                   = "acctest-egncg-%[2]d"
+  eventgrid_namespace_id = azurerm_eventgrid_namespace.test.id
+  query                  = "attributes.type = 'sensor'"
+}
+
+resource "azurerm_eventgrid_namespace_topic_space" "test" {
+  name                   = "acctest-egnts-%[2]d"
+  eventgrid_namespace_id = azurerm_eventgrid_namespace.test.id
+  topic_templates        = ["devices/+/telemetry"]
+}
+`, eventGridNamespaceMqttTemplate(data), data.RandomInteger)
+}
@@ -0,0 +1,676 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/eventsubscriptions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceTopicEventSubscriptionResource{}
+
+type EventGridNamespaceTopicEventSubscriptionResource struct{}
+
+type EventGridNamespaceTopicEventSubscriptionResourceModel struct {
+	Name                      string                                       `tfschema:"name"`
+	EventgridNamespaceTopicId string                                       `tfschema:"eventgrid_namespace_topic_id"`
+	EventDeliverySchema       string                                       `tfschema:"event_delivery_schema"`
+	ExpirationTimeUtc         string                                       `tfschema:"expiration_time_utc"`
+	Filter                    []NamespaceTopicEventSubscriptionFilterModel `tfschema:"filter"`
+	IncludedEventTypes        []string                                     `tfschema:"included_event_types"`
+	Push                      []NamespaceTopicEventSubscriptionPushModel   `tfschema:"push"`
+	Queue                     []NamespaceTopicEventSubscriptionQueueModel  `tfschema:"queue"`
+}
+
+type NamespaceTopicEventSubscriptionFilterModel struct {
+	Key          string   `tfschema:"key"`
+	OperatorType string   `tfschema:"operator_type"`
+	Values       []string `tfschema:"values"`
+}
+
+type NamespaceTopicEventSubscriptionPushModel struct {
+	EventHubId             string `tfschema:"event_hub_id"`
+	EventTimeToLive        string `tfschema:"event_time_to_live"`
+	IdentityType           string `tfschema:"identity_type"`
+	MaxDeliveryCount       int64  `tfschema:"max_delivery_count"`
+	UserAssignedIdentityId string `tfschema:"user_assigned_identity_id"`
+}
+
+type NamespaceTopicEventSubscriptionQueueModel struct {
+	EventTimeToLive              string `tfschema:"event_time_to_live"`
+	MaxDeliveryCount             int64  `tfschema:"max_delivery_count"`
+	ReceiveLockDurationInSeconds int64  `tfschema:"receive_lock_duration_in_seconds"`
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z0-9-]{3,50}$"),
+				"Event Grid Namespace Topic Event Subscription name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"eventgrid_namespace_topic_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: eventsubscriptions.ValidateNamespaceTopicID,
+		},
+
+		"event_delivery_schema": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(eventsubscriptions.DeliverySchemaCloudEventSchemaVOneZero),
+			ValidateFunc: validation.StringInSlice(eventsubscriptions.PossibleValuesForDeliverySchema(), false),
+		},
+
+		"expiration_time_utc": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"filter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 25,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"operator_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(possibleValuesForNamespaceTopicEventSubscriptionFilterOperatorType(), false),
+					},
+
+					"values": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 25,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"included_event_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"push": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"push", "queue"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"event_hub_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: eventhubs.ValidateEventhubID,
+					},
+
+					"event_time_to_live": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validate.ISO8601Duration,
+					},
+
+					"identity_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(eventsubscriptions.EventSubscriptionIdentityTypeSystemAssigned),
+						ValidateFunc: validation.StringInSlice(eventsubscriptions.PossibleValuesForEventSubscriptionIdentityType(), false),
+					},
+
+					"max_delivery_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 10),
+					},
+
+					"user_assigned_identity_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+					},
+				},
+			},
+		},
+
+		"queue": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"push", "queue"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"event_time_to_live": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validate.ISO8601Duration,
+					},
+
+					"max_delivery_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 10),
+					},
+
+					"receive_lock_duration_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      60,
+						ValidateFunc: validation.IntBetween(60, 300),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ModelObject() interface{} {
+	return &EventGridNamespaceTopicEventSubscriptionResourceModel{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_topic_event_subscription"
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return eventsubscriptions.ValidateNamespaceTopicEventSubscriptionID
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.EventSubscriptions
+
+			var model EventGridNamespaceTopicEventSubscriptionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			topicId, err := eventsubscriptions.ParseNamespaceTopicID(model.EventgridNamespaceTopicId)
+			if err != nil {
+				return err
+			}
+
+			id := eventsubscriptions.NewNamespaceTopicEventSubscriptionID(topicId.SubscriptionId, topicId.ResourceGroupName, topicId.NamespaceName, topicId.TopicName, model.Name)
+
+			existing, err := client.NamespaceTopicEventSubscriptionsGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandEventGridNamespaceTopicEventSubscription(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsCreateOrUpdateThenPoll(ctx, id, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.EventSubscriptions
+
+			id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.NamespaceTopicEventSubscriptionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceTopicEventSubscriptionResourceModel{
+				Name:                      id.EventSubscriptionName,
+				EventgridNamespaceTopicId: eventsubscriptions.NewNamespaceTopicID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EventDeliverySchema = string(pointer.From(props.EventDeliverySchema))
+					state.ExpirationTimeUtc = pointer.From(props.ExpirationTimeUtc)
+
+					if filters := props.FiltersConfiguration; filters != nil {
+						state.IncludedEventTypes = pointer.From(filters.IncludedEventTypes)
+
+						filter, err := flattenEventGridNamespaceTopicEventSubscriptionFilters(filters.Filters)
+						if err != nil {
+							return fmt.Errorf("flattening `filter`: %+v", err)
+						}
+						state.Filter = filter
+					}
+
+					if delivery := props.DeliveryConfiguration; delivery != nil {
+						state.Push = flattenEventGridNamespaceTopicEventSubscriptionPush(delivery.Push)
+						state.Queue = flattenEventGridNamespaceTopicEventSubscriptionQueue(delivery.Queue)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.EventSubscriptions
+
+			id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceTopicEventSubscriptionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload, err := expandEventGridNamespaceTopicEventSubscription(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsCreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.EventSubscriptions
+
+			id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// possibleValuesForNamespaceTopicEventSubscriptionFilterOperatorType returns the supported filter operators, the range
+// operators (`NumberInRange` and `NumberNotInRange`) aren't supported since their values are pairs of numbers
+func possibleValuesForNamespaceTopicEventSubscriptionFilterOperatorType() []string {
+	output := make([]string, 0)
+	for _, v := range eventsubscriptions.PossibleValuesForFilterOperatorType() {
+		if v == string(eventsubscriptions.FilterOperatorTypeNumberInRange) || v == string(eventsubscriptions.FilterOperatorTypeNumberNotInRange) {
+			continue
+		}
+		output = append(output, v)
+	}
+
+	return output
+}
+
+func expandEventGridNamespaceTopicEventSubscription(input EventGridNamespaceTopicEventSubscriptionResourceModel) (*eventsubscriptions.Subscription, error) {
+	filters, err := expandEventGridNamespaceTopicEventSubscriptionFilters(input.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `filter`: %+v", err)
+	}
+
+	delivery, err := expandEventGridNamespaceTopicEventSubscriptionDelivery(input.Push, input.Queue)
+	if err != nil {
+		return nil, err
+	}
+
+	output := eventsubscriptions.Subscription{
+		Properties: &eventsubscriptions.SubscriptionProperties{
+			DeliveryConfiguration: delivery,
+			EventDeliverySchema:   pointer.To(eventsubscriptions.DeliverySchema(input.EventDeliverySchema)),
+			FiltersConfiguration: &eventsubscriptions.FiltersConfiguration{
+				Filters:            filters,
+				IncludedEventTypes: pointer.To(input.IncludedEventTypes),
+			},
+		},
+	}
+
+	if input.ExpirationTimeUtc != "" {
+		output.Properties.ExpirationTimeUtc = pointer.To(input.ExpirationTimeUtc)
+	}
+
+	return &output, nil
+}
+
+func expandEventGridNamespaceTopicEventSubscriptionDelivery(push []NamespaceTopicEventSubscriptionPushModel, queue []NamespaceTopicEventSubscriptionQueueModel) (*eventsubscriptions.DeliveryConfiguration, error) {
+	if len(queue) > 0 {
+		q := queue[0]
+		info := eventsubscriptions.QueueInfo{
+			MaxDeliveryCount:             pointer.To(q.MaxDeliveryCount),
+			ReceiveLockDurationInSeconds: pointer.To(q.ReceiveLockDurationInSeconds),
+		}
+		if q.EventTimeToLive != "" {
+			info.EventTimeToLive = pointer.To(q.EventTimeToLive)
+		}
+
+		return &eventsubscriptions.DeliveryConfiguration{
+			DeliveryMode: pointer.To(eventsubscriptions.DeliveryModeQueue),
+			Queue:        &info,
+		}, nil
+	}
+
+	if len(push) > 0 {
+		p := push[0]
+		identity := eventsubscriptions.EventSubscriptionIdentity{
+			Type: pointer.To(eventsubscriptions.EventSubscriptionIdentityType(p.IdentityType)),
+		}
+		if p.IdentityType == string(eventsubscriptions.EventSubscriptionIdentityTypeUserAssigned) {
+			if p.UserAssignedIdentityId == "" {
+				return nil, fmt.Errorf("`user_assigned_identity_id` must be specified when `identity_type` is `UserAssigned`")
+			}
+			identity.UserAssignedIdentity = pointer.To(p.UserAssignedIdentityId)
+		} else if p.UserAssignedIdentityId != "" {
+			return nil, fmt.Errorf("`user_assigned_identity_id` can only be specified when `identity_type` is `UserAssigned`")
+		}
+
+		destination := eventsubscriptions.EventHubEventSubscriptionDestination{
+			Properties: &eventsubscriptions.EventHubEventSubscriptionDestinationProperties{
+				ResourceId: pointer.To(p.EventHubId),
+			},
+		}
+
+		info := eventsubscriptions.PushInfo{
+			DeliveryWithResourceIdentity: &eventsubscriptions.DeliveryWithResourceIdentity{
+				Destination: destination,
+				Identity:    &identity,
+			},
+			MaxDeliveryCount: pointer.To(p.MaxDeliveryCount),
+		}
+		if p.EventTimeToLive != "" {
+			info.EventTimeToLive = pointer.To(p.EventTimeToLive)
+		}
+
+		return &eventsubscriptions.DeliveryConfiguration{
+			DeliveryMode: pointer.To(eventsubscriptions.DeliveryModePush),
+			Push:         &info,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func flattenEventGridNamespaceTopicEventSubscriptionQueue(input *eventsubscriptions.QueueInfo) []NamespaceTopicEventSubscriptionQueueModel {
+	if input == nil {
+		return []NamespaceTopicEventSubscriptionQueueModel{}
+	}
+
+	return []NamespaceTopicEventSubscriptionQueueModel{
+		{
+			EventTimeToLive:              pointer.From(input.EventTimeToLive),
+			MaxDeliveryCount:             pointer.From(input.MaxDeliveryCount),
+			ReceiveLockDurationInSeconds: pointer.From(input.ReceiveLockDurationInSeconds),
+		},
+	}
+}
+
+func flattenEventGridNamespaceTopicEventSubscriptionPush(input *eventsubscriptions.PushInfo) []NamespaceTopicEventSubscriptionPushModel {
+	if input == nil {
+		return []NamespaceTopicEventSubscriptionPushModel{}
+	}
+
+	output := NamespaceTopicEventSubscriptionPushModel{
+		EventTimeToLive:  pointer.From(input.EventTimeToLive),
+		MaxDeliveryCount: pointer.From(input.MaxDeliveryCount),
+	}
+
+	if delivery := input.DeliveryWithResourceIdentity; delivery != nil {
+		if destination, ok := delivery.Destination.(eventsubscriptions.EventHubEventSubscriptionDestination); ok && destination.Properties != nil {
+			output.EventHubId = pointer.From(destination.Properties.ResourceId)
+		}
+
+		if identity := delivery.Identity; identity != nil {
+			output.IdentityType = string(pointer.From(identity.Type))
+			output.UserAssignedIdentityId = pointer.From(identity.UserAssignedIdentity)
+		}
+	}
+
+	return []NamespaceTopicEventSubscriptionPushModel{output}
+}
+
+func expandEventGridNamespaceTopicEventSubscriptionFilters(input []NamespaceTopicEventSubscriptionFilterModel) (*[]eventsubscriptions.Filter, error) {
+	output := make([]eventsubscriptions.Filter, 0)
+
+	for _, v := range input {
+		filter, err := expandEventGridNamespaceTopicEventSubscriptionFilter(v)
+		if err != nil {
+			return nil, fmt.Errorf("filter on key %q: %+v", v.Key, err)
+		}
+		output = append(output, filter)
+	}
+
+	return &output, nil
+}
+
+func expandEventGridNamespaceTopicEventSubscriptionFilter(input NamespaceTopicEventSubscriptionFilterModel) (eventsubscriptions.Filter, error) {
+	key := pointer.To(input.Key)
+
+	switch eventsubscriptions.FilterOperatorType(input.OperatorType) {
+	case eventsubscriptions.FilterOperatorTypeIsNotNull, eventsubscriptions.FilterOperatorTypeIsNullOrUndefined:
+		if len(input.Values) > 0 {
+			return nil, fmt.Errorf("`values` can't be specified when `operator_type` is %q", input.OperatorType)
+		}
+		if input.OperatorType == string(eventsubscriptions.FilterOperatorTypeIsNotNull) {
+			return eventsubscriptions.IsNotNullFilter{Key: key}, nil
+		}
+		return eventsubscriptions.IsNullOrUndefinedFilter{Key: key}, nil
+
+	case eventsubscriptions.FilterOperatorTypeBoolEquals:
+		if len(input.Values) != 1 {
+			return nil, fmt.Errorf("exactly one value must be specified when `operator_type` is %q", input.OperatorType)
+		}
+		value, err := strconv.ParseBool(input.Values[0])
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as a boolean: %+v", input.Values[0], err)
+		}
+		return eventsubscriptions.BoolEqualsFilter{Key: key, Value: pointer.To(value)}, nil
+
+	case eventsubscriptions.FilterOperatorTypeNumberGreaterThan, eventsubscriptions.FilterOperatorTypeNumberGreaterThanOrEquals,
+		eventsubscriptions.FilterOperatorTypeNumberLessThan, eventsubscriptions.FilterOperatorTypeNumberLessThanOrEquals:
+		if len(input.Values) != 1 {
+			return nil, fmt.Errorf("exactly one value must be specified when `operator_type` is %q", input.OperatorType)
+		}
+		value, err := strconv.ParseFloat(input.Values[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as a number: %+v", input.Values[0], err)
+		}
+
+		switch eventsubscriptions.FilterOperatorType(input.OperatorType) {
+		case eventsubscriptions.FilterOperatorTypeNumberGreaterThan:
+			return eventsubscriptions.NumberGreaterThanFilter{Key: key, Value: pointer.To(value)}, nil
+		case eventsubscriptions.FilterOperatorTypeNumberGreaterThanOrEquals:
+			return eventsubscriptions.NumberGreaterThanOrEqualsFilter{Key: key, Value: pointer.To(value)}, nil
+		case eventsubscriptions.FilterOperatorTypeNumberLessThan:
+			return eventsubscriptions.NumberLessThanFilter{Key: key, Value: pointer.To(value)}, nil
+		default:
+			return eventsubscriptions.NumberLessThanOrEqualsFilter{Key: key, Value: pointer.To(value)}, nil
+		}
+
+	case eventsubscriptions.FilterOperatorTypeNumberIn, eventsubscriptions.FilterOperatorTypeNumberNotIn:
+		if len(input.Values) == 0 {
+			return nil, fmt.Errorf("at least one value must be specified when `operator_type` is %q", input.OperatorType)
+		}
+		values := make([]float64, 0, len(input.Values))
+		for _, v := range input.Values {
+			value, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %q as a number: %+v", v, err)
+			}
+			values = append(values, value)
+		}
+
+		if input.OperatorType == string(eventsubscriptions.FilterOperatorTypeNumberIn) {
+			return eventsubscriptions.NumberInFilter{Key: key, Values: pointer.To(values)}, nil
+		}
+		return eventsubscriptions.NumberNotInFilter{Key: key, Values: pointer.To(values)}, nil
+	}
+
+	if len(input.Values) == 0 {
+		return nil, fmt.Errorf("at least one value must be specified when `operator_type` is %q", input.OperatorType)
+	}
+	values := pointer.To(input.Values)
+
+	switch eventsubscriptions.FilterOperatorType(input.OperatorType) {
+	case eventsubscriptions.FilterOperatorTypeStringBeginsWith:
+		return eventsubscriptions.StringBeginsWithFilter{Key: key, Values: values}, nil
+	case eventsubscriptions.FilterOperatorTypeStringContains:
+		return eventsubscriptions.StringContainsFilter{Key: key, Values: values}, nil
+	case eventsubscriptions.FilterOperatorTypeStringEndsWith:
+		return eventsubscriptions.StringEndsWithFilter{Key: key, Values: values}, nil
+	case eventsubscriptions.FilterOperatorTypeStringIn:
+		return eventsubscriptions.StringInFilter{Key: key, Values: values}, nil
+	case eventsubscriptions.FilterOperatorTypeStringNotBeginsWith:
+		return eventsubscriptions.StringNotBeginsWithFilter{Key: key, Values: values}, nil
+	case eventsubscriptions.FilterOperatorTypeStringNotContains:
+		return eventsubscriptions.StringNotContainsFilter{Key: key, Values: values}, nil
+	case eventsubscriptions.FilterOperatorTypeStringNotEndsWith:
+		return eventsubscriptions.StringNotEndsWithFilter{Key: key, Values: values}, nil
+	case eventsubscriptions.FilterOperatorTypeStringNotIn:
+		return eventsubscriptions.StringNotInFilter{Key: key, Values: values}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported `operator_type` %q", input.OperatorType)
+}
+
+func flattenEventGridNamespaceTopicEventSubscriptionFilters(input *[]eventsubscriptions.Filter) ([]NamespaceTopicEventSubscriptionFilterModel, error) {
+	output := make([]NamespaceTopicEventSubscriptionFilterModel, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	formatNumber := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	formatNumbers := func(input *[]float64) []string {
+		values := make([]string, 0)
+		for _, v := range pointer.From(input) {
+			values = append(values, formatNumber(v))
+		}
+		return values
+	}
+
+	for _, item := range *input {
+		base := item.Filter()
+		filter := NamespaceTopicEventSubscriptionFilterModel{
+			Key:          pointer.From(base.Key),
+			OperatorType: string(base.OperatorType),
+			Values:       []string{},
+		}
+
+		switch v := item.(type) {
+		case eventsubscriptions.IsNotNullFilter, eventsubscriptions.IsNullOrUndefinedFilter:
+			// these operators don't have any values
+		case eventsubscriptions.BoolEqualsFilter:
+			filter.Values = []string{strconv.FormatBool(pointer.From(v.Value))}
+		case eventsubscriptions.NumberGreaterThanFilter:
+			filter.Values = []string{formatNumber(pointer.From(v.Value))}
+		case eventsubscriptions.NumberGreaterThanOrEqualsFilter:
+			filter.Values = []string{formatNumber(pointer.From(v.Value))}
+		case eventsubscriptions.NumberLessThanFilter:
+			filter.Values = []string{formatNumber(pointer.From(v.Value))}
+		case eventsubscriptions.NumberLessThanOrEqualsFilter:
+			filter.Values = []string{formatNumber(pointer.From(v.Value))}
+		case eventsubscriptions.NumberInFilter:
+			filter.Values = formatNumbers(v.Values)
+		case eventsubscriptions.NumberNotInFilter:
+			filter.Values = formatNumbers(v.Values)
+		case eventsubscriptions.StringBeginsWithFilter:
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringContainsFilter:
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringEndsWithFilter:
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringInFilter:
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringNotBeginsWithFilter:
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringNotContainsFilter:
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringNotEndsWithFilter:
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringNotInFilter:
+			filter.Values = pointer.From(v.Values)
+		default:
+			return nil, fmt.Errorf("unsupported filter operator %q on key %q", base.OperatorType, filter.Key)
+		}
+
+		output = append(output, filter)
+	}
+
+	return output, nil
+}
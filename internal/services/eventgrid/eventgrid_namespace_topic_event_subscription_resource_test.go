@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/eventsubscriptions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventGridNamespaceTopicEventSubscriptionResource struct{}
+
+func TestAccEventGridNamespaceTopicEventSubscription_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_topic_event_subscription", "test")
+	r := EventGridNamespaceTopicEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespaceTopicEventSubscription_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_topic_event_subscription", "test")
+	r := EventGridNamespaceTopicEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventGridNamespaceTopicEventSubscription_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_topic_event_subscription", "test")
+	r := EventGridNamespaceTopicEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespaceTopicEventSubscription_pushToEventHub(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_topic_event_subscription", "test")
+	r := EventGridNamespaceTopicEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.pushToEventHub(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.EventSubscriptions.NamespaceTopicEventSubscriptionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_topic_event_subscription" "test" {
+  name                         = "acctest-egntes-%d"
+  eventgrid_namespace_topic_id = azurerm_eventgrid_namespace_topic.test.id
+
+  queue {}
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_topic_event_subscription" "import" {
+  name                         = azurerm_eventgrid_namespace_topic_event_subscription.test.name
+  eventgrid_namespace_topic_id = azurerm_eventgrid_namespace_topic_event_subscription.test.eventgrid_namespace_topic_id
+
+  queue {}
+}
+`, r.basic(data))
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_topic_event_subscription" "test" {
+  name                         = "acctest-egntes-%d"
+  eventgrid_namespace_topic_id = azurerm_eventgrid_namespace_topic.test.id
+  included_event_types         = ["Contoso.Orders.Created", "Contoso.Orders.Updated"]
+
+  filter {
+    key           = "data.region"
+    operator_type = "StringIn"
+    values        = ["westeurope", "northeurope"]
+  }
+
+  filter {
+    key           = "data.quantity"
+    operator_type = "NumberGreaterThanOrEquals"
+    values        = ["5"]
+  }
+
+  filter {
+    key           = "data.priority"
+    operator_type = "BoolEquals"
+    values        = ["true"]
+  }
+
+  filter {
+    key           = "data.customer"
+    operator_type = "IsNotNull"
+  }
+
+  queue {
+    event_time_to_live               = "P1D"
+    max_delivery_count               = 5
+    receive_lock_duration_in_seconds = 120
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) pushToEventHub(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestUAI-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Basic"
+}
+
+resource "azurerm_eventhub" "test" {
+  name              = "acctesteventhub-%[1]d"
+  namespace_id      = azurerm_eventhub_namespace.test.id
+  partition_count   = 2
+  message_retention = 1
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_eventhub.test.id
+  role_definition_name = "Azure Event Hubs Data Sender"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_eventgrid_namespace" "test" {
+  name                = "acctest-egn-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+}
+
+resource "azurerm_eventgrid_namespace_topic" "test" {
+  name                   = "acctest-egnt-%[1]d"
+  eventgrid_namespace_id = azurerm_eventgrid_namespace.test.id
+}
+
+resource "azurerm_eventgrid_namespace_topic_event_subscription" "test" {
+  name                         = "acctest-egntes-%[1]d"
+  eventgrid_namespace_topic_id = azurerm_eventgrid_namespace_topic.test.id
+
+  push {
+    event_hub_id              = azurerm_eventhub.test.id
+    identity_type             = "UserAssigned"
+    user_assigned_identity_id = azurerm_user_assigned_identity.test.id
+    max_delivery_count        = 3
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_topic" "test" {
+  name                   = "acctest-egnt-%d"
+  eventgrid_namespace_id = azurerm_eventgrid_namespace.test.id
+}
+`, EventgridNamespaceTopicResource{}.template(data), data.RandomInteger)
+}
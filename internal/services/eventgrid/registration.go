@@ -42,8 +42,14 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		EventGridNamespaceCaCertificateResource{},
+		EventGridNamespaceClientGroupResource{},
+		EventGridNamespaceClientResource{},
+		EventGridNamespacePermissionBindingResource{},
 		EventGridNamespaceResource{},
+		EventGridNamespaceTopicEventSubscriptionResource{},
 		EventGridNamespaceTopicResource{},
+		EventGridNamespaceTopicSpaceResource{},
 		EventGridPartnerConfigurationResource{},
 		EventGridPartnerNamespaceResource{},
 		EventGridPartnerRegistrationResource{},
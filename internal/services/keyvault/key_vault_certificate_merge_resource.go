@@ -0,0 +1,280 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+type KeyVaultCertificateMergeResource struct{}
+
+var _ sdk.Resource = KeyVaultCertificateMergeResource{}
+
+type KeyVaultCertificateMergeResourceModel struct {
+	KeyVaultCertificateId string `tfschema:"key_vault_certificate_id"`
+	CertificateChain      string `tfschema:"certificate_chain"`
+	CertificateDataBase64 string `tfschema:"certificate_data_base64"`
+	ExpirationDate        string `tfschema:"expiration_date"`
+	Thumbprint            string `tfschema:"thumbprint"`
+	Version               string `tfschema:"version"`
+	VersionlessId         string `tfschema:"versionless_id"`
+}
+
+func (r KeyVaultCertificateMergeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"key_vault_certificate_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NestedItemIdWithOptionalVersion,
+		},
+
+		// a new chain is required each time the Certificate is renewed, since it's signed from a new CSR
+		"certificate_chain": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+	}
+}
+
+func (r KeyVaultCertificateMergeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"certificate_data_base64": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"expiration_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"thumbprint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"versionless_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KeyVaultCertificateMergeResource) ResourceType() string {
+	return "azurerm_key_vault_certificate_merge"
+}
+
+func (r KeyVaultCertificateMergeResource) ModelObject() interface{} {
+	return &KeyVaultCertificateMergeResourceModel{}
+}
+
+func (r KeyVaultCertificateMergeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NestedItemId
+}
+
+func (r KeyVaultCertificateMergeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.KeyVault.ManagementClient
+
+			var state KeyVaultCertificateMergeResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			certificateId, err := parse.ParseOptionallyVersionedNestedItemID(state.KeyVaultCertificateId)
+			if err != nil {
+				return fmt.Errorf("parsing `key_vault_certificate_id`: %+v", err)
+			}
+
+			certificateSigningRequest, err := pendingKeyVaultCertificateSigningRequest(ctx, client, certificateId.KeyVaultBaseUrl, certificateId.Name)
+			if err != nil {
+				return err
+			}
+			if certificateSigningRequest == "" {
+				return fmt.Errorf("Certificate %q in Vault %q doesn't have a pending Certificate Signing Request to merge the signed certificate with", certificateId.Name, certificateId.KeyVaultBaseUrl)
+			}
+
+			chain, err := expandKeyVaultCertificateMergeChain(state.CertificateChain)
+			if err != nil {
+				return fmt.Errorf("expanding `certificate_chain`: %+v", err)
+			}
+
+			parameters := keyvault.CertificateMergeParameters{
+				X509Certificates: &chain,
+			}
+			resp, err := client.MergeCertificate(ctx, certificateId.KeyVaultBaseUrl, certificateId.Name, parameters)
+			if err != nil {
+				return fmt.Errorf("merging the signed certificate into Certificate %q in Vault %q: %+v", certificateId.Name, certificateId.KeyVaultBaseUrl, err)
+			}
+			if resp.ID == nil {
+				return fmt.Errorf("merging the signed certificate into Certificate %q in Vault %q: `id` was nil", certificateId.Name, certificateId.KeyVaultBaseUrl)
+			}
+
+			id, err := parse.ParseNestedItemID(*resp.ID)
+			if err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r KeyVaultCertificateMergeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			vaultClient := metadata.Client.KeyVault
+			client := metadata.Client.KeyVault.ManagementClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := parse.ParseNestedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			subscriptionResourceId := commonids.NewSubscriptionID(subscriptionId)
+			keyVaultIdRaw, err := vaultClient.KeyVaultIDFromBaseUrl(ctx, subscriptionResourceId, id.KeyVaultBaseUrl)
+			if err != nil {
+				return fmt.Errorf("retrieving resource ID of the Key Vault at URL %s: %+v", id.KeyVaultBaseUrl, err)
+			}
+			if keyVaultIdRaw == nil {
+				metadata.Logger.Infof("Unable to determine the Resource ID for the Key Vault at URL %s - removing from state!", id.KeyVaultBaseUrl)
+				return metadata.MarkAsGone(id)
+			}
+
+			cert, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+			if err != nil {
+				if utils.ResponseWasNotFound(cert.Response) {
+					metadata.Logger.Infof("Certificate %q (Version %q) was not found in Key Vault at URI %q - removing from state!", id.Name, id.Version, id.KeyVaultBaseUrl)
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving Certificate %q (Version %q) in Vault %q: %+v", id.Name, id.Version, id.KeyVaultBaseUrl, err)
+			}
+
+			// when the Certificate is renewed (e.g. by the Lifetime Actions in its Policy) a new Certificate Operation is created
+			// with a new CSR, which needs to be signed and merged - as such this resource is removed from the state so that the
+			// signed certificate for the new CSR is merged
+			certificateSigningRequest, err := pendingKeyVaultCertificateSigningRequest(ctx, client, id.KeyVaultBaseUrl, id.Name)
+			if err != nil {
+				return err
+			}
+			if certificateSigningRequest != "" {
+				metadata.Logger.Infof("Certificate %q in Key Vault at URI %q has a pending Certificate Signing Request - removing from state!", id.Name, id.KeyVaultBaseUrl)
+				return metadata.MarkAsGone(id)
+			}
+
+			var state KeyVaultCertificateMergeResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			if state.KeyVaultCertificateId == "" {
+				state.KeyVaultCertificateId = id.VersionlessID()
+			}
+			state.Version = id.Version
+			state.VersionlessId = id.VersionlessID()
+
+			state.CertificateDataBase64 = ""
+			if contents := cert.Cer; contents != nil {
+				state.CertificateDataBase64 = base64.StdEncoding.EncodeToString(*contents)
+			}
+
+			state.Thumbprint = ""
+			if v := cert.X509Thumbprint; v != nil {
+				x509Thumbprint, err := base64.RawURLEncoding.DecodeString(*v)
+				if err != nil {
+					return err
+				}
+				state.Thumbprint = strings.ToUpper(hex.EncodeToString(x509Thumbprint))
+			}
+
+			state.ExpirationDate = ""
+			if attributes := cert.Attributes; attributes != nil && attributes.Expires != nil {
+				state.ExpirationDate = time.Time(*attributes.Expires).Format(time.RFC3339)
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r KeyVaultCertificateMergeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseNestedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a merged certificate can't be unmerged, the Certificate itself is managed by the `azurerm_key_vault_certificate` resource
+			metadata.Logger.Infof("Certificate %q (Version %q) in Key Vault at URI %q can't be unmerged - removing from state", id.Name, id.Version, id.KeyVaultBaseUrl)
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+// expandKeyVaultCertificateMergeChain expands a PEM encoded certificate chain (either a sequence of certificates or
+// a PKCS#7 bundle), or a base64 encoded DER certificate or PKCS#7 bundle, into the certificates to merge
+func expandKeyVaultCertificateMergeChain(input string) ([][]byte, error) {
+	input = strings.TrimSpace(input)
+
+	if !strings.Contains(input, "-----BEGIN") {
+		data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(input), ""))
+		if err != nil {
+			return nil, fmt.Errorf("expected a PEM encoded or base64 encoded certificate chain: %+v", err)
+		}
+		return [][]byte{data}, nil
+	}
+
+	chain := make([][]byte, 0)
+	rest := []byte(input)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "CERTIFICATE", "PKCS7":
+			chain = append(chain, block.Bytes)
+		default:
+			return nil, fmt.Errorf("unsupported PEM block type %q, expected `CERTIFICATE` or `PKCS7`", block.Type)
+		}
+	}
+
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("no certificates were found in the PEM encoded certificate chain")
+	}
+
+	return chain, nil
+}
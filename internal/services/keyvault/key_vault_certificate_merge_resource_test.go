@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultCertificateMergeResource struct{}
+
+func TestAccKeyVaultCertificateMerge_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_certificate_merge", "test")
+	r := KeyVaultCertificateMergeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("thumbprint").IsSet(),
+				check.That(data.ResourceName).Key("certificate_data_base64").IsSet(),
+			),
+		},
+		data.ImportStep("certificate_chain", "key_vault_certificate_id"),
+		{
+			// once merged the CSR is retained, so that the signed certificate generated from it isn't replaced
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_key_vault_certificate.test").Key("certificate_signing_request").IsSet(),
+				check.That("azurerm_key_vault_certificate.test").Key("thumbprint").IsSet(),
+			),
+		},
+	})
+}
+
+func TestAccKeyVaultCertificateMerge_base64(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_certificate_merge", "test")
+	r := KeyVaultCertificateMergeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.singleCertificateBase64(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("certificate_chain", "key_vault_certificate_id"),
+	})
+}
+
+func (t KeyVaultCertificateMergeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := clients.KeyVault.ManagementClient.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		if utils.ResponseWasNotFound(cert.Response) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(cert.Cer != nil), nil
+}
+
+func (t KeyVaultCertificateMergeResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "tls_private_key" "ca" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+
+resource "tls_self_signed_cert" "ca" {
+  private_key_pem       = tls_private_key.ca.private_key_pem
+  is_ca_certificate     = true
+  validity_period_hours = 24
+
+  subject {
+    common_name = "acctest-ca-%s"
+  }
+
+  allowed_uses = [
+    "cert_signing",
+    "crl_signing",
+  ]
+}
+
+resource "tls_locally_signed_cert" "test" {
+  cert_request_pem      = azurerm_key_vault_certificate.test.certificate_signing_request
+  ca_private_key_pem    = tls_private_key.ca.private_key_pem
+  ca_cert_pem           = tls_self_signed_cert.ca.cert_pem
+  validity_period_hours = 12
+
+  allowed_uses = [
+    "digital_signature",
+    "key_encipherment",
+    "server_auth",
+  ]
+}
+`, KeyVaultCertificateResource{}.basicGenerateUnknownIssuer(data), data.RandomString)
+}
+
+func (t KeyVaultCertificateMergeResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_merge" "test" {
+  key_vault_certificate_id = azurerm_key_vault_certificate.test.versionless_id
+  certificate_chain        = "${tls_locally_signed_cert.test.cert_pem}${tls_self_signed_cert.ca.cert_pem}"
+}
+`, t.template(data))
+}
+
+func (t KeyVaultCertificateMergeResource) singleCertificateBase64(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_merge" "test" {
+  key_vault_certificate_id = azurerm_key_vault_certificate.test.id
+  certificate_chain        = join("", slice(split("\n", trimspace(tls_locally_signed_cert.test.cert_pem)), 1, length(split("\n", trimspace(tls_locally_signed_cert.test.cert_pem))) - 1))
+}
+`, t.template(data))
+}
@@ -8,6 +8,7 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"math"
@@ -405,6 +406,11 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"certificate_signing_request": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -707,6 +713,28 @@ func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client *keyvaul
 	}
 }
 
+// pendingKeyVaultCertificateSigningRequest returns the PEM encoded Certificate Signing Request of the pending
+// Certificate Operation for the Certificate `name`, which needs to be signed by an external Certificate Authority and
+// merged into the Certificate - or an empty string when there's no pending Certificate Operation
+func pendingKeyVaultCertificateSigningRequest(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl string, name string) (string, error) {
+	operation, err := client.GetCertificateOperation(ctx, keyVaultBaseUrl, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(operation.Response) {
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieving Certificate Operation for Certificate %q in Vault %q: %+v", name, keyVaultBaseUrl, err)
+	}
+
+	if !strings.EqualFold(pointer.From(operation.Status), "inProgress") || operation.Csr == nil || len(*operation.Csr) == 0 {
+		return "", nil
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE REQUEST",
+		Bytes: *operation.Csr,
+	})), nil
+}
+
 func resourceKeyVaultCertificateRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
@@ -806,6 +834,16 @@ func resourceKeyVaultCertificateRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 	d.Set("thumbprint", thumbprint)
 
+	certificateSigningRequest, err := pendingKeyVaultCertificateSigningRequest(ctx, client, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		return err
+	}
+	// the CSR is only updated when there's a pending Certificate Operation (i.e. when the Certificate is created or renewed) -
+	// once the signed certificate has been merged the CSR is retained, since the signed certificate is generated from it
+	if certificateSigningRequest != "" {
+		d.Set("certificate_signing_request", certificateSigningRequest)
+	}
+
 	return tags.FlattenAndSet(d, cert.Tags)
 }
 
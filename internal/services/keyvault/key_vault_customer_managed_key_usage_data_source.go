@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/customermanagedkeys"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = CustomerManagedKeyUsageDataSource{}
+
+type CustomerManagedKeyUsageDataSource struct{}
+
+type CustomerManagedKeyUsageDataSourceModel struct {
+	ResourceId string                         `tfschema:"resource_id"`
+	Keys       []CustomerManagedKeyUsageModel `tfschema:"keys"`
+}
+
+type CustomerManagedKeyUsageModel struct {
+	Path             string `tfschema:"path"`
+	KeyId            string `tfschema:"key_id"`
+	VersionlessKeyId string `tfschema:"versionless_key_id"`
+	Version          string `tfschema:"version"`
+	LatestVersion    string `tfschema:"latest_version"`
+	IsLatestVersion  bool   `tfschema:"is_latest_version"`
+}
+
+func (CustomerManagedKeyUsageDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (CustomerManagedKeyUsageDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"keys": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"path": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"key_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"versionless_key_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"latest_version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"is_latest_version": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (CustomerManagedKeyUsageDataSource) ModelObject() interface{} {
+	return &CustomerManagedKeyUsageDataSourceModel{}
+}
+
+func (CustomerManagedKeyUsageDataSource) ResourceType() string {
+	return "azurerm_key_vault_customer_managed_key_usage"
+}
+
+func (CustomerManagedKeyUsageDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resourcesClient := metadata.Client.Resource.LegacyResourcesClient
+			providersClient := metadata.Client.Resource.ResourceProvidersClient
+
+			var model CustomerManagedKeyUsageDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			subscriptionId, providerNamespace, resourceType, err := resourceproviders.ParseResourceType(model.ResourceId)
+			if err != nil {
+				return err
+			}
+			if subscriptionId == "" {
+				subscriptionId = metadata.Client.Account.SubscriptionId
+			}
+
+			providerId := providers.NewSubscriptionProviderID(subscriptionId, providerNamespace)
+			apiVersions, err := resourceproviders.ApiVersionsForResourceTypes(ctx, providersClient, providerId, resourceType)
+			if err != nil {
+				return err
+			}
+			apiVersion, ok := apiVersions[resourceType]
+			if !ok {
+				return fmt.Errorf("unable to determine API version for Resource Type %q (%s)", resourceType, providerId)
+			}
+
+			resp, err := resourcesClient.GetByID(ctx, model.ResourceId, apiVersion)
+			if err != nil {
+				return fmt.Errorf("retrieving %q: %+v", model.ResourceId, err)
+			}
+
+			keys := make(map[string]keyvault.NestedItemID)
+			findCustomerManagedKeys("properties", resp.Properties, keys)
+
+			paths := make([]string, 0, len(keys))
+			for path := range keys {
+				paths = append(paths, path)
+			}
+			sort.Strings(paths)
+
+			model.Keys = make([]CustomerManagedKeyUsageModel, 0, len(paths))
+			for _, path := range paths {
+				key := keys[path]
+				usage := CustomerManagedKeyUsageModel{
+					Path:             path,
+					KeyId:            key.ID(),
+					VersionlessKeyId: key.VersionlessID(),
+					Version:          key.Version,
+				}
+
+				// the identity used by Terraform may not have access to the Key, in which case the latest version is unknown
+				latest, err := customermanagedkeys.LatestKeyVersion(ctx, metadata.Client.KeyVault.ManagementClient, metadata.Client.ManagedHSMs.DataPlaneKeysClient, key)
+				if err != nil {
+					log.Printf("[WARN] unable to determine the latest version of Key %q used at %q: %+v", key.VersionlessID(), path, err)
+				} else {
+					usage.LatestVersion = latest.Version
+					usage.IsLatestVersion = key.Version == "" || strings.EqualFold(key.Version, latest.Version)
+				}
+
+				model.Keys = append(model.Keys, usage)
+			}
+
+			metadata.ResourceData.SetId(model.ResourceId)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+// findCustomerManagedKeys populates `keys` with the Key Vault and Managed HSM Keys referenced within `input`, keyed by
+// their path. Services either reference the Key by its ID or use separate Key Vault URI, Key Name and Key Version fields.
+func findCustomerManagedKeys(path string, input interface{}, keys map[string]keyvault.NestedItemID) {
+	switch v := input.(type) {
+	case string:
+		if !strings.HasPrefix(strings.ToLower(v), "https://") {
+			return
+		}
+		if key, err := keyvault.ParseNestedItemID(v, keyvault.VersionTypeAny, keyvault.NestedItemTypeKey); err == nil {
+			keys[path] = *key
+		}
+
+	case []interface{}:
+		for i, item := range v {
+			findCustomerManagedKeys(fmt.Sprintf("%s.%d", path, i), item, keys)
+		}
+
+	case map[string]interface{}:
+		values := make(map[string]string)
+		for k, item := range v {
+			if s, ok := item.(string); ok {
+				values[strings.ToLower(k)] = s
+			}
+		}
+		if values["keyvaulturi"] != "" && values["keyname"] != "" {
+			if key, err := keyvault.NewNestedItemID(values["keyvaulturi"], keyvault.NestedItemTypeKey, values["keyname"], values["keyversion"]); err == nil {
+				keys[path] = *key
+			}
+		}
+
+		for k, item := range v {
+			findCustomerManagedKeys(fmt.Sprintf("%s.%s", path, k), item, keys)
+		}
+	}
+}
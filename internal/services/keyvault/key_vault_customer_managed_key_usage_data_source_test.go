@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CustomerManagedKeyUsageDataSource struct{}
+
+func TestAccCustomerManagedKeyUsageDataSource_storageAccount(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_key_vault_customer_managed_key_usage", "test")
+	r := CustomerManagedKeyUsageDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.storageAccount(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("keys.#").HasValue("2"),
+				check.That(data.ResourceName).Key("keys.0.path").HasValue("properties.encryption.keyvaultproperties"),
+				check.That(data.ResourceName).Key("keys.0.key_id").MatchesOtherKey(check.That("azurerm_key_vault_key.test").Key("versionless_id")),
+				check.That(data.ResourceName).Key("keys.0.version").HasValue(""),
+				check.That(data.ResourceName).Key("keys.0.is_latest_version").HasValue("true"),
+				check.That(data.ResourceName).Key("keys.1.path").HasValue("properties.encryption.keyvaultproperties.currentVersionedKeyIdentifier"),
+				check.That(data.ResourceName).Key("keys.1.versionless_key_id").MatchesOtherKey(check.That("azurerm_key_vault_key.test").Key("versionless_id")),
+				check.That(data.ResourceName).Key("keys.1.version").MatchesOtherKey(check.That("azurerm_key_vault_key.test").Key("version")),
+				check.That(data.ResourceName).Key("keys.1.is_latest_version").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (CustomerManagedKeyUsageDataSource) storageAccount(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy       = false
+      purge_soft_deleted_keys_on_destroy = false
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acctestkv%[3]s"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  purge_protection_enabled   = true
+  soft_delete_retention_days = 7
+}
+
+resource "azurerm_key_vault_access_policy" "storage" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = data.azurerm_client_config.current.tenant_id
+  object_id    = azurerm_storage_account.test.identity.0.principal_id
+
+  key_permissions = ["Get", "UnwrapKey", "WrapKey"]
+}
+
+resource "azurerm_key_vault_access_policy" "client" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = data.azurerm_client_config.current.tenant_id
+  object_id    = data.azurerm_client_config.current.object_id
+
+  key_permissions = ["Get", "Create", "Delete", "List", "Purge", "Recover", "GetRotationPolicy"]
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "acctestkvkey%[3]s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+  key_opts     = ["decrypt", "encrypt", "sign", "unwrapKey", "verify", "wrapKey"]
+
+  depends_on = [
+    azurerm_key_vault_access_policy.client,
+    azurerm_key_vault_access_policy.storage,
+  ]
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  lifecycle {
+    ignore_changes = ["customer_managed_key"]
+  }
+}
+
+resource "azurerm_storage_account_customer_managed_key" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  key_vault_key_id   = azurerm_key_vault_key.test.versionless_id
+}
+
+data "azurerm_key_vault_customer_managed_key_usage" "test" {
+  resource_id = azurerm_storage_account_customer_managed_key.test.storage_account_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -69,6 +69,7 @@ func (r Registration) Actions() []func() action.Action {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		CustomerManagedKeyUsageDataSource{},
 		EncryptedValueDataSource{},
 	}
 }
@@ -76,6 +77,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		KeyVaultCertificateContactsResource{},
+		KeyVaultCertificateMergeResource{},
 	}
 }
 
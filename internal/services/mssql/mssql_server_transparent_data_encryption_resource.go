@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/serverkeys"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/customermanagedkeys"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
@@ -59,9 +60,9 @@ func resourceMsSqlTransparentDataEncryption() *pluginsdk.Resource {
 			},
 
 			"key_vault_key_id": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ValidateFunc: keyvault.ValidateNestedItemID(keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey),
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc: keyvault.ValidateNestedItemID(keyvault.VersionTypeAny, keyvault.NestedItemTypeKey),
 			},
 
 			"auto_rotation_enabled": {
@@ -88,7 +89,7 @@ func resourceMsSqlTransparentDataEncryption() *pluginsdk.Resource {
 
 				return false
 			},
-			ValidateFunc:  keyvault.ValidateNestedItemID(keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey),
+			ValidateFunc:  keyvault.ValidateNestedItemID(keyvault.VersionTypeAny, keyvault.NestedItemTypeKey),
 			ConflictsWith: []string{"managed_hsm_key_id"},
 		}
 
@@ -107,7 +108,7 @@ func resourceMsSqlTransparentDataEncryption() *pluginsdk.Resource {
 
 				return false
 			},
-			ValidateFunc:  keyvault.ValidateNestedItemID(keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey),
+			ValidateFunc:  keyvault.ValidateNestedItemID(keyvault.VersionTypeAny, keyvault.NestedItemTypeKey),
 			ConflictsWith: []string{"key_vault_key_id"},
 			Deprecated:    "`managed_hsm_key_id` has been deprecated in favour of `key_vault_key_id` and will be removed in v5.0 of the AzureRM provider",
 		}
@@ -147,7 +148,7 @@ func resourceMsSqlTransparentDataEncryptionCreateUpdate(d *pluginsdk.ResourceDat
 
 	var key *keyvault.NestedItemID
 	if v, ok := d.GetOk("key_vault_key_id"); ok {
-		keyId, err := keyvault.ParseNestedItemID(v.(string), keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey)
+		keyId, err := keyvault.ParseNestedItemID(v.(string), keyvault.VersionTypeAny, keyvault.NestedItemTypeKey)
 		if err != nil {
 			return err
 		}
@@ -156,7 +157,7 @@ func resourceMsSqlTransparentDataEncryptionCreateUpdate(d *pluginsdk.ResourceDat
 
 	if !features.FivePointOh() {
 		if !pluginsdk.IsExplicitlyNullInConfig(d, "managed_hsm_key_id") {
-			keyId, err := keyvault.ParseNestedItemID(d.Get("managed_hsm_key_id").(string), keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey)
+			keyId, err := keyvault.ParseNestedItemID(d.Get("managed_hsm_key_id").(string), keyvault.VersionTypeAny, keyvault.NestedItemTypeKey)
 			if err != nil {
 				return err
 			}
@@ -165,6 +166,14 @@ func resourceMsSqlTransparentDataEncryptionCreateUpdate(d *pluginsdk.ResourceDat
 	}
 
 	if key != nil {
+		// the Key Version is part of the Key name, so a versionless Key uses the current version of the Key
+		if key.Version == "" {
+			key, err = customermanagedkeys.LatestKeyVersion(ctx, meta.(*clients.Client).KeyVault.ManagementClient, meta.(*clients.Client).ManagedHSMs.DataPlaneKeysClient, *key)
+			if err != nil {
+				return err
+			}
+		}
+
 		keyVaultName, err := resourceMsSqlTransparentDataEncryptionKeyVaultName(key.KeyVaultBaseURL)
 		if err != nil {
 			return err
@@ -236,7 +245,16 @@ func resourceMsSqlTransparentDataEncryptionRead(d *pluginsdk.ResourceData, meta
 
 			var hsmKeyId, keyVaultKeyId string
 			if key != nil {
-				keyVaultKeyId = key.ID()
+				// the Key Version is part of the Key name, so a rotated Key is only used once the TDE protector is updated
+				// (or automatically when `auto_rotation_enabled` is set) - as such the configured Key ID is kept unless
+				// the TDE protector isn't using the configured (or, for a versionless Key, the latest) Key Version
+				previous := d.Get("key_vault_key_id").(string)
+				if !features.FivePointOh() && previous == "" {
+					previous = d.Get("managed_hsm_key_id").(string)
+				}
+				keyVaultKeyId = customermanagedkeys.KeyIDForState(previous, *key, pointer.From(props.AutoRotationEnabled), func() (*keyvault.NestedItemID, error) {
+					return customermanagedkeys.LatestKeyVersion(ctx, meta.(*clients.Client).KeyVault.ManagementClient, meta.(*clients.Client).ManagedHSMs.DataPlaneKeysClient, *key)
+				})
 				if !features.FivePointOh() && key.IsManagedHSM() {
 					hsmKeyId = keyVaultKeyId
 				}
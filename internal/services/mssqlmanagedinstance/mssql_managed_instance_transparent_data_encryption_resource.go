@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedinstancekeys"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/customermanagedkeys"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/validate"
@@ -53,9 +54,9 @@ func resourceMsSqlManagedInstanceTransparentDataEncryption() *pluginsdk.Resource
 			},
 
 			"key_vault_key_id": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ValidateFunc: keyvault.ValidateNestedItemID(keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey),
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc: keyvault.ValidateNestedItemID(keyvault.VersionTypeAny, keyvault.NestedItemTypeKey),
 			},
 
 			"auto_rotation_enabled": {
@@ -82,7 +83,7 @@ func resourceMsSqlManagedInstanceTransparentDataEncryption() *pluginsdk.Resource
 
 				return false
 			},
-			ValidateFunc:  keyvault.ValidateNestedItemID(keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey),
+			ValidateFunc:  keyvault.ValidateNestedItemID(keyvault.VersionTypeAny, keyvault.NestedItemTypeKey),
 			ConflictsWith: []string{"managed_hsm_key_id"},
 		}
 
@@ -101,7 +102,7 @@ func resourceMsSqlManagedInstanceTransparentDataEncryption() *pluginsdk.Resource
 
 				return false
 			},
-			ValidateFunc:  keyvault.ValidateNestedItemID(keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey),
+			ValidateFunc:  keyvault.ValidateNestedItemID(keyvault.VersionTypeAny, keyvault.NestedItemTypeKey),
 			ConflictsWith: []string{"key_vault_key_id"},
 		}
 	}
@@ -140,7 +141,7 @@ func resourceMsSqlManagedInstanceTransparentDataEncryptionCreateUpdate(d *plugin
 
 	var key *keyvault.NestedItemID
 	if v, ok := d.GetOk("key_vault_key_id"); ok {
-		key, err = keyvault.ParseNestedItemID(v.(string), keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey)
+		key, err = keyvault.ParseNestedItemID(v.(string), keyvault.VersionTypeAny, keyvault.NestedItemTypeKey)
 		if err != nil {
 			return err
 		}
@@ -148,7 +149,7 @@ func resourceMsSqlManagedInstanceTransparentDataEncryptionCreateUpdate(d *plugin
 
 	if !features.FivePointOh() {
 		if !pluginsdk.IsExplicitlyNullInConfig(d, "managed_hsm_key_id") {
-			key, err = keyvault.ParseNestedItemID(d.Get("managed_hsm_key_id").(string), keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey)
+			key, err = keyvault.ParseNestedItemID(d.Get("managed_hsm_key_id").(string), keyvault.VersionTypeAny, keyvault.NestedItemTypeKey)
 			if err != nil {
 				return err
 			}
@@ -156,6 +157,14 @@ func resourceMsSqlManagedInstanceTransparentDataEncryptionCreateUpdate(d *plugin
 	}
 
 	if key != nil {
+		// the Key Version is part of the Key name, so a versionless Key uses the current version of the Key
+		if key.Version == "" {
+			key, err = customermanagedkeys.LatestKeyVersion(ctx, meta.(*clients.Client).KeyVault.ManagementClient, meta.(*clients.Client).ManagedHSMs.DataPlaneKeysClient, *key)
+			if err != nil {
+				return err
+			}
+		}
+
 		keyVaultName, err := resourceMsSqlManagedInstanceTransparentDataEncryptionKeyVaultName(key.KeyVaultBaseURL)
 		if err != nil {
 			return err
@@ -227,7 +236,16 @@ func resourceMsSqlManagedInstanceTransparentDataEncryptionRead(d *pluginsdk.Reso
 
 			var hsmKeyId, keyVaultKeyId string
 			if key != nil {
-				keyVaultKeyId = key.ID()
+				// the Key Version is part of the Key name, so a rotated Key is only used once the TDE protector is updated
+				// (or automatically when `auto_rotation_enabled` is set) - as such the configured Key ID is kept unless
+				// the TDE protector isn't using the configured (or, for a versionless Key, the latest) Key Version
+				previous := d.Get("key_vault_key_id").(string)
+				if !features.FivePointOh() && previous == "" {
+					previous = d.Get("managed_hsm_key_id").(string)
+				}
+				keyVaultKeyId = customermanagedkeys.KeyIDForState(previous, *key, pointer.From(props.AutoRotationEnabled), func() (*keyvault.NestedItemID, error) {
+					return customermanagedkeys.LatestKeyVersion(ctx, meta.(*clients.Client).KeyVault.ManagementClient, meta.(*clients.Client).ManagedHSMs.DataPlaneKeysClient, *key)
+				})
 				if !features.FivePointOh() && key.IsManagedHSM() {
 					hsmKeyId = keyVaultKeyId
 				}
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/customermanagedkeys"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	managedHsmHelpers "github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/helpers"
@@ -132,10 +133,11 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"key_vault_key_id": {
-							Type:          pluginsdk.TypeString,
-							Optional:      true,
-							ValidateFunc:  keyVaultValidate.NestedItemIdWithOptionalVersion,
-							ConflictsWith: []string{"customer_managed_key.0.managed_hsm_key_id"},
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							ValidateFunc:     keyVaultValidate.NestedItemIdWithOptionalVersion,
+							DiffSuppressFunc: customermanagedkeys.DiffSuppressVersionlessKey,
+							ConflictsWith:    []string{"customer_managed_key.0.managed_hsm_key_id"},
 							RequiredWith: []string{
 								"identity",
 								"customer_managed_key.0.primary_user_assigned_identity_id",
@@ -147,9 +149,10 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 							ValidateFunc: commonids.ValidateUserAssignedIdentityID,
 						},
 						"geo_backup_key_vault_key_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							ValidateFunc:     keyVaultValidate.NestedItemIdWithOptionalVersion,
+							DiffSuppressFunc: customermanagedkeys.DiffSuppressVersionlessKey,
 							RequiredWith: []string{
 								"identity",
 								"customer_managed_key.0.geo_backup_user_assigned_identity_id",
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+var _ resourceids.Id = PrivateEndpointConnectionApprovalId{}
+
+// PrivateEndpointConnectionApprovalId is the ID of a Private Endpoint Connection nested beneath any Resource which
+// supports Private Link, e.g. a Storage Account, Key Vault or SQL Server
+type PrivateEndpointConnectionApprovalId struct {
+	TargetResourceId              string
+	PrivateEndpointConnectionName string
+}
+
+func NewPrivateEndpointConnectionApprovalId(targetResourceId, privateEndpointConnectionName string) PrivateEndpointConnectionApprovalId {
+	return PrivateEndpointConnectionApprovalId{
+		TargetResourceId:              targetResourceId,
+		PrivateEndpointConnectionName: privateEndpointConnectionName,
+	}
+}
+
+func (p PrivateEndpointConnectionApprovalId) ID() string {
+	return fmt.Sprintf("%s/privateEndpointConnections/%s", p.TargetResourceId, p.PrivateEndpointConnectionName)
+}
+
+func (p PrivateEndpointConnectionApprovalId) String() string {
+	components := []string{
+		fmt.Sprintf("Target Resource %s", p.TargetResourceId),
+		fmt.Sprintf("Private Endpoint Connection Name %q", p.PrivateEndpointConnectionName),
+	}
+	return fmt.Sprintf("Private Endpoint Connection (%s)", strings.Join(components, " / "))
+}
+
+func PrivateEndpointConnectionApprovalID(input string) (*PrivateEndpointConnectionApprovalId, error) {
+	index := strings.LastIndex(strings.ToLower(input), "/privateendpointconnections/")
+	if index == -1 {
+		return nil, fmt.Errorf("expected ID to be in the format {TargetResourceId}/privateEndpointConnections/{Name} but got %q", input)
+	}
+
+	targetResourceId := input[:index]
+	name := input[index+len("/privateEndpointConnections/"):]
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("expected ID to be in the format {TargetResourceId}/privateEndpointConnections/{Name} but got %q", input)
+	}
+
+	target, err := azure.ParseAzureResourceID(targetResourceId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Target Resource ID %q: %+v", targetResourceId, err)
+	}
+	if target.Provider == "" || len(target.Path) == 0 {
+		return nil, fmt.Errorf("expected Target Resource ID %q to be the ID of a Resource within a Resource Provider", targetResourceId)
+	}
+
+	id := NewPrivateEndpointConnectionApprovalId(targetResourceId, name)
+	return &id, nil
+}
+
+func PrivateEndpointConnectionApprovalIDValidation(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := PrivateEndpointConnectionApprovalID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestPrivateEndpointConnectionApprovalID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Expect *PrivateEndpointConnectionApprovalId
+		Error  bool
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "Target Resource ID only",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Error: true,
+		},
+		{
+			Name:  "Missing Connection Name",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/privateEndpointConnections/",
+			Error: true,
+		},
+		{
+			Name:  "Missing Target Resource",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/privateEndpointConnections/connection1",
+			Error: true,
+		},
+		{
+			Name:  "Storage Account Connection",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/privateEndpointConnections/connection1",
+			Expect: &PrivateEndpointConnectionApprovalId{
+				TargetResourceId:              "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+				PrivateEndpointConnectionName: "connection1",
+			},
+		},
+		{
+			Name:  "Lower-cased Key Vault Connection",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/privateendpointconnections/connection1",
+			Expect: &PrivateEndpointConnectionApprovalId{
+				TargetResourceId:              "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+				PrivateEndpointConnectionName: "connection1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := PrivateEndpointConnectionApprovalID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.TargetResourceId != v.Expect.TargetResourceId {
+			t.Fatalf("Expected %q but got %q for TargetResourceId", v.Expect.TargetResourceId, actual.TargetResourceId)
+		}
+
+		if actual.PrivateEndpointConnectionName != v.Expect.PrivateEndpointConnectionName {
+			t.Fatalf("Expected %q but got %q for PrivateEndpointConnectionName", v.Expect.PrivateEndpointConnectionName, actual.PrivateEndpointConnectionName)
+		}
+	}
+}
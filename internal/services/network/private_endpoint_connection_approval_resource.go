@@ -0,0 +1,492 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/privateendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	privateEndpointConnectionApprovalStatusApproved = "Approved"
+	privateEndpointConnectionApprovalStatusRejected = "Rejected"
+)
+
+var _ sdk.ResourceWithUpdate = PrivateEndpointConnectionApprovalResource{}
+
+type PrivateEndpointConnectionApprovalResource struct{}
+
+type PrivateEndpointConnectionApprovalModel struct {
+	TargetResourceId  string `tfschema:"target_resource_id"`
+	PrivateEndpointId string `tfschema:"private_endpoint_id"`
+	Status            string `tfschema:"status"`
+	Description       string `tfschema:"description"`
+	Name              string `tfschema:"name"`
+	PrivateIpAddress  string `tfschema:"private_ip_address"`
+}
+
+func (PrivateEndpointConnectionApprovalResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"private_endpoint_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: privateendpoints.ValidatePrivateEndpointID,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  privateEndpointConnectionApprovalStatusApproved,
+			ValidateFunc: validation.StringInSlice([]string{
+				privateEndpointConnectionApprovalStatusApproved,
+				privateEndpointConnectionApprovalStatusRejected,
+			}, false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (PrivateEndpointConnectionApprovalResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"private_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (PrivateEndpointConnectionApprovalResource) ModelObject() interface{} {
+	return &PrivateEndpointConnectionApprovalModel{}
+}
+
+func (PrivateEndpointConnectionApprovalResource) ResourceType() string {
+	return "azurerm_private_endpoint_connection_approval"
+}
+
+func (PrivateEndpointConnectionApprovalResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.PrivateEndpointConnectionApprovalIDValidation
+}
+
+func (PrivateEndpointConnectionApprovalResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resourcesClient := metadata.Client.Resource.ResourcesClient.Client
+
+			var config PrivateEndpointConnectionApprovalModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Private Endpoint may live in a Subscription the Terraform identity has no access to, so it's matched on its ID
+			privateEndpointId, err := privateendpoints.ParsePrivateEndpointID(config.PrivateEndpointId)
+			if err != nil {
+				return err
+			}
+
+			apiVersion, err := privateEndpointConnectionApprovalApiVersion(ctx, metadata, config.TargetResourceId)
+			if err != nil {
+				return err
+			}
+
+			connections, err := listPrivateEndpointConnectionApprovalConnections(ctx, resourcesClient, config.TargetResourceId, apiVersion)
+			if err != nil {
+				return err
+			}
+
+			// the Private Endpoint Connection is created by the Service when the Private Endpoint requests access,
+			// so rather than creating it we look it up on the Target Resource and take over managing its state
+			connection := findPrivateEndpointConnection(connections, *privateEndpointId)
+			if connection == nil {
+				return fmt.Errorf("no Private Endpoint Connection for %s was found on %q - the Private Endpoint must request access to the Target Resource before it can be approved", privateEndpointId, config.TargetResourceId)
+			}
+
+			id := parse.NewPrivateEndpointConnectionApprovalId(config.TargetResourceId, connection.name())
+			if err := updatePrivateEndpointConnectionApprovalState(ctx, resourcesClient, id, apiVersion, *connection, config.Status, config.Description); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (PrivateEndpointConnectionApprovalResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resourcesClient := metadata.Client.Resource.ResourcesClient.Client
+			privateEndpointsClient := metadata.Client.Network.PrivateEndpoints
+			nicsClient := metadata.Client.Network.NetworkInterfaces
+
+			id, err := parse.PrivateEndpointConnectionApprovalID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			apiVersion, err := privateEndpointConnectionApprovalApiVersion(ctx, metadata, id.TargetResourceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := getPrivateEndpointConnectionApprovalConnection(ctx, resourcesClient, *id, apiVersion)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := PrivateEndpointConnectionApprovalModel{
+				TargetResourceId: id.TargetResourceId,
+				Name:             id.PrivateEndpointConnectionName,
+			}
+
+			if model := resp.Model; model != nil {
+				if v := model.privateEndpointId(); v != "" {
+					privateEndpointId, err := privateendpoints.ParsePrivateEndpointIDInsensitively(v)
+					if err != nil {
+						return err
+					}
+					state.PrivateEndpointId = privateEndpointId.ID()
+
+					// the Private Endpoint may live in a Subscription the Terraform identity has no access to, in which
+					// case the Private IP Address is unknown
+					privateEndpoint, err := privateEndpointsClient.Get(ctx, *privateEndpointId, privateendpoints.DefaultGetOperationOptions())
+					if err == nil && privateEndpoint.Model != nil {
+						_, state.PrivateIpAddress = getPrivateEndpointNetworkInterface(ctx, nicsClient, privateEndpoint.Model.Properties)
+					}
+				}
+
+				if connectionState, ok := model.Properties["privateLinkServiceConnectionState"].(map[string]interface{}); ok {
+					if v, ok := connectionState["status"].(string); ok {
+						state.Status = v
+					}
+					if v, ok := connectionState["description"].(string); ok {
+						state.Description = v
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (PrivateEndpointConnectionApprovalResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resourcesClient := metadata.Client.Resource.ResourcesClient.Client
+
+			id, err := parse.PrivateEndpointConnectionApprovalID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config PrivateEndpointConnectionApprovalModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			apiVersion, err := privateEndpointConnectionApprovalApiVersion(ctx, metadata, id.TargetResourceId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := getPrivateEndpointConnectionApprovalConnection(ctx, resourcesClient, *id, apiVersion)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+
+			if err := updatePrivateEndpointConnectionApprovalState(ctx, resourcesClient, *id, apiVersion, *existing.Model, config.Status, config.Description); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func (PrivateEndpointConnectionApprovalResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.PrivateEndpointConnectionApprovalID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the Private Endpoint Connection belongs to the Private Endpoint, deleting it here would disconnect the
+			// Private Endpoint - as such we only remove this resource from the state and leave the connection as-is
+			metadata.Logger.Infof("[DEBUG] removing %s from the state, the Private Endpoint Connection will not be modified", id)
+
+			return nil
+		},
+	}
+}
+
+// privateEndpointConnectionApprovalConnection is a Private Endpoint Connection of any Resource Provider, the properties
+// are kept as-is so that they can be sent back to the Resource Provider when updating the connection state
+type privateEndpointConnectionApprovalConnection struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+func (c privateEndpointConnectionApprovalConnection) name() string {
+	if c.Name != nil && *c.Name != "" {
+		return *c.Name
+	}
+
+	// some services only return the ID of the connection
+	id := pointer.From(c.Id)
+	return id[strings.LastIndex(id, "/")+1:]
+}
+
+func (c privateEndpointConnectionApprovalConnection) privateEndpointId() string {
+	privateEndpoint, ok := c.Properties["privateEndpoint"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	id, _ := privateEndpoint["id"].(string)
+	return id
+}
+
+// findPrivateEndpointConnection returns the Private Endpoint Connection for `privateEndpointId` within `connections`
+func findPrivateEndpointConnection(connections []privateEndpointConnectionApprovalConnection, privateEndpointId privateendpoints.PrivateEndpointId) *privateEndpointConnectionApprovalConnection {
+	for _, connection := range connections {
+		id, err := privateendpoints.ParsePrivateEndpointIDInsensitively(connection.privateEndpointId())
+		if err != nil || !strings.EqualFold(id.ID(), privateEndpointId.ID()) {
+			continue
+		}
+
+		return &connection
+	}
+
+	return nil
+}
+
+func updatePrivateEndpointConnectionApprovalState(ctx context.Context, resourcesClient *resourcemanager.Client, id parse.PrivateEndpointConnectionApprovalId, apiVersion string, connection privateEndpointConnectionApprovalConnection, status, description string) error {
+	properties := connection.Properties
+	if properties == nil {
+		properties = make(map[string]interface{})
+	}
+
+	// read-only properties are rejected by some services
+	delete(properties, "provisioningState")
+	delete(properties, "groupIds")
+
+	connectionState := map[string]interface{}{
+		"status":      status,
+		"description": description,
+	}
+	if existing, ok := properties["privateLinkServiceConnectionState"].(map[string]interface{}); ok {
+		if v, ok := existing["actionsRequired"]; ok {
+			connectionState["actionsRequired"] = v
+		}
+	}
+	properties["privateLinkServiceConnectionState"] = connectionState
+
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: privateEndpointConnectionApprovalOptions{apiVersion: apiVersion},
+		Path:          id.ID(),
+	}
+	req, err := resourcesClient.NewRequest(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+	if err := req.Marshal(privateEndpointConnectionApprovalConnection{Properties: properties}); err != nil {
+		return fmt.Errorf("marshaling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("updating the status of %s to %q: %+v", id, status, err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, resourcesClient)
+	if err != nil {
+		return fmt.Errorf("building poller for %s: %+v", id, err)
+	}
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("waiting for the status of %s to be updated to %q: %+v", id, status, err)
+	}
+
+	return nil
+}
+
+type privateEndpointConnectionApprovalGetResponse struct {
+	HttpResponse *http.Response
+	Model        *privateEndpointConnectionApprovalConnection
+}
+
+func getPrivateEndpointConnectionApprovalConnection(ctx context.Context, resourcesClient *resourcemanager.Client, id parse.PrivateEndpointConnectionApprovalId, apiVersion string) (result privateEndpointConnectionApprovalGetResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: privateEndpointConnectionApprovalOptions{apiVersion: apiVersion},
+		Path:          id.ID(),
+	}
+	req, err := resourcesClient.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model privateEndpointConnectionApprovalConnection
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+	return
+}
+
+type privateEndpointConnectionApprovalListPager struct {
+	NextLink *odata.Link `json:"nextLink"`
+}
+
+func (p *privateEndpointConnectionApprovalListPager) NextPageLink() *odata.Link {
+	defer func() {
+		p.NextLink = nil
+	}()
+
+	return p.NextLink
+}
+
+// listPrivateEndpointConnectionApprovalConnections lists the Private Endpoint Connections of the Target Resource
+func listPrivateEndpointConnectionApprovalConnections(ctx context.Context, resourcesClient *resourcemanager.Client, targetResourceId, apiVersion string) ([]privateEndpointConnectionApprovalConnection, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: privateEndpointConnectionApprovalOptions{apiVersion: apiVersion},
+		Pager:         &privateEndpointConnectionApprovalListPager{},
+		Path:          fmt.Sprintf("%s/privateEndpointConnections", targetResourceId),
+	}
+	req, err := resourcesClient.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := req.ExecutePaged(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing Private Endpoint Connections for %q: %+v", targetResourceId, err)
+	}
+
+	var values struct {
+		Values *[]privateEndpointConnectionApprovalConnection `json:"value"`
+	}
+	if err := resp.Unmarshal(&values); err != nil {
+		return nil, fmt.Errorf("unmarshaling Private Endpoint Connections for %q: %+v", targetResourceId, err)
+	}
+
+	return pointer.From(values.Values), nil
+}
+
+// privateEndpointConnectionApprovalApiVersion returns the API version to use for the Private Endpoint Connections of
+// the Target Resource, which differs between Resource Providers
+func privateEndpointConnectionApprovalApiVersion(ctx context.Context, metadata sdk.ResourceMetaData, targetResourceId string) (string, error) {
+	subscriptionId, providerNamespace, resourceType, err := resourceproviders.ParseResourceType(targetResourceId)
+	if err != nil {
+		return "", err
+	}
+	if subscriptionId == "" {
+		subscriptionId = metadata.Client.Account.SubscriptionId
+	}
+
+	connectionResourceType := resourceType + "/privateEndpointConnections"
+	providerId := providers.NewSubscriptionProviderID(subscriptionId, providerNamespace)
+	apiVersions, err := resourceproviders.ApiVersionsForResourceTypes(ctx, metadata.Client.Resource.ResourceProvidersClient, providerId, resourceType, connectionResourceType)
+	if err != nil {
+		return "", err
+	}
+
+	// not every Resource Provider registers the nested Resource Type, in which case the API version of the
+	// Target Resource is used
+	if v, ok := apiVersions[connectionResourceType]; ok {
+		return v, nil
+	}
+	if v, ok := apiVersions[resourceType]; ok {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("unable to determine API version for Resource Type %q (%s)", resourceType, providerId)
+}
+
+type privateEndpointConnectionApprovalOptions struct {
+	apiVersion string
+}
+
+func (o privateEndpointConnectionApprovalOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o privateEndpointConnectionApprovalOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o privateEndpointConnectionApprovalOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("api-version", o.apiVersion)
+	return out
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-06-01/privateendpointconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PrivateEndpointConnectionApprovalResource struct{}
+
+func TestAccPrivateEndpointConnectionApproval_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint_connection_approval", "test")
+	r := PrivateEndpointConnectionApprovalResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Approved"),
+				check.That(data.ResourceName).Key("name").Exists(),
+				check.That(data.ResourceName).Key("private_ip_address").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateEndpointConnectionApproval_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint_connection_approval", "test")
+	r := PrivateEndpointConnectionApprovalResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withDescription(data, "Approved", "Approved by the Storage team"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("Approved by the Storage team"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateEndpointConnectionApproval_rejected(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint_connection_approval", "test")
+	r := PrivateEndpointConnectionApprovalResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withDescription(data, "Rejected", "Access to this Storage Account is not permitted"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Rejected"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (PrivateEndpointConnectionApprovalResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PrivateEndpointConnectionApprovalID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the test configurations only target Storage Accounts
+	connectionId, err := privateendpointconnections.ParsePrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.ResourceManager.PrivateEndpointConnections.Get(ctx, *connectionId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	status := ""
+	if model := resp.Model; model != nil && model.Properties != nil {
+		status = string(pointer.From(model.Properties.PrivateLinkServiceConnectionState.Status))
+	}
+
+	return pointer.To(status != "" && !strings.EqualFold(status, "Pending")), nil
+}
+
+func (r PrivateEndpointConnectionApprovalResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint_connection_approval" "test" {
+  target_resource_id  = azurerm_storage_account.test.id
+  private_endpoint_id = azurerm_private_endpoint.test.id
+}
+`, r.template(data))
+}
+
+func (r PrivateEndpointConnectionApprovalResource) withDescription(data acceptance.TestData, status, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint_connection_approval" "test" {
+  target_resource_id  = azurerm_storage_account.test.id
+  private_endpoint_id = azurerm_private_endpoint.test.id
+  status              = %q
+  description         = %q
+}
+`, r.template(data), status, description)
+}
+
+func (PrivateEndpointConnectionApprovalResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-pecapproval-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_space       = ["10.5.0.0/16"]
+}
+
+resource "azurerm_subnet" "endpoint" {
+  name                 = "acctestsnetendpoint-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.5.2.0/24"]
+
+  private_endpoint_network_policies = "Disabled"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-privatelink-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  subnet_id           = azurerm_subnet.endpoint.id
+
+  private_service_connection {
+    name                           = "acctest-privatelink-%[1]d"
+    is_manual_connection           = true
+    private_connection_resource_id = azurerm_storage_account.test.id
+    subresource_names              = ["blob"]
+    request_message                = "Please approve"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
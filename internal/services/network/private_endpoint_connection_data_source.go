@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
@@ -108,16 +109,7 @@ func dataSourcePrivateEndpointConnectionRead(d *pluginsdk.ResourceData, meta int
 		d.Set("location", location.NormalizeNilable(model.Location))
 
 		if props := model.Properties; props != nil {
-			networkInterfaceId := ""
-			privateIpAddress := ""
-
-			if nics := props.NetworkInterfaces; nics != nil && len(*nics) > 0 {
-				nic := (*nics)[0]
-				if nic.Id != nil && *nic.Id != "" {
-					networkInterfaceId = *nic.Id
-					privateIpAddress = getPrivateIpAddress(ctx, nicsClient, networkInterfaceId)
-				}
-			}
+			networkInterfaceId, privateIpAddress := getPrivateEndpointNetworkInterface(ctx, nicsClient, props)
 
 			if err := d.Set("network_interface", flattenNetworkInterface(networkInterfaceId)); err != nil {
 				return fmt.Errorf("setting `network_interface`: %+v", err)
@@ -146,6 +138,20 @@ func flattenNetworkInterface(networkInterfaceId string) interface{} {
 	}
 }
 
+// getPrivateEndpointNetworkInterface returns the ID and Private IP Address of the first Network Interface of a Private Endpoint
+func getPrivateEndpointNetworkInterface(ctx context.Context, client *networkinterfaces.NetworkInterfacesClient, props *privateendpoints.PrivateEndpointProperties) (string, string) {
+	if props == nil || props.NetworkInterfaces == nil || len(*props.NetworkInterfaces) == 0 {
+		return "", ""
+	}
+
+	networkInterfaceId := pointer.From((*props.NetworkInterfaces)[0].Id)
+	if networkInterfaceId == "" {
+		return "", ""
+	}
+
+	return networkInterfaceId, getPrivateIpAddress(ctx, client, networkInterfaceId)
+}
+
 func getPrivateIpAddress(ctx context.Context, client *networkinterfaces.NetworkInterfacesClient, networkInterfaceId string) string {
 	privateIpAddress := ""
 	id, err := commonids.ParseNetworkInterfaceID(networkInterfaceId)
@@ -74,6 +74,7 @@ func (r Registration) Resources() []sdk.Resource {
 		NetworkSecurityPerimeterResource{},
 		NetworkSecurityPerimeterProfileResource{},
 		PrivateEndpointApplicationSecurityGroupAssociationResource{},
+		PrivateEndpointConnectionApprovalResource{},
 		RouteMapResource{},
 		VirtualHubRoutingIntentResource{},
 	}
@@ -119,7 +119,7 @@ func (br assignmentBaseResource) createFunc(resourceName, scopeFieldName string)
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 		Timeout: 30 * time.Minute,
 	}
@@ -173,52 +173,66 @@ func (br assignmentBaseResource) readFunc(scopeFieldName string) sdk.ResourceFun
 				return fmt.Errorf("reading nil model")
 			}
 
-			model := resp.Model
-			metadata.ResourceData.Set("name", id.PolicyAssignmentName)
-			metadata.ResourceData.Set("location", location.NormalizeNilable(model.Location))
-			// lintignore:R001
-			metadata.ResourceData.Set(scopeFieldName, id.Scope)
+			return br.flatten(metadata, id, scopeFieldName, resp.Model)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
 
-			identityIns, err := identity.FlattenSystemOrUserAssignedMap(model.Identity)
-			if err != nil {
-				return fmt.Errorf("FlattenSystemOrUserAssignedMap: %+v", err)
-			}
-			if err = metadata.ResourceData.Set("identity", identityIns); err != nil {
-				return fmt.Errorf("setting `identity`: %+v", err)
-			}
+func (br assignmentBaseResource) flatten(metadata sdk.ResourceMetaData, id *policyassignments.ScopedPolicyAssignmentId, scopeFieldName string, model *policyassignments.PolicyAssignment) error {
+	metadata.ResourceData.Set("name", id.PolicyAssignmentName)
+	metadata.ResourceData.Set("location", location.NormalizeNilable(model.Location))
+	// lintignore:R001
+	metadata.ResourceData.Set(scopeFieldName, id.Scope)
 
-			if props := model.Properties; props != nil {
-				metadata.ResourceData.Set("description", props.Description)
-				metadata.ResourceData.Set("display_name", props.DisplayName)
-				var enforce bool
-				if mode := props.EnforcementMode; mode != nil {
-					enforce = (*props.EnforcementMode) == policyassignments.EnforcementModeDefault
-				}
-				metadata.ResourceData.Set("enforce", enforce)
-				metadata.ResourceData.Set("not_scopes", props.NotScopes)
-				metadata.ResourceData.Set("policy_definition_id", props.PolicyDefinitionId)
+	identityIns, err := identity.FlattenSystemOrUserAssignedMap(model.Identity)
+	if err != nil {
+		return fmt.Errorf("FlattenSystemOrUserAssignedMap: %+v", err)
+	}
+	if err = metadata.ResourceData.Set("identity", identityIns); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
 
-				metadata.ResourceData.Set("non_compliance_message", br.flattenNonComplianceMessages(props.NonComplianceMessages))
+	if props := model.Properties; props != nil {
+		metadata.ResourceData.Set("description", props.Description)
+		metadata.ResourceData.Set("display_name", props.DisplayName)
+		var enforce bool
+		if mode := props.EnforcementMode; mode != nil {
+			enforce = (*props.EnforcementMode) == policyassignments.EnforcementModeDefault
+		}
+		metadata.ResourceData.Set("enforce", enforce)
+		metadata.ResourceData.Set("not_scopes", props.NotScopes)
+		metadata.ResourceData.Set("policy_definition_id", props.PolicyDefinitionId)
 
-				flattenedMetaData := flattenJSON(pointer.From(props.Metadata))
-				metadata.ResourceData.Set("metadata", flattenedMetaData)
+		metadata.ResourceData.Set("non_compliance_message", br.flattenNonComplianceMessages(props.NonComplianceMessages))
 
-				flattenedParameters, err := flattenParameterValuesValueToStringV2(props.Parameters)
-				if err != nil {
-					return fmt.Errorf("serializing JSON from `parameters`: %+v", err)
-				}
-				metadata.ResourceData.Set("parameters", flattenedParameters)
+		flattenedMetaData := flattenJSON(pointer.From(props.Metadata))
+		metadata.ResourceData.Set("metadata", flattenedMetaData)
 
-				overrides := br.flattenOverrides(props.Overrides)
-				metadata.ResourceData.Set("overrides", overrides)
+		flattenedParameters, err := flattenParameterValuesValueToStringV2(props.Parameters)
+		if err != nil {
+			return fmt.Errorf("serializing JSON from `parameters`: %+v", err)
+		}
+		metadata.ResourceData.Set("parameters", flattenedParameters)
 
-				resourceSel := br.flattenResourceSelectors(props.ResourceSelectors)
-				metadata.ResourceData.Set("resource_selectors", resourceSel)
-			}
+		overrides := br.flattenOverrides(props.Overrides)
+		metadata.ResourceData.Set("overrides", overrides)
 
-			return nil
-		},
-		Timeout: 5 * time.Minute,
+		resourceSel := br.flattenResourceSelectors(props.ResourceSelectors)
+		metadata.ResourceData.Set("resource_selectors", resourceSel)
+	}
+
+	return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
+}
+
+func (br assignmentBaseResource) importerFunc(validateFunc pluginsdk.SchemaValidateFunc) sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		// the Resource Identity is shared between the Policy Assignment resources, so ensure the ID is at the expected scope
+		if _, errs := validateFunc(metadata.ResourceData.Id(), "id"); len(errs) > 0 {
+			return errs[0]
+		}
+
+		return nil
 	}
 }
 
@@ -0,0 +1,55 @@
+// Copyright IBM Corp.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// listResults returns an iterator which pushes the Policy Assignments whose scope matches the type of scope managed by
+// the resource `r` - Policy Assignments are returned by the API for parent and child scopes too, these are instead
+// surfaced by the List Resource for the Policy Assignment resource at that scope.
+func (br assignmentBaseResource) listResults(ctx context.Context, request list.ListRequest, metadata sdk.ResourceMetadata, r sdk.Resource, scopeFieldName string, assignments []policyassignments.PolicyAssignment, scopeMatches func(scope string) bool) func(push func(list.ListResult) bool) {
+	return func(push func(list.ListResult) bool) {
+		for _, assignment := range assignments {
+			id, err := policyassignments.ParseScopedPolicyAssignmentIDInsensitively(pointer.From(assignment.Id))
+			if err != nil {
+				result := request.NewListResult(ctx)
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Policy Assignment ID", err)
+				return
+			}
+
+			if !scopeMatches(id.Scope) {
+				continue
+			}
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(assignment.Name)
+
+			rmd := sdk.NewResourceMetaData(metadata.Client, r)
+			rmd.SetID(id)
+
+			if err := br.flatten(rmd, id, scopeFieldName, &assignment); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", r.ResourceType()), err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rmd.ResourceData, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// policyExemptionListFlattenFunc populates the resource data for a Policy Exemption within a List Resource, returning
+// false when the Policy Exemption is not at the scope managed by the resource and should be skipped
+type policyExemptionListFlattenFunc func(d *pluginsdk.ResourceData, id parse.ScopedPolicyExemptionId, exemption *policy.Exemption) (bool, error)
+
+// collectPolicyExemptions drains the (Track1) iterator for Policy Exemptions
+func collectPolicyExemptions(ctx context.Context, iterator policy.ExemptionListResultIterator) ([]policy.Exemption, error) {
+	results := make([]policy.Exemption, 0)
+	for iterator.NotDone() {
+		results = append(results, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+func policyExemptionListResults(ctx context.Context, request list.ListRequest, resourceType string, resourceFunc func() *pluginsdk.Resource, exemptions []policy.Exemption, flatten policyExemptionListFlattenFunc) func(push func(list.ListResult) bool) {
+	return func(push func(list.ListResult) bool) {
+		for _, exemption := range exemptions {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(exemption.Name)
+
+			id, err := parse.ParseScopedPolicyExemptionIDInsensitively(pointer.From(exemption.ID))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Policy Exemption ID", err)
+				return
+			}
+
+			rd := resourceFunc().Data(&terraform.InstanceState{})
+
+			ok, err := flatten(rd, *id, &exemption)
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", resourceType), err)
+				return
+			}
+			if !ok {
+				continue
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
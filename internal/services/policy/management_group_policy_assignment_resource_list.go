@@ -0,0 +1,83 @@
+// Copyright IBM Corp.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagementGroupAssignmentListResource struct{}
+
+type ManagementGroupAssignmentListModel struct {
+	ManagementGroupId types.String `tfsdk:"management_group_id"`
+}
+
+var _ sdk.FrameworkListWrappedResource = new(ManagementGroupAssignmentListResource)
+
+func (ManagementGroupAssignmentListResource) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(ManagementGroupAssignmentResource{})
+}
+
+func (ManagementGroupAssignmentListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = ManagementGroupAssignmentResource{}.ResourceType()
+}
+
+func (ManagementGroupAssignmentListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"management_group_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateManagementGroupID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ManagementGroupAssignmentListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Policy.AssignmentsClient
+	r := ManagementGroupAssignmentResource{}
+
+	var data ManagementGroupAssignmentListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	managementGroupId, err := commonids.ParseManagementGroupID(data.ManagementGroupId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing `management_group_id`", err)
+		return
+	}
+
+	resp, err := client.ListForManagementGroupComplete(ctx, *managementGroupId, policyassignments.ListForManagementGroupOperationOptions{
+		Filter: pointer.To("atExactScope()"),
+	})
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
+		return
+	}
+
+	stream.Results = r.base.listResults(ctx, request, metadata, r, "management_group_id", resp.Items, func(scope string) bool {
+		_, err := commonids.ParseManagementGroupIDInsensitively(scope)
+		return err == nil
+	})
+}
@@ -0,0 +1,89 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccManagementGroupPolicyAssignment_list_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_policy_assignment", "testlist")
+	r := ManagementGroupAssignmentTestResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.listConfig(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicQuery(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_management_group_policy_assignment.list", 1),
+					querycheck.ExpectIdentity(
+						"azurerm_management_group_policy_assignment.list",
+						map[string]knownvalue.Check{
+							"name":  knownvalue.StringExact(fmt.Sprintf("acctestpol-mg-%s", data.RandomString)),
+							"scope": knownvalue.StringRegexp(regexp.MustCompile(fmt.Sprintf("managementGroups/acctestmg-%d$", data.RandomInteger))),
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func (r ManagementGroupAssignmentTestResource) listConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+  name         = "acctestmg-%[1]d"
+  display_name = "Acceptance Test MgmtGroup %[1]d"
+}
+
+data "azurerm_policy_definition" "test" {
+  display_name = "Allowed locations"
+}
+
+resource "azurerm_management_group_policy_assignment" "test" {
+  name                 = "acctestpol-mg-%[2]s"
+  management_group_id  = azurerm_management_group.test.id
+  policy_definition_id = data.azurerm_policy_definition.test.id
+  parameters = jsonencode({
+    "listOfAllowedLocations" = {
+      "value" = ["%[3]s"]
+    }
+  })
+}
+`, data.RandomInteger, data.RandomString, data.Locations.Primary)
+}
+
+func (r ManagementGroupAssignmentTestResource) basicQuery(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+list "azurerm_management_group_policy_assignment" "list" {
+  provider = azurerm
+  config {
+    management_group_id = "/providers/Microsoft.Management/managementGroups/acctestmg-%d"
+  }
+}
+`, data.RandomInteger)
+}
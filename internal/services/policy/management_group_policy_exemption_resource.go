@@ -4,6 +4,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -33,11 +34,15 @@ func resourceArmManagementGroupPolicyExemption() *pluginsdk.Resource {
 		Update: resourceArmManagementGroupPolicyExemptionCreateUpdate,
 		Delete: resourceArmManagementGroupPolicyExemptionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ResourcePolicyExemptionID(id)
-			return err
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&parse.ScopedPolicyExemptionId{}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			_, err := parse.ResourcePolicyExemptionID(d.Id())
+			return []*pluginsdk.ResourceData{d}, err
 		}),
 
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ScopedPolicyExemptionId{}),
+		},
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -198,9 +203,13 @@ func resourceArmManagementGroupPolicyExemptionRead(d *pluginsdk.ResourceData, me
 		return fmt.Errorf("reading %s: %+v", id.ID(), err)
 	}
 
-	d.Set("name", resp.Name)
-	d.Set("management_group_id", managementGroupId.ID())
-	if props := resp.ExemptionProperties; props != nil {
+	return resourceArmManagementGroupPolicyExemptionFlatten(d, managementGroupId.ID(), &resp)
+}
+
+func resourceArmManagementGroupPolicyExemptionFlatten(d *pluginsdk.ResourceData, scopeId string, exemption *policy.Exemption) error {
+	d.Set("name", exemption.Name)
+	d.Set("management_group_id", scopeId)
+	if props := exemption.ExemptionProperties; props != nil {
 		d.Set("policy_assignment_id", props.PolicyAssignmentID)
 		d.Set("display_name", props.DisplayName)
 		d.Set("description", props.Description)
@@ -221,7 +230,8 @@ func resourceArmManagementGroupPolicyExemptionRead(d *pluginsdk.ResourceData, me
 		}
 	}
 
-	return nil
+	id := parse.NewScopedPolicyExemptionID(scopeId, pointer.From(exemption.Name))
+	return pluginsdk.SetResourceIdentityData(d, &id)
 }
 
 func resourceArmManagementGroupPolicyExemptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,92 @@
+// Copyright IBM Corp.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagementGroupExemptionListResource struct{}
+
+type ManagementGroupExemptionListModel struct {
+	ManagementGroupId types.String `tfsdk:"management_group_id"`
+}
+
+var _ sdk.FrameworkListWrappedResource = new(ManagementGroupExemptionListResource)
+
+func (ManagementGroupExemptionListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceArmManagementGroupPolicyExemption()
+}
+
+func (ManagementGroupExemptionListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_management_group_policy_exemption"
+}
+
+func (ManagementGroupExemptionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"management_group_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateManagementGroupID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ManagementGroupExemptionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Policy.ExemptionsClient
+
+	var data ManagementGroupExemptionListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	managementGroupId, err := commonids.ParseManagementGroupID(data.ManagementGroupId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing `management_group_id`", err)
+		return
+	}
+
+	iterator, err := client.ListForManagementGroupComplete(ctx, managementGroupId.GroupId, "atExactScope()")
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_management_group_policy_exemption"), err)
+		return
+	}
+
+	results, err := collectPolicyExemptions(ctx, iterator)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_management_group_policy_exemption"), err)
+		return
+	}
+
+	stream.Results = policyExemptionListResults(ctx, request, "azurerm_management_group_policy_exemption", resourceArmManagementGroupPolicyExemption, results, func(d *pluginsdk.ResourceData, id parse.ScopedPolicyExemptionId, exemption *policy.Exemption) (bool, error) {
+		scopeId, err := commonids.ParseManagementGroupIDInsensitively(id.Scope)
+		if err != nil {
+			return false, nil
+		}
+
+		d.SetId(parse.NewResourcePolicyExemptionId(scopeId.ID(), id.PolicyExemptionName).ID())
+
+		return true, resourceArmManagementGroupPolicyExemptionFlatten(d, scopeId.ID(), exemption)
+	})
+}
@@ -0,0 +1,74 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccAzureRMManagementGroupPolicyExemption_list_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_policy_exemption", "testlist")
+	r := ManagementGroupPolicyExemptionResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.listConfig(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicQuery(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_management_group_policy_exemption.list", 1),
+					querycheck.ExpectIdentity(
+						"azurerm_management_group_policy_exemption.list",
+						map[string]knownvalue.Check{
+							"name":  knownvalue.StringExact(fmt.Sprintf("acctest-exemption-%d", data.RandomInteger)),
+							"scope": knownvalue.StringRegexp(regexp.MustCompile(fmt.Sprintf("managementGroups/acctestmg-%d$", data.RandomInteger))),
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func (r ManagementGroupPolicyExemptionResource) listConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_policy_exemption" "test" {
+  name                 = "acctest-exemption-%d"
+  management_group_id  = azurerm_management_group.test.id
+  policy_assignment_id = azurerm_management_group_policy_assignment.test.id
+  exemption_category   = "Mitigated"
+}
+`, ManagementGroupAssignmentTestResource{}.listConfig(data), data.RandomInteger)
+}
+
+func (r ManagementGroupPolicyExemptionResource) basicQuery(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+list "azurerm_management_group_policy_exemption" "list" {
+  provider = azurerm
+  config {
+    management_group_id = "/providers/Microsoft.Management/managementGroups/acctestmg-%d"
+  }
+}
+`, data.RandomInteger)
+}
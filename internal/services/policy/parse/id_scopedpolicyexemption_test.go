@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestParseScopedPolicyExemptionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ScopedPolicyExemptionId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyExemptions",
+			Error: true,
+		},
+		{
+			// subscription
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyExemptions/exemption1",
+			Expected: &ScopedPolicyExemptionId{
+				Scope:               "/subscriptions/12345678-1234-9876-4563-123456789012",
+				PolicyExemptionName: "exemption1",
+			},
+		},
+		{
+			// resource group
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/policyExemptions/exemption1",
+			Expected: &ScopedPolicyExemptionId{
+				Scope:               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+				PolicyExemptionName: "exemption1",
+			},
+		},
+		{
+			// management group
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyExemptions/exemption1",
+			Expected: &ScopedPolicyExemptionId{
+				Scope:               "/providers/Microsoft.Management/managementGroups/group1",
+				PolicyExemptionName: "exemption1",
+			},
+		},
+		{
+			// wrong casing
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/POLICYEXEMPTIONS/exemption1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseScopedPolicyExemptionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.PolicyExemptionName != v.Expected.PolicyExemptionName {
+			t.Fatalf("Expected %q but got %q for PolicyExemptionName", v.Expected.PolicyExemptionName, actual.PolicyExemptionName)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID", v.Input, actual.ID())
+		}
+	}
+}
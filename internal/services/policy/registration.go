@@ -107,5 +107,12 @@ func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
-	return []sdk.FrameworkListWrappedResource{}
+	return []sdk.FrameworkListWrappedResource{
+		ManagementGroupAssignmentListResource{},
+		ManagementGroupExemptionListResource{},
+		ResourceGroupAssignmentListResource{},
+		ResourceGroupExemptionListResource{},
+		SubscriptionAssignmentListResource{},
+		SubscriptionExemptionListResource{},
+	}
 }
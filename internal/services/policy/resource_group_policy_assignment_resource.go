@@ -6,6 +6,8 @@ package policy
 import (
 	"regexp"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	resourceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
@@ -13,7 +15,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = ResourceGroupAssignmentResource{}
+var (
+	_ sdk.ResourceWithUpdate         = ResourceGroupAssignmentResource{}
+	_ sdk.ResourceWithIdentity       = ResourceGroupAssignmentResource{}
+	_ sdk.ResourceWithCustomImporter = ResourceGroupAssignmentResource{}
+)
 
 type ResourceGroupAssignmentResource struct {
 	base assignmentBaseResource
@@ -54,6 +60,14 @@ func (r ResourceGroupAssignmentResource) Delete() sdk.ResourceFunc {
 	return r.base.deleteFunc()
 }
 
+func (r ResourceGroupAssignmentResource) Identity() resourceids.ResourceId {
+	return &policyassignments.ScopedPolicyAssignmentId{}
+}
+
+func (r ResourceGroupAssignmentResource) CustomImporter() sdk.ResourceRunFunc {
+	return r.base.importerFunc(r.IDValidationFunc())
+}
+
 func (r ResourceGroupAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.ResourceGroupAssignmentID
 }
@@ -0,0 +1,75 @@
+// Copyright IBM Corp.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ResourceGroupAssignmentListResource struct{}
+
+var _ sdk.FrameworkListWrappedResource = new(ResourceGroupAssignmentListResource)
+
+func (ResourceGroupAssignmentListResource) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(ResourceGroupAssignmentResource{})
+}
+
+func (ResourceGroupAssignmentListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = ResourceGroupAssignmentResource{}.ResourceType()
+}
+
+func (ResourceGroupAssignmentListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Policy.AssignmentsClient
+	r := ResourceGroupAssignmentResource{}
+
+	var data sdk.DefaultListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	subscriptionId := metadata.SubscriptionId
+	if !data.SubscriptionId.IsNull() {
+		subscriptionId = data.SubscriptionId.ValueString()
+	}
+
+	results := make([]policyassignments.PolicyAssignment, 0)
+
+	switch {
+	case !data.ResourceGroupName.IsNull():
+		resp, err := client.ListForResourceGroupComplete(ctx, commonids.NewResourceGroupID(subscriptionId, data.ResourceGroupName.ValueString()), policyassignments.ListForResourceGroupOperationOptions{
+			Filter: pointer.To("atExactScope()"),
+		})
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
+			return
+		}
+
+		results = resp.Items
+	default:
+		// without a filter the API returns the Policy Assignments at the Subscription and all scopes below it
+		resp, err := client.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionId), policyassignments.DefaultListOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
+			return
+		}
+
+		results = resp.Items
+	}
+
+	stream.Results = r.base.listResults(ctx, request, metadata, r, "resource_group_id", results, func(scope string) bool {
+		_, err := commonids.ParseResourceGroupIDInsensitively(scope)
+		return err == nil
+	})
+}
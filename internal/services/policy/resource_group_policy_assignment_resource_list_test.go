@@ -0,0 +1,81 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccResourceGroupPolicyAssignment_list_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_assignment", "testlist")
+	r := ResourceGroupAssignmentTestResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.withBuiltInPolicyBasic(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicQuery(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_resource_group_policy_assignment.list", 1),
+					querycheck.ExpectIdentity(
+						"azurerm_resource_group_policy_assignment.list",
+						map[string]knownvalue.Check{
+							"name":  knownvalue.StringExact(fmt.Sprintf("acctestpa-rg-%d", data.RandomInteger)),
+							"scope": knownvalue.StringRegexp(regexp.MustCompile(fmt.Sprintf("resourceGroups/acctest%d$", data.RandomInteger))),
+						},
+					),
+				},
+			},
+			{
+				Query:  true,
+				Config: r.basicQueryBySubscription(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("azurerm_resource_group_policy_assignment.list", 1),
+				},
+			},
+		},
+	})
+}
+
+func (r ResourceGroupAssignmentTestResource) basicQuery(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+list "azurerm_resource_group_policy_assignment" "list" {
+  provider = azurerm
+  config {
+    resource_group_name = "acctest%d"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (r ResourceGroupAssignmentTestResource) basicQueryBySubscription() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+list "azurerm_resource_group_policy_assignment" "list" {
+  provider = azurerm
+  config {}
+}
+`
+}
@@ -4,6 +4,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -33,11 +34,15 @@ func resourceArmResourceGroupPolicyExemption() *pluginsdk.Resource {
 		Update: resourceArmResourceGroupPolicyExemptionCreateUpdate,
 		Delete: resourceArmResourceGroupPolicyExemptionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ResourceGroupPolicyExemptionID(id)
-			return err
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&parse.ScopedPolicyExemptionId{}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			_, err := parse.ResourceGroupPolicyExemptionID(d.Id())
+			return []*pluginsdk.ResourceData{d}, err
 		}),
 
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ScopedPolicyExemptionId{}),
+		},
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -196,9 +201,13 @@ func resourceArmResourceGroupPolicyExemptionRead(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("reading %s: %+v", id.ID(), err)
 	}
 
-	d.Set("name", resp.Name)
-	d.Set("resource_group_id", resourceGroupId.ID())
-	if props := resp.ExemptionProperties; props != nil {
+	return resourceArmResourceGroupPolicyExemptionFlatten(d, resourceGroupId.ID(), &resp)
+}
+
+func resourceArmResourceGroupPolicyExemptionFlatten(d *pluginsdk.ResourceData, scopeId string, exemption *policy.Exemption) error {
+	d.Set("name", exemption.Name)
+	d.Set("resource_group_id", scopeId)
+	if props := exemption.ExemptionProperties; props != nil {
 		d.Set("policy_assignment_id", props.PolicyAssignmentID)
 		d.Set("display_name", props.DisplayName)
 		d.Set("description", props.Description)
@@ -219,7 +228,8 @@ func resourceArmResourceGroupPolicyExemptionRead(d *pluginsdk.ResourceData, meta
 		}
 	}
 
-	return nil
+	id := parse.NewScopedPolicyExemptionID(scopeId, pointer.From(exemption.Name))
+	return pluginsdk.SetResourceIdentityData(d, &id)
 }
 
 func resourceArmResourceGroupPolicyExemptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,77 @@
+// Copyright IBM Corp.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ResourceGroupExemptionListResource struct{}
+
+var _ sdk.FrameworkListWrappedResource = new(ResourceGroupExemptionListResource)
+
+func (ResourceGroupExemptionListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceArmResourceGroupPolicyExemption()
+}
+
+func (ResourceGroupExemptionListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_resource_group_policy_exemption"
+}
+
+func (ResourceGroupExemptionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	// the Track1 client is bound to a Subscription, so take a copy to allow listing within other Subscriptions
+	client := *metadata.Client.Policy.ExemptionsClient
+
+	var data sdk.DefaultListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if !data.SubscriptionId.IsNull() {
+		client.SubscriptionID = data.SubscriptionId.ValueString()
+	}
+
+	var iterator policy.ExemptionListResultIterator
+	var err error
+
+	switch {
+	case !data.ResourceGroupName.IsNull():
+		iterator, err = client.ListForResourceGroupComplete(ctx, data.ResourceGroupName.ValueString(), "atExactScope()")
+	default:
+		// without a filter the API returns the Policy Exemptions at the Subscription and all scopes below it
+		iterator, err = client.ListComplete(ctx, "")
+	}
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_resource_group_policy_exemption"), err)
+		return
+	}
+
+	results, err := collectPolicyExemptions(ctx, iterator)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_resource_group_policy_exemption"), err)
+		return
+	}
+
+	stream.Results = policyExemptionListResults(ctx, request, "azurerm_resource_group_policy_exemption", resourceArmResourceGroupPolicyExemption, results, func(d *pluginsdk.ResourceData, id parse.ScopedPolicyExemptionId, exemption *policy.Exemption) (bool, error) {
+		scopeId, err := commonids.ParseResourceGroupIDInsensitively(id.Scope)
+		if err != nil {
+			return false, nil
+		}
+
+		d.SetId(parse.NewResourceGroupPolicyExemptionID(scopeId.SubscriptionId, scopeId.ResourceGroupName, id.PolicyExemptionName).ID())
+
+		return true, resourceArmResourceGroupPolicyExemptionFlatten(d, scopeId.ID(), exemption)
+	})
+}
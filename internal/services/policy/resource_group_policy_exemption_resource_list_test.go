@@ -0,0 +1,61 @@
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccAzureRMResourceGroupPolicyExemption_list_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_exemption", "testlist")
+	r := ResourceGroupPolicyExemptionResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicQuery(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_resource_group_policy_exemption.list", 1),
+					querycheck.ExpectIdentity(
+						"azurerm_resource_group_policy_exemption.list",
+						map[string]knownvalue.Check{
+							"name":  knownvalue.StringExact(fmt.Sprintf("acctest-exemption-%d", data.RandomInteger)),
+							"scope": knownvalue.StringRegexp(regexp.MustCompile(fmt.Sprintf("resourceGroups/acctest%d$", data.RandomInteger))),
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func (r ResourceGroupPolicyExemptionResource) basicQuery(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+list "azurerm_resource_group_policy_exemption" "list" {
+  provider = azurerm
+  config {
+    resource_group_name = "acctest%d"
+  }
+}
+`, data.RandomInteger)
+}
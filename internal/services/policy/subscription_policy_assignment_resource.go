@@ -7,13 +7,19 @@ import (
 	"regexp"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = SubscriptionAssignmentResource{}
+var (
+	_ sdk.ResourceWithUpdate         = SubscriptionAssignmentResource{}
+	_ sdk.ResourceWithIdentity       = SubscriptionAssignmentResource{}
+	_ sdk.ResourceWithCustomImporter = SubscriptionAssignmentResource{}
+)
 
 type SubscriptionAssignmentResource struct {
 	base assignmentBaseResource
@@ -54,6 +60,14 @@ func (r SubscriptionAssignmentResource) Delete() sdk.ResourceFunc {
 	return r.base.deleteFunc()
 }
 
+func (r SubscriptionAssignmentResource) Identity() resourceids.ResourceId {
+	return &policyassignments.ScopedPolicyAssignmentId{}
+}
+
+func (r SubscriptionAssignmentResource) CustomImporter() sdk.ResourceRunFunc {
+	return r.base.importerFunc(r.IDValidationFunc())
+}
+
 func (r SubscriptionAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.SubscriptionAssignmentID
 }
@@ -0,0 +1,83 @@
+// Copyright IBM Corp.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SubscriptionAssignmentListResource struct{}
+
+type SubscriptionAssignmentListModel struct {
+	SubscriptionId types.String `tfsdk:"subscription_id"`
+}
+
+var _ sdk.FrameworkListWrappedResource = new(SubscriptionAssignmentListResource)
+
+func (SubscriptionAssignmentListResource) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(SubscriptionAssignmentResource{})
+}
+
+func (SubscriptionAssignmentListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = SubscriptionAssignmentResource{}.ResourceType()
+}
+
+func (SubscriptionAssignmentListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"subscription_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsUUID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (SubscriptionAssignmentListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Policy.AssignmentsClient
+	r := SubscriptionAssignmentResource{}
+
+	var data SubscriptionAssignmentListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	subscriptionId := metadata.SubscriptionId
+	if !data.SubscriptionId.IsNull() {
+		subscriptionId = data.SubscriptionId.ValueString()
+	}
+
+	resp, err := client.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionId), policyassignments.ListOperationOptions{
+		Filter: pointer.To("atExactScope()"),
+	})
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
+		return
+	}
+
+	stream.Results = r.base.listResults(ctx, request, metadata, r, "subscription_id", resp.Items, func(scope string) bool {
+		_, err := commonids.ParseSubscriptionIDInsensitively(scope)
+		return err == nil
+	})
+}
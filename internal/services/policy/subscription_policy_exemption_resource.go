@@ -4,6 +4,7 @@
 package policy
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -32,11 +33,15 @@ func resourceArmSubscriptionPolicyExemption() *pluginsdk.Resource {
 		Update: resourceArmSubscriptionPolicyExemptionCreateUpdate,
 		Delete: resourceArmSubscriptionPolicyExemptionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SubscriptionPolicyExemptionID(id)
-			return err
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&parse.ScopedPolicyExemptionId{}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			_, err := parse.SubscriptionPolicyExemptionID(d.Id())
+			return []*pluginsdk.ResourceData{d}, err
 		}),
 
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ScopedPolicyExemptionId{}),
+		},
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -194,9 +199,13 @@ func resourceArmSubscriptionPolicyExemptionRead(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("reading %s: %+v", id.ID(), err)
 	}
 
-	d.Set("name", resp.Name)
-	d.Set("subscription_id", subscriptionId.ID())
-	if props := resp.ExemptionProperties; props != nil {
+	return resourceArmSubscriptionPolicyExemptionFlatten(d, subscriptionId.ID(), &resp)
+}
+
+func resourceArmSubscriptionPolicyExemptionFlatten(d *pluginsdk.ResourceData, scopeId string, exemption *policy.Exemption) error {
+	d.Set("name", exemption.Name)
+	d.Set("subscription_id", scopeId)
+	if props := exemption.ExemptionProperties; props != nil {
 		d.Set("policy_assignment_id", props.PolicyAssignmentID)
 		d.Set("display_name", props.DisplayName)
 		d.Set("description", props.Description)
@@ -217,7 +226,8 @@ func resourceArmSubscriptionPolicyExemptionRead(d *pluginsdk.ResourceData, meta
 		}
 	}
 
-	return nil
+	id := parse.NewScopedPolicyExemptionID(scopeId, pointer.From(exemption.Name))
+	return pluginsdk.SetResourceIdentityData(d, &id)
 }
 
 func resourceArmSubscriptionPolicyExemptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,92 @@
+// Copyright IBM Corp.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SubscriptionExemptionListResource struct{}
+
+type SubscriptionExemptionListModel struct {
+	SubscriptionId types.String `tfsdk:"subscription_id"`
+}
+
+var _ sdk.FrameworkListWrappedResource = new(SubscriptionExemptionListResource)
+
+func (SubscriptionExemptionListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceArmSubscriptionPolicyExemption()
+}
+
+func (SubscriptionExemptionListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_subscription_policy_exemption"
+}
+
+func (SubscriptionExemptionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"subscription_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsUUID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (SubscriptionExemptionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	// the Track1 client is bound to a Subscription, so take a copy to allow listing within other Subscriptions
+	client := *metadata.Client.Policy.ExemptionsClient
+
+	var data SubscriptionExemptionListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if !data.SubscriptionId.IsNull() {
+		client.SubscriptionID = data.SubscriptionId.ValueString()
+	}
+
+	iterator, err := client.ListComplete(ctx, "atExactScope()")
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_subscription_policy_exemption"), err)
+		return
+	}
+
+	results, err := collectPolicyExemptions(ctx, iterator)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_subscription_policy_exemption"), err)
+		return
+	}
+
+	stream.Results = policyExemptionListResults(ctx, request, "azurerm_subscription_policy_exemption", resourceArmSubscriptionPolicyExemption, results, func(d *pluginsdk.ResourceData, id parse.ScopedPolicyExemptionId, exemption *policy.Exemption) (bool, error) {
+		scopeId, err := commonids.ParseSubscriptionIDInsensitively(id.Scope)
+		if err != nil {
+			return false, nil
+		}
+
+		d.SetId(parse.NewSubscriptionPolicyExemptionID(scopeId.SubscriptionId, id.PolicyExemptionName).ID())
+
+		return true, resourceArmSubscriptionPolicyExemptionFlatten(d, scopeId.ID(), exemption)
+	})
+}
@@ -10,11 +10,13 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	mgParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
 	mgValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
@@ -179,6 +181,13 @@ func managementGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData,
 
 	log.Printf("[DEBUG] Waiting for deployment of Management Group Template Deployment %q..", id.DeploymentName)
 	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		err = sdk.CancelInterruptedOperation(ctx, err, func(ctx context.Context) error {
+			return cancelTemplateDeployment(ctx, func(ctx context.Context) (autorest.Response, error) {
+				return client.CancelAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName)
+			}, func(ctx context.Context) (resources.DeploymentExtended, error) {
+				return client.GetAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName)
+			})
+		})
 		return fmt.Errorf("waiting for creation of Management Group Template Deployment %q: %+v", id.DeploymentName, err)
 	}
 
@@ -272,6 +281,13 @@ func managementGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData,
 
 	log.Printf("[DEBUG] Waiting for deployment of Management Group Template Deployment %q..", id.DeploymentName)
 	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		err = sdk.CancelInterruptedOperation(ctx, err, func(ctx context.Context) error {
+			return cancelTemplateDeployment(ctx, func(ctx context.Context) (autorest.Response, error) {
+				return client.CancelAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName)
+			}, func(ctx context.Context) (resources.DeploymentExtended, error) {
+				return client.GetAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName)
+			})
+		})
 		return fmt.Errorf("waiting for creation of Management Group Template Deployment %q: %+v", id.DeploymentName, err)
 	}
 
@@ -11,10 +11,12 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -199,6 +201,13 @@ func resourceGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, me
 
 	log.Printf("[DEBUG] Waiting for deployment of Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
 	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		err = sdk.CancelInterruptedOperation(ctx, err, func(ctx context.Context) error {
+			return cancelTemplateDeployment(ctx, func(ctx context.Context) (autorest.Response, error) {
+				return client.Cancel(ctx, id.ResourceGroup, id.DeploymentName)
+			}, func(ctx context.Context) (resources.DeploymentExtended, error) {
+				return client.Get(ctx, id.ResourceGroup, id.DeploymentName)
+			})
+		})
 		return fmt.Errorf("waiting for creation of Template Deployment %q (Resource Group %q): %+v", id.DeploymentName, id.ResourceGroup, err)
 	}
 
@@ -293,6 +302,13 @@ func resourceGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, me
 
 	log.Printf("[DEBUG] Waiting for deployment of Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
 	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		err = sdk.CancelInterruptedOperation(ctx, err, func(ctx context.Context) error {
+			return cancelTemplateDeployment(ctx, func(ctx context.Context) (autorest.Response, error) {
+				return client.Cancel(ctx, id.ResourceGroup, id.DeploymentName)
+			}, func(ctx context.Context) (resources.DeploymentExtended, error) {
+				return client.Get(ctx, id.ResourceGroup, id.DeploymentName)
+			})
+		})
 		return fmt.Errorf("waiting for creation of Template Deployment %q (Resource Group %q): %+v", id.DeploymentName, id.ResourceGroup, err)
 	}
 
@@ -10,11 +10,13 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -166,6 +168,13 @@ func subscriptionTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, met
 
 	log.Printf("[DEBUG] Waiting for deployment of Subscription Template Deployment %q..", id.DeploymentName)
 	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		err = sdk.CancelInterruptedOperation(ctx, err, func(ctx context.Context) error {
+			return cancelTemplateDeployment(ctx, func(ctx context.Context) (autorest.Response, error) {
+				return client.CancelAtSubscriptionScope(ctx, id.DeploymentName)
+			}, func(ctx context.Context) (resources.DeploymentExtended, error) {
+				return client.GetAtSubscriptionScope(ctx, id.DeploymentName)
+			})
+		})
 		return fmt.Errorf("waiting for creation of Subscription Template Deployment %q: %+v", id.DeploymentName, err)
 	}
 
@@ -257,6 +266,13 @@ func subscriptionTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, met
 
 	log.Printf("[DEBUG] Waiting for deployment of Subscription Template Deployment %q..", id.DeploymentName)
 	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		err = sdk.CancelInterruptedOperation(ctx, err, func(ctx context.Context) error {
+			return cancelTemplateDeployment(ctx, func(ctx context.Context) (autorest.Response, error) {
+				return client.CancelAtSubscriptionScope(ctx, id.DeploymentName)
+			}, func(ctx context.Context) (resources.DeploymentExtended, error) {
+				return client.GetAtSubscriptionScope(ctx, id.DeploymentName)
+			})
+		})
 		return fmt.Errorf("waiting for creation of Subscription Template Deployment %q: %+v", id.DeploymentName, err)
 	}
 
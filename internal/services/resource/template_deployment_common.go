@@ -14,11 +14,13 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type templateDeploymentDebugLevel string
@@ -145,6 +147,50 @@ func filterOutTemplateDeploymentParameters(input interface{}) interface{} {
 	return output
 }
 
+// FlattenTemplateDeploymentOutputs unwraps the `{ "type": "...", "value": ... }` object returned for each Output of
+// a Template Deployment into the value of the Output
+func FlattenTemplateDeploymentOutputs(input interface{}) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output, nil
+	}
+
+	items, ok := input.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected the outputs to be an object but got %T", input)
+	}
+
+	// the `type` is removed in the same way as for the Parameters, leaving only the value
+	for key, value := range filterOutTemplateDeploymentParameters(items).(map[string]interface{}) {
+		inner, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected the output %q to be an object containing a `value` but got %T", key, value)
+		}
+
+		output[key] = nil
+		for innerKey, innerValue := range inner {
+			if strings.EqualFold("value", innerKey) {
+				output[key] = innerValue
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// ExpandTemplateDeploymentParameters wraps each of the values as `{ "value": ... }`, as required for the Parameters of
+// a Template Deployment
+func ExpandTemplateDeploymentParameters(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	for key, value := range input {
+		output[key] = map[string]interface{}{
+			"value": value,
+		}
+	}
+
+	return output
+}
+
 func deleteNestedResource(ctx context.Context, resourcesClient *resources.Client, resourceProviderApiVersions *map[string]string, nestedResource resources.Reference) error {
 	parsedId, err := azure.ParseAzureResourceID(*nestedResource.ID)
 	if err != nil {
@@ -309,3 +355,58 @@ func findApiVersionForResourceType(resourceType string, availableResourceTypes [
 
 	return nil
 }
+
+// cancelTemplateDeployment cancels a Template Deployment which is still in progress, and then waits for the
+// Template Deployment to reach a terminal state - this is used when Terraform is interrupted (or the timeout is
+// reached) whilst waiting for a deployment, since otherwise the deployment continues to run in Azure.
+func cancelTemplateDeployment(ctx context.Context, cancel func(ctx context.Context) (autorest.Response, error), get func(ctx context.Context) (resources.DeploymentExtended, error)) error {
+	if resp, err := cancel(ctx); err != nil {
+		// a Conflict is returned when the deployment has already finished, so there's nothing to cancel
+		if !utils.ResponseWasConflict(resp) {
+			return fmt.Errorf("cancelling: %+v", err)
+		}
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(resources.ProvisioningStateAccepted),
+			string(resources.ProvisioningStateCreated),
+			string(resources.ProvisioningStateCreating),
+			string(resources.ProvisioningStateDeleting),
+			string(resources.ProvisioningStateNotSpecified),
+			string(resources.ProvisioningStateReady),
+			string(resources.ProvisioningStateRunning),
+			string(resources.ProvisioningStateUpdating),
+			"Canceling",
+			"Validating",
+			"Waiting",
+		},
+		Target: []string{
+			string(resources.ProvisioningStateCanceled),
+			string(resources.ProvisioningStateDeleted),
+			string(resources.ProvisioningStateFailed),
+			string(resources.ProvisioningStateSucceeded),
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := get(ctx)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving: %+v", err)
+			}
+			if resp.Properties == nil {
+				return nil, "", fmt.Errorf("retrieving: `properties` was nil")
+			}
+			return resp, string(resp.Properties.ProvisioningState), nil
+		},
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for cancellation to complete: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,360 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/md5" // nolint: gosec used for the Content-MD5 of a blob, which the API requires to be md5
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
+)
+
+const defaultBlobDirectoryContentType = "application/octet-stream"
+
+// BlobDirectorySync synchronises the files within a local directory to the blobs beneath a prefix in a container
+type BlobDirectorySync struct {
+	BlobsClient      *blobs.Client
+	ContainersClient shim.StorageContainerWrapper
+
+	ContainerName string
+	Prefix        string
+	Source        string
+
+	CacheControl string
+	ContentTypes map[string]string
+	Parallelism  int
+}
+
+// buildBlobDirectoryManifest returns a map of the path of each file within the directory (relative to the directory
+// and using forward slashes) to the hex encoded MD5 hash of its contents
+func buildBlobDirectoryManifest(source string) (map[string]string, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory %q: %+v", source, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("source %q is not a directory", source)
+	}
+
+	manifest := make(map[string]string)
+	err = filepath.WalkDir(source, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(source, filePath)
+		if err != nil {
+			return err
+		}
+
+		hash, err := blobDirectoryFileMD5(filePath)
+		if err != nil {
+			return err
+		}
+
+		manifest[filepath.ToSlash(relativePath)] = hex.EncodeToString(hash)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking source directory %q: %+v", source, err)
+	}
+
+	return manifest, nil
+}
+
+// blobDirectoryManifestHash returns a stable hash of the manifest, which changes when any file is added, removed or modified
+func blobDirectoryManifestHash(manifest map[string]string) string {
+	names := make([]string, 0, len(manifest))
+	for name := range manifest {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		hash.Write([]byte(fmt.Sprintf("%s:%s\n", name, manifest[name])))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// ContentType returns the Content Type for the file, using the configured overrides for its extension if present,
+// then the well known type for the extension, falling back to `application/octet-stream`
+func (s BlobDirectorySync) ContentType(name string) string {
+	extension := strings.ToLower(path.Ext(name))
+	if extension == "" {
+		return defaultBlobDirectoryContentType
+	}
+
+	for k, v := range s.ContentTypes {
+		if strings.EqualFold(strings.TrimPrefix(k, "."), strings.TrimPrefix(extension, ".")) {
+			return v
+		}
+	}
+
+	if contentType := mime.TypeByExtension(extension); contentType != "" {
+		return contentType
+	}
+
+	return defaultBlobDirectoryContentType
+}
+
+// BlobName returns the name of the blob for the relative path of a file within the directory
+func (s BlobDirectorySync) BlobName(relativePath string) string {
+	if s.Prefix == "" {
+		return relativePath
+	}
+
+	return fmt.Sprintf("%s/%s", s.Prefix, relativePath)
+}
+
+// ListRemote returns a map of the relative path of each blob beneath the prefix to the hex encoded MD5 hash of its
+// contents, the returned map is nil when the container doesn't exist
+func (s BlobDirectorySync) ListRemote(ctx context.Context) (map[string]string, error) {
+	prefix := ""
+	if s.Prefix != "" {
+		prefix = s.Prefix + "/"
+	}
+
+	output := make(map[string]string)
+	input := containers.ListBlobsInput{
+		MaxResults: pointer.To(5000),
+	}
+	if prefix != "" {
+		input.Prefix = pointer.To(prefix)
+	}
+
+	for {
+		result, err := s.ContainersClient.ListBlobs(ctx, s.ContainerName, input)
+		if err != nil {
+			return nil, fmt.Errorf("listing blobs in container %q: %+v", s.ContainerName, err)
+		}
+		if result == nil {
+			return nil, nil
+		}
+
+		for _, blob := range result.Blobs.Blobs {
+			contentMD5 := ""
+			if blob.Properties != nil && blob.Properties.ContentMD5 != nil && *blob.Properties.ContentMD5 != "" {
+				contentMD5, err = convertBase64ToHexEncoding(*blob.Properties.ContentMD5)
+				if err != nil {
+					return nil, fmt.Errorf("parsing the Content MD5 of blob %q: %+v", blob.Name, err)
+				}
+			}
+			output[strings.TrimPrefix(blob.Name, prefix)] = contentMD5
+		}
+
+		if result.NextMarker == nil || *result.NextMarker == "" {
+			break
+		}
+		input.Marker = result.NextMarker
+	}
+
+	return output, nil
+}
+
+// Upload uploads the specified files (relative paths within the source directory) to the container. Files of up to
+// `maxBlockSize` are uploaded `Parallelism` at a time in a single request, larger files are then uploaded one at a
+// time in blocks, `Parallelism` blocks at a time - so that at most `Parallelism` blocks are held in memory at once
+func (s BlobDirectorySync) Upload(ctx context.Context, files []string) error {
+	small := make([]string, 0)
+	large := make([]string, 0)
+	for _, name := range files {
+		info, err := os.Stat(filepath.Join(s.Source, filepath.FromSlash(name)))
+		if err != nil {
+			return fmt.Errorf("reading %q: %+v", name, err)
+		}
+
+		if info.Size() > maxBlockSize {
+			large = append(large, name)
+		} else {
+			small = append(small, name)
+		}
+	}
+
+	if err := s.parallelise(small, func(name string) error {
+		return s.uploadFile(ctx, name)
+	}); err != nil {
+		return err
+	}
+
+	for _, name := range large {
+		if err := s.uploadFileInBlocks(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Delete deletes the blobs for the specified files (relative paths within the source directory), blobs which
+// no longer exist are ignored
+func (s BlobDirectorySync) Delete(ctx context.Context, files []string) error {
+	return s.parallelise(files, func(name string) error {
+		blobName := s.BlobName(name)
+		resp, err := s.BlobsClient.Delete(ctx, s.ContainerName, blobName, blobs.DeleteInput{DeleteSnapshots: true})
+		if err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting blob %q: %+v", blobName, err)
+		}
+		return nil
+	})
+}
+
+func (s BlobDirectorySync) uploadFile(ctx context.Context, name string) error {
+	blobName := s.BlobName(name)
+
+	content, err := os.ReadFile(filepath.Join(s.Source, filepath.FromSlash(name)))
+	if err != nil {
+		return fmt.Errorf("reading %q: %+v", name, err)
+	}
+
+	hash := md5.Sum(content) // nolint: gosec
+	input := blobs.PutBlockBlobInput{
+		ContentMD5:  pointer.To(base64.StdEncoding.EncodeToString(hash[:])),
+		ContentType: pointer.To(s.ContentType(name)),
+	}
+	if s.CacheControl != "" {
+		input.CacheControl = pointer.To(s.CacheControl)
+	}
+	// the API rejects an empty body, so empty files are uploaded without any content
+	if len(content) > 0 {
+		input.Content = pointer.To(content)
+	}
+
+	if _, err := s.BlobsClient.PutBlockBlob(ctx, s.ContainerName, blobName, input); err != nil {
+		return fmt.Errorf("uploading %q to blob %q: %+v", name, blobName, err)
+	}
+
+	return nil
+}
+
+func (s BlobDirectorySync) uploadFileInBlocks(ctx context.Context, name string) error {
+	blobName := s.BlobName(name)
+	filePath := filepath.Join(s.Source, filepath.FromSlash(name))
+
+	hash, err := blobDirectoryFileMD5(filePath)
+	if err != nil {
+		return fmt.Errorf("reading %q: %+v", name, err)
+	}
+
+	upload := BlobUpload{
+		Client:        s.BlobsClient,
+		BlobName:      blobName,
+		ContainerName: s.ContainerName,
+		CacheControl:  s.CacheControl,
+		ContentType:   s.ContentType(name),
+		ContentMD5:    base64.StdEncoding.EncodeToString(hash),
+		Parallelism:   s.Parallelism,
+		Source:        filePath,
+	}
+	if err := upload.uploadBlockBlobInBlocks(ctx); err != nil {
+		return fmt.Errorf("uploading %q to blob %q: %+v", name, blobName, err)
+	}
+
+	return nil
+}
+
+func (s BlobDirectorySync) parallelise(items []string, work func(string) error) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	workerCount := s.Parallelism
+	if workerCount < 1 {
+		workerCount = 1
+	}
+	if workerCount > len(items) {
+		workerCount = len(items)
+	}
+
+	queue := make(chan string, len(items))
+	for _, item := range items {
+		queue <- item
+	}
+	close(queue)
+
+	errs := make(chan error, len(items))
+	wg := &sync.WaitGroup{}
+	wg.Add(workerCount)
+	for i := 0; i < workerCount; i++ {
+		go func() {
+			defer wg.Done()
+			for item := range queue {
+				if err := work(item); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	messages := make([]string, 0)
+	for err := range errs {
+		messages = append(messages, err.Error())
+	}
+	if len(messages) > 0 {
+		sort.Strings(messages)
+		return fmt.Errorf("%d operation(s) failed: %s", len(messages), strings.Join(messages, "; "))
+	}
+
+	return nil
+}
+
+// blobDirectoryChanges returns the files which need to be uploaded since they're missing from, or differ to, the
+// remote blobs - and the remote blobs which are no longer present locally
+func blobDirectoryChanges(local, remote map[string]string) (upload []string, removed []string) {
+	upload = make([]string, 0)
+	for name, hash := range local {
+		if remoteHash, ok := remote[name]; !ok || !strings.EqualFold(remoteHash, hash) {
+			upload = append(upload, name)
+		}
+	}
+
+	removed = make([]string, 0)
+	for name := range remote {
+		if _, ok := local[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	sort.Strings(upload)
+	sort.Strings(removed)
+	return upload, removed
+}
+
+func blobDirectoryFileMD5(filePath string) ([]byte, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	hash := md5.New() // nolint: gosec
+	if _, err := io.Copy(hash, file); err != nil {
+		return nil, fmt.Errorf("hashing %q: %+v", filePath, err)
+	}
+
+	return hash.Sum(nil), nil
+}
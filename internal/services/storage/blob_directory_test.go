@@ -0,0 +1,314 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
+)
+
+func writeBlobDirectoryTestFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			t.Fatalf("creating directory for %q: %+v", name, err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+}
+
+func TestBuildBlobDirectoryManifest(t *testing.T) {
+	dir := t.TempDir()
+	writeBlobDirectoryTestFiles(t, dir, map[string]string{
+		"index.html":        "hello",
+		"css/site.css":      "",
+		"js/vendor/app.js":  "world",
+		"images/.gitignore": "hello",
+	})
+
+	actual, err := buildBlobDirectoryManifest(dir)
+	if err != nil {
+		t.Fatalf("building manifest: %+v", err)
+	}
+
+	expected := map[string]string{
+		"index.html":        "5d41402abc4b2a76b9719d911017c592",
+		"css/site.css":      "d41d8cd98f00b204e9800998ecf8427e",
+		"js/vendor/app.js":  "7d793037a0760186574b0282f2f435e7",
+		"images/.gitignore": "5d41402abc4b2a76b9719d911017c592",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	if _, err := buildBlobDirectoryManifest(filepath.Join(dir, "index.html")); err == nil {
+		t.Fatalf("expected an error when the source is a file but didn't get one")
+	}
+
+	if _, err := buildBlobDirectoryManifest(filepath.Join(dir, "missing")); err == nil {
+		t.Fatalf("expected an error when the source doesn't exist but didn't get one")
+	}
+}
+
+func TestBlobDirectoryManifestHash(t *testing.T) {
+	first := map[string]string{
+		"a.txt": "0cc175b9c0f1b6a831c399e269772661",
+		"b.txt": "92eb5ffee6ae2fec3ad71c777531578f",
+	}
+	second := map[string]string{
+		"b.txt": "92eb5ffee6ae2fec3ad71c777531578f",
+		"a.txt": "0cc175b9c0f1b6a831c399e269772661",
+	}
+	if blobDirectoryManifestHash(first) != blobDirectoryManifestHash(second) {
+		t.Fatalf("expected the hash to be independent of the ordering of the manifest")
+	}
+
+	modified := map[string]string{
+		"a.txt": "0cc175b9c0f1b6a831c399e269772661",
+		"b.txt": "4a8a08f09d37b73795649038408b5f33",
+	}
+	if blobDirectoryManifestHash(first) == blobDirectoryManifestHash(modified) {
+		t.Fatalf("expected the hash to change when a file is modified")
+	}
+
+	renamed := map[string]string{
+		"a.txt": "0cc175b9c0f1b6a831c399e269772661",
+		"c.txt": "92eb5ffee6ae2fec3ad71c777531578f",
+	}
+	if blobDirectoryManifestHash(first) == blobDirectoryManifestHash(renamed) {
+		t.Fatalf("expected the hash to change when a file is renamed")
+	}
+}
+
+func TestBlobDirectoryContentType(t *testing.T) {
+	syncer := BlobDirectorySync{
+		ContentTypes: map[string]string{
+			".wasm": "application/wasm",
+			"MD":    "text/markdown",
+		},
+	}
+
+	testCases := map[string]string{
+		"index.html":         "text/html; charset=utf-8",
+		"css/site.CSS":       "text/css; charset=utf-8",
+		"images/logo.png":    "image/png",
+		"app.wasm":           "application/wasm",
+		"docs/README.md":     "text/markdown",
+		"LICENSE":            "application/octet-stream",
+		"data.unknown-thing": "application/octet-stream",
+	}
+
+	for name, expected := range testCases {
+		if actual := syncer.ContentType(name); actual != expected {
+			t.Errorf("expected the content type for %q to be %q but got %q", name, expected, actual)
+		}
+	}
+}
+
+func TestBlobDirectoryBlobName(t *testing.T) {
+	if actual := (BlobDirectorySync{}).BlobName("css/site.css"); actual != "css/site.css" {
+		t.Fatalf("expected %q but got %q", "css/site.css", actual)
+	}
+
+	if actual := (BlobDirectorySync{Prefix: "static/v1"}).BlobName("css/site.css"); actual != "static/v1/css/site.css" {
+		t.Fatalf("expected %q but got %q", "static/v1/css/site.css", actual)
+	}
+}
+
+func TestBlobDirectoryChanges(t *testing.T) {
+	local := map[string]string{
+		"unchanged.txt": "0cc175b9c0f1b6a831c399e269772661",
+		"modified.txt":  "92eb5ffee6ae2fec3ad71c777531578f",
+		"added.txt":     "4a8a08f09d37b73795649038408b5f33",
+	}
+	remote := map[string]string{
+		"unchanged.txt": "0CC175B9C0F1B6A831C399E269772661",
+		"modified.txt":  "0cc175b9c0f1b6a831c399e269772661",
+		"removed.txt":   "0cc175b9c0f1b6a831c399e269772661",
+	}
+
+	upload, removed := blobDirectoryChanges(local, remote)
+	if expected := []string{"added.txt", "modified.txt"}; !reflect.DeepEqual(upload, expected) {
+		t.Fatalf("expected the files to upload to be %+v but got %+v", expected, upload)
+	}
+	if expected := []string{"removed.txt"}; !reflect.DeepEqual(removed, expected) {
+		t.Fatalf("expected the removed files to be %+v but got %+v", expected, removed)
+	}
+}
+
+func TestStorageBlobBlockSplit(t *testing.T) {
+	testCases := []struct {
+		fileSize int64
+		expected []int64
+	}{
+		{
+			fileSize: 0,
+			expected: []int64{},
+		},
+		{
+			fileSize: 1,
+			expected: []int64{1},
+		},
+		{
+			fileSize: maxBlockSize,
+			expected: []int64{maxBlockSize},
+		},
+		{
+			fileSize: 2*maxBlockSize + 10,
+			expected: []int64{maxBlockSize, maxBlockSize, 10},
+		},
+	}
+
+	for _, testCase := range testCases {
+		blocks := storageBlobBlockSplit(strings.NewReader(strings.Repeat("a", int(testCase.fileSize))), testCase.fileSize)
+
+		sizes := make([]int64, 0)
+		ids := make(map[string]struct{})
+		for _, block := range blocks {
+			sizes = append(sizes, block.section.Size())
+			if len(block.id) != len(blocks[0].id) {
+				t.Fatalf("expected every Block ID to be the same length but got %q and %q", blocks[0].id, block.id)
+			}
+			ids[block.id] = struct{}{}
+		}
+
+		if !reflect.DeepEqual(sizes, testCase.expected) {
+			t.Fatalf("expected blocks of %+v for a file of %d bytes but got %+v", testCase.expected, testCase.fileSize, sizes)
+		}
+		if len(ids) != len(blocks) {
+			t.Fatalf("expected every Block ID to be unique for a file of %d bytes", testCase.fileSize)
+		}
+	}
+}
+
+// TestBlobDirectorySyncAzurite runs a synchronisation against the Azurite storage emulator, which can be started using
+// `docker run -p 10000:10000 mcr.microsoft.com/azure-storage/azurite azurite-blob --blobHost 0.0.0.0`
+// and then running this test with `AZURITE_BLOB_ENDPOINT=http://127.0.0.1:10000/devstoreaccount1`
+func TestBlobDirectorySyncAzurite(t *testing.T) {
+	endpoint := os.Getenv("AZURITE_BLOB_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("`AZURITE_BLOB_ENDPOINT` isn't set, skipping")
+	}
+
+	// these are the well-known credentials for the storage emulator
+	accountName := "devstoreaccount1"
+	accountKey := "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	authorizer, err := auth.NewSharedKeyAuthorizer(accountName, accountKey, auth.SharedKey)
+	if err != nil {
+		t.Fatalf("building authorizer: %+v", err)
+	}
+
+	blobsClient, err := blobs.NewWithBaseUri(endpoint)
+	if err != nil {
+		t.Fatalf("building blobs client: %+v", err)
+	}
+	blobsClient.Client.SetAuthorizer(authorizer)
+
+	containersClient, err := containers.NewWithBaseUri(endpoint)
+	if err != nil {
+		t.Fatalf("building containers client: %+v", err)
+	}
+	containersClient.Client.SetAuthorizer(authorizer)
+	containersWrapper := shim.NewDataPlaneStorageContainerWrapper(containersClient)
+
+	containerName := fmt.Sprintf("acctest%d", time.Now().UnixNano())
+	if err := containersWrapper.Create(ctx, containerName, containers.CreateInput{}); err != nil {
+		t.Fatalf("creating container: %+v", err)
+	}
+	defer containersWrapper.Delete(context.Background(), containerName) // nolint: errcheck
+
+	dir := t.TempDir()
+	writeBlobDirectoryTestFiles(t, dir, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+		"empty.txt":    "",
+		// larger than a single block, so this is uploaded in blocks
+		"large.bin": strings.Repeat("0123456789abcdef", 600*1024),
+	})
+
+	syncer := BlobDirectorySync{
+		BlobsClient:      blobsClient,
+		ContainersClient: containersWrapper,
+		ContainerName:    containerName,
+		Prefix:           "static",
+		Source:           dir,
+		CacheControl:     "max-age=60",
+		Parallelism:      4,
+	}
+
+	sync := func() map[string]string {
+		local, err := buildBlobDirectoryManifest(dir)
+		if err != nil {
+			t.Fatalf("building manifest: %+v", err)
+		}
+
+		remote, err := syncer.ListRemote(ctx)
+		if err != nil {
+			t.Fatalf("listing blobs: %+v", err)
+		}
+
+		upload, removed := blobDirectoryChanges(local, remote)
+		if err := syncer.Upload(ctx, upload); err != nil {
+			t.Fatalf("uploading: %+v", err)
+		}
+		if err := syncer.Delete(ctx, removed); err != nil {
+			t.Fatalf("deleting: %+v", err)
+		}
+
+		remote, err = syncer.ListRemote(ctx)
+		if err != nil {
+			t.Fatalf("listing blobs: %+v", err)
+		}
+		if !reflect.DeepEqual(local, remote) {
+			t.Fatalf("expected the blobs to match the local files %+v but got %+v", local, remote)
+		}
+
+		return remote
+	}
+
+	sync()
+
+	props, err := blobsClient.GetProperties(ctx, containerName, "static/css/site.css", blobs.GetPropertiesInput{})
+	if err != nil {
+		t.Fatalf("retrieving blob properties: %+v", err)
+	}
+	if props.ContentType != "text/css; charset=utf-8" {
+		t.Fatalf("expected the content type to be %q but got %q", "text/css; charset=utf-8", props.ContentType)
+	}
+	if props.CacheControl != "max-age=60" {
+		t.Fatalf("expected the cache control to be %q but got %q", "max-age=60", props.CacheControl)
+	}
+
+	// modify, add and remove files
+	writeBlobDirectoryTestFiles(t, dir, map[string]string{
+		"index.html":  "<html><body></body></html>",
+		"js/site.js":  "console.log('hello')",
+		"js/other.js": "console.log('world')",
+	})
+	if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+		t.Fatalf("removing file: %+v", err)
+	}
+
+	remote := sync()
+	if _, ok := remote["css/site.css"]; ok {
+		t.Fatalf("expected the removed file to have been deleted")
+	}
+}
@@ -11,12 +11,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"runtime"
 	"strings"
 	"sync"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
 )
 
@@ -400,6 +403,190 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+type storageBlobBlock struct {
+	id      string
+	section *io.SectionReader
+}
+
+// uploadBlockBlobInBlocks uploads the source file as a series of blocks, `Parallelism` at a time, which are then
+// committed as a single block blob - which means at most `Parallelism` blocks are held in memory at once
+func (sbu BlobUpload) uploadBlockBlobInBlocks(ctx context.Context) error {
+	file, err := os.Open(sbu.Source)
+	if err != nil {
+		return fmt.Errorf("opening source file for upload %q: %s", sbu.Source, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("could not stat file %q: %s", file.Name(), err)
+	}
+
+	blockList := storageBlobBlockSplit(file, info.Size())
+	if err := sbu.blockUploadFromSource(ctx, blockList); err != nil {
+		return fmt.Errorf("uploading blocks: %s", err)
+	}
+
+	if err := sbu.putBlockList(ctx, blockList); err != nil {
+		return fmt.Errorf("PutBlockList: %s", err)
+	}
+
+	return nil
+}
+
+// putBlockList commits the blocks as the contents of the blob, this is sent directly rather than using the
+// `PutBlockList` method from Giovanni since that doesn't set the Content Type of the request, which means the block
+// list can't be marshalled as XML
+func (sbu BlobUpload) putBlockList(ctx context.Context, blockList []storageBlobBlock) error {
+	payload := blobs.BlockList{
+		LatestBlockIDs: make([]blobs.BlockID, 0, len(blockList)),
+	}
+	for _, block := range blockList {
+		payload.LatestBlockIDs = append(payload.LatestBlockIDs, blobs.BlockID{
+			Value: block.id,
+		})
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: blobPutBlockListOptions{upload: sbu},
+		Path:          fmt.Sprintf("/%s/%s", sbu.ContainerName, sbu.BlobName),
+	}
+
+	req, err := sbu.Client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+	if err := req.Marshal(&payload); err != nil {
+		return fmt.Errorf("marshalling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("executing request: %+v", err)
+	}
+	if resp != nil && resp.Response != nil {
+		resp.Body.Close()
+	}
+
+	return nil
+}
+
+type blobPutBlockListOptions struct {
+	upload BlobUpload
+}
+
+func (o blobPutBlockListOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	if o.upload.CacheControl != "" {
+		headers.Append("x-ms-blob-cache-control", o.upload.CacheControl)
+	}
+	if o.upload.ContentMD5 != "" {
+		headers.Append("x-ms-blob-content-md5", o.upload.ContentMD5)
+	}
+	if o.upload.ContentType != "" {
+		headers.Append("x-ms-blob-content-type", o.upload.ContentType)
+	}
+	if o.upload.EncryptionScope != "" {
+		headers.Append("x-ms-encryption-scope", o.upload.EncryptionScope)
+	}
+	for k, v := range o.upload.MetaData {
+		headers.Append(fmt.Sprintf("x-ms-meta-%s", k), v)
+	}
+	return headers
+}
+
+func (o blobPutBlockListOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o blobPutBlockListOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "blocklist")
+	return out
+}
+
+// maxBlockSize is the size of each block uploaded by uploadBlockBlobInBlocks, the final block may be smaller
+const maxBlockSize int64 = 4 * 1024 * 1024
+
+func storageBlobBlockSplit(file io.ReaderAt, fileSize int64) []storageBlobBlock {
+	blocks := make([]storageBlobBlock, 0, (fileSize+maxBlockSize-1)/maxBlockSize)
+	for offset := int64(0); offset < fileSize; offset += maxBlockSize {
+		length := maxBlockSize
+		if offset+length > fileSize {
+			length = fileSize - offset
+		}
+
+		// Block IDs must be base64 encoded and of the same length for every block within the blob
+		blocks = append(blocks, storageBlobBlock{
+			id:      base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%016d", offset))),
+			section: io.NewSectionReader(file, offset, length),
+		})
+	}
+
+	return blocks
+}
+
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, blockList []storageBlobBlock) error {
+	workerCount := sbu.Parallelism
+	if workerCount < 1 {
+		workerCount = 1
+	}
+
+	blocks := make(chan storageBlobBlock, len(blockList))
+	errs := make(chan error, len(blockList))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(blockList))
+
+	for _, block := range blockList {
+		blocks <- block
+	}
+	close(blocks)
+
+	for i := 0; i < workerCount; i++ {
+		go sbu.blobBlockUploadWorker(ctx, blocks, errs, wg)
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return fmt.Errorf("while uploading source file %q: %s", sbu.Source, <-errs)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, blocks chan storageBlobBlock, errs chan error, wg *sync.WaitGroup) {
+	for block := range blocks {
+		chunk := make([]byte, block.section.Size())
+		if _, err := io.ReadFull(block.section, chunk); err != nil {
+			errs <- fmt.Errorf("reading source file %q: %s", sbu.Source, err)
+			wg.Done()
+			continue
+		}
+
+		input := blobs.PutBlockInput{
+			BlockID: block.id,
+			Content: chunk,
+		}
+		if sbu.EncryptionScope != "" {
+			input.EncryptionScope = pointer.To(sbu.EncryptionScope)
+		}
+
+		if _, err := sbu.Client.PutBlock(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
+			errs <- fmt.Errorf("writing block %q for file %q: %s", block.id, sbu.Source, err)
+			wg.Done()
+			continue
+		}
+
+		wg.Done()
+	}
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
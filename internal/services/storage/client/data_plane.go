@@ -18,6 +18,7 @@ import (
 	"github.com/jackofallops/giovanni/storage/2023-11-03/file/directories"
 	"github.com/jackofallops/giovanni/storage/2023-11-03/file/files"
 	"github.com/jackofallops/giovanni/storage/2023-11-03/file/shares"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/queue/messages"
 	"github.com/jackofallops/giovanni/storage/2023-11-03/queue/queues"
 	"github.com/jackofallops/giovanni/storage/2023-11-03/table/entities"
 	"github.com/jackofallops/giovanni/storage/2023-11-03/table/tables"
@@ -250,6 +251,28 @@ func (c Client) FileSharesDataPlaneClient(ctx context.Context, account AccountDe
 	return shim.NewDataPlaneStorageShareWrapper(apiClient), nil
 }
 
+func (c Client) QueueMessagesDataPlaneClient(ctx context.Context, account AccountDetails, operation DataPlaneOperation) (*messages.Client, error) {
+	const clientName = "Storage Queue Messages"
+	operation.sharedKeyAuthenticationType = auth.SharedKey
+
+	baseUri, err := account.DataPlaneEndpoint(EndpointTypeQueue)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, err := messages.NewWithBaseUri(*baseUri)
+	if err != nil {
+		return nil, fmt.Errorf("building %s client: %+v", clientName, err)
+	}
+
+	err = c.configureDataPlane(ctx, clientName, *baseUri, apiClient.Client, account, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiClient, nil
+}
+
 func (c Client) QueuesDataPlaneClient(ctx context.Context, account AccountDetails, operation DataPlaneOperation) (shim.StorageQueuesWrapper, error) {
 	const clientName = "File Storage Queue Queues"
 	operation.sharedKeyAuthenticationType = auth.SharedKey
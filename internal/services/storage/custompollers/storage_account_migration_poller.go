@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-06-01/storageaccountmigrations"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+var _ pollers.PollerType = &storageAccountMigrationPoller{}
+
+type storageAccountMigrationPoller struct {
+	client *storageaccountmigrations.StorageAccountMigrationsClient
+	id     commonids.StorageAccountId
+}
+
+// The long-running operation returned from `startAccountMigration` completes once the migration has been accepted,
+// rather than once the account has been converted, so the status of the migration itself needs to be polled
+func NewStorageAccountMigrationPoller(client *storageaccountmigrations.StorageAccountMigrationsClient, id commonids.StorageAccountId) *storageAccountMigrationPoller {
+	return &storageAccountMigrationPoller{
+		client: client,
+		id:     id,
+	}
+}
+
+func (p storageAccountMigrationPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	resp, err := p.client.StorageAccountsGetCustomerInitiatedMigration(ctx, p.id)
+	if err != nil {
+		if resp.HttpResponse == nil {
+			return nil, pollers.PollingDroppedConnectionError{
+				Message: err.Error(),
+			}
+		}
+		return nil, fmt.Errorf("retrieving the migration status for %s: %+v", p.id, err)
+	}
+
+	status := ""
+	failureReason := ""
+	if model := resp.Model; model != nil {
+		status = string(pointer.From(model.Properties.MigrationStatus))
+		failureReason = pointer.From(model.Properties.MigrationFailedReason)
+		if detailedReason := pointer.From(model.Properties.MigrationFailedDetailedReason); detailedReason != "" {
+			failureReason = fmt.Sprintf("%s: %s", failureReason, detailedReason)
+		}
+	}
+
+	switch storageaccountmigrations.MigrationStatus(status) {
+	case storageaccountmigrations.MigrationStatusComplete:
+		return &pollers.PollResult{
+			HttpResponse: &client.Response{
+				Response: resp.HttpResponse,
+			},
+			PollInterval: time.Minute,
+			Status:       pollers.PollingStatusSucceeded,
+		}, nil
+
+	case storageaccountmigrations.MigrationStatusInProgress, storageaccountmigrations.MigrationStatusSubmittedForConversion:
+		return &pollers.PollResult{
+			HttpResponse: &client.Response{
+				Response: resp.HttpResponse,
+			},
+			PollInterval: time.Minute,
+			Status:       pollers.PollingStatusInProgress,
+		}, nil
+	}
+
+	return nil, pollers.PollingFailedError{
+		HttpResponse: &client.Response{
+			Response: resp.HttpResponse,
+		},
+		Message: fmt.Sprintf("migration of %s finished with status %q: %s", p.id, status, failureReason),
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = StorageBlobDirectoryId{}
+
+// StorageBlobDirectoryId is used by azurerm_storage_blob_directory, which manages the blobs beneath a prefix in a container
+type StorageBlobDirectoryId struct {
+	AccountName   string
+	DomainSuffix  string
+	ContainerName string
+	Prefix        string
+}
+
+func NewStorageBlobDirectoryID(accountName, domainSuffix, containerName, prefix string) StorageBlobDirectoryId {
+	return StorageBlobDirectoryId{
+		AccountName:   accountName,
+		DomainSuffix:  domainSuffix,
+		ContainerName: containerName,
+		Prefix:        prefix,
+	}
+}
+
+func (id StorageBlobDirectoryId) String() string {
+	components := []string{
+		fmt.Sprintf("Account Name %q", id.AccountName),
+		fmt.Sprintf("Domain Suffix %q", id.DomainSuffix),
+		fmt.Sprintf("Container Name %q", id.ContainerName),
+		fmt.Sprintf("Prefix %q", id.Prefix),
+	}
+	return fmt.Sprintf("Storage Blob Directory %s", strings.Join(components, " / "))
+}
+
+// ID returns the URI of the prefix within the container, the container URI with a trailing slash is used when
+// the blobs are uploaded to the root of the container
+func (id StorageBlobDirectoryId) ID() string {
+	return fmt.Sprintf("https://%s.blob.%s/%s/%s", id.AccountName, id.DomainSuffix, id.ContainerName, id.Prefix)
+}
+
+// StorageBlobDirectoryID parses a Storage Blob Directory ID into a StorageBlobDirectoryId struct
+func StorageBlobDirectoryID(input string) (*StorageBlobDirectoryId, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a URI: %+v", input, err)
+	}
+
+	if uri.Scheme != "https" || uri.Host == "" {
+		return nil, fmt.Errorf("expected %q to be a https URI", input)
+	}
+
+	hostSegments := strings.SplitN(uri.Host, ".", 3)
+	if len(hostSegments) != 3 || hostSegments[0] == "" || hostSegments[1] != "blob" || hostSegments[2] == "" {
+		return nil, fmt.Errorf("expected the host of %q to be in the format `{accountName}.blob.{domainSuffix}`", input)
+	}
+
+	pathSegments := strings.SplitN(strings.TrimPrefix(uri.Path, "/"), "/", 2)
+	if len(pathSegments) != 2 || pathSegments[0] == "" {
+		return nil, fmt.Errorf("expected the path of %q to be in the format `/{containerName}/{prefix}`", input)
+	}
+
+	return &StorageBlobDirectoryId{
+		AccountName:   hostSegments[0],
+		DomainSuffix:  hostSegments[2],
+		ContainerName: pathSegments[0],
+		Prefix:        pathSegments[1],
+	}, nil
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestStorageBlobDirectoryIDFormatter(t *testing.T) {
+	actual := NewStorageBlobDirectoryID("account1", "core.windows.net", "container1", "assets/images").ID()
+	expected := "https://account1.blob.core.windows.net/container1/assets/images"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+
+	actual = NewStorageBlobDirectoryID("account1", "core.windows.net", "container1", "").ID()
+	expected = "https://account1.blob.core.windows.net/container1/"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStorageBlobDirectoryID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *StorageBlobDirectoryId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// resource manager id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1",
+			Error: true,
+		},
+
+		{
+			// not the blob endpoint
+			Input: "https://account1.queue.core.windows.net/container1/assets",
+			Error: true,
+		},
+
+		{
+			// missing container
+			Input: "https://account1.blob.core.windows.net/",
+			Error: true,
+		},
+
+		{
+			// container without the trailing slash
+			Input: "https://account1.blob.core.windows.net/container1",
+			Error: true,
+		},
+
+		{
+			// valid, root of the container
+			Input: "https://account1.blob.core.windows.net/container1/",
+			Expected: &StorageBlobDirectoryId{
+				AccountName:   "account1",
+				DomainSuffix:  "core.windows.net",
+				ContainerName: "container1",
+				Prefix:        "",
+			},
+		},
+
+		{
+			// valid
+			Input: "https://account1.blob.core.windows.net/container1/assets/images",
+			Expected: &StorageBlobDirectoryId{
+				AccountName:   "account1",
+				DomainSuffix:  "core.windows.net",
+				ContainerName: "container1",
+				Prefix:        "assets/images",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := StorageBlobDirectoryID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.AccountName != v.Expected.AccountName {
+			t.Fatalf("Expected %q but got %q for AccountName", v.Expected.AccountName, actual.AccountName)
+		}
+		if actual.DomainSuffix != v.Expected.DomainSuffix {
+			t.Fatalf("Expected %q but got %q for DomainSuffix", v.Expected.DomainSuffix, actual.DomainSuffix)
+		}
+		if actual.ContainerName != v.Expected.ContainerName {
+			t.Fatalf("Expected %q but got %q for ContainerName", v.Expected.ContainerName, actual.ContainerName)
+		}
+		if actual.Prefix != v.Expected.Prefix {
+			t.Fatalf("Expected %q but got %q for Prefix", v.Expected.Prefix, actual.Prefix)
+		}
+	}
+}
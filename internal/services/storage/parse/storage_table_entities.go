@@ -7,6 +7,7 @@ import (
 	"crypto/sha1" // nolint: gosec only used for hashing and the API expects sha1
 	"encoding/hex"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
@@ -16,7 +17,8 @@ import (
 // TODO: tests for this
 var _ resourceids.Id = StorageTableEntitiesId{}
 
-// StorageTableEntitiesId is used by the plural data source azurerm_storage_table_entities
+// StorageTableEntitiesId is used by the plural data source azurerm_storage_table_entities and the resource
+// azurerm_storage_table_entities - where the resource manages all entities within the table, so the Filter is empty
 type StorageTableEntitiesId struct {
 	AccountName  string
 	DomainSuffix string
@@ -49,3 +51,33 @@ func NewStorageTableEntitiesId(accountName, domainSuffix, tableName, filter stri
 		Filter:       filterHash,
 	}
 }
+
+// StorageTableEntitiesID parses the ID of the resource azurerm_storage_table_entities, which is in the
+// format `https://{accountName}.table.{domainSuffix}/{tableName}()`
+func StorageTableEntitiesID(input string) (*StorageTableEntitiesId, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a URI: %+v", input, err)
+	}
+
+	if uri.Scheme != "https" || uri.Host == "" {
+		return nil, fmt.Errorf("expected %q to be a https URI", input)
+	}
+
+	hostSegments := strings.SplitN(uri.Host, ".", 3)
+	if len(hostSegments) != 3 || hostSegments[0] == "" || hostSegments[1] != "table" || hostSegments[2] == "" {
+		return nil, fmt.Errorf("expected the host of %q to be in the format `{accountName}.table.{domainSuffix}`", input)
+	}
+
+	path := strings.TrimPrefix(uri.Path, "/")
+	tableName := strings.TrimSuffix(path, "()")
+	if !strings.HasSuffix(path, "()") || tableName == "" || strings.ContainsAny(tableName, "/()'") {
+		return nil, fmt.Errorf("expected the path of %q to be in the format `/{tableName}()`", input)
+	}
+
+	return &StorageTableEntitiesId{
+		AccountName:  hostSegments[0],
+		DomainSuffix: hostSegments[2],
+		TableName:    tableName,
+	}, nil
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestStorageTableEntitiesIDFormatter(t *testing.T) {
+	actual := StorageTableEntitiesId{
+		AccountName:  "account1",
+		DomainSuffix: "core.windows.net",
+		TableName:    "table1",
+	}.ID()
+	expected := "https://account1.table.core.windows.net/table1()"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStorageTableEntitiesID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *StorageTableEntitiesId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// resource manager id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1/tableServices/default/tables/table1",
+			Error: true,
+		},
+
+		{
+			// not the table endpoint
+			Input: "https://account1.blob.core.windows.net/table1()",
+			Error: true,
+		},
+
+		{
+			// table data plane id
+			Input: "https://account1.table.core.windows.net/Tables('table1')",
+			Error: true,
+		},
+
+		{
+			// entity id
+			Input: "https://account1.table.core.windows.net/table1(PartitionKey='partition1',RowKey='row1')",
+			Error: true,
+		},
+
+		{
+			// missing brackets
+			Input: "https://account1.table.core.windows.net/table1",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "https://account1.table.core.windows.net/table1()",
+			Expected: &StorageTableEntitiesId{
+				AccountName:  "account1",
+				DomainSuffix: "core.windows.net",
+				TableName:    "table1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := StorageTableEntitiesID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.AccountName != v.Expected.AccountName {
+			t.Fatalf("Expected %q but got %q for AccountName", v.Expected.AccountName, actual.AccountName)
+		}
+		if actual.DomainSuffix != v.Expected.DomainSuffix {
+			t.Fatalf("Expected %q but got %q for DomainSuffix", v.Expected.DomainSuffix, actual.DomainSuffix)
+		}
+		if actual.TableName != v.Expected.TableName {
+			t.Fatalf("Expected %q but got %q for TableName", v.Expected.TableName, actual.TableName)
+		}
+	}
+}
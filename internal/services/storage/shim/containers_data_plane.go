@@ -69,6 +69,19 @@ func (w DataPlaneStorageContainerWrapper) Get(ctx context.Context, containerName
 	}, nil
 }
 
+func (w DataPlaneStorageContainerWrapper) ListBlobs(ctx context.Context, containerName string, input containers.ListBlobsInput) (*containers.ListBlobsResult, error) {
+	resp, err := w.client.ListBlobs(ctx, containerName, input)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &resp.ListBlobsResult, nil
+}
+
 func (w DataPlaneStorageContainerWrapper) UpdateAccessLevel(ctx context.Context, containerName string, level containers.AccessLevel) error {
 	input := containers.SetAccessControlInput{
 		AccessLevel: level,
@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-06-01/storageaccounts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type StorageAccountFailoverAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &StorageAccountFailoverAction{}
+
+func newStorageAccountFailoverAction() action.Action {
+	return &StorageAccountFailoverAction{}
+}
+
+type StorageAccountFailoverActionModel struct {
+	StorageAccountId types.String `tfsdk:"storage_account_id"`
+	FailoverType     types.String `tfsdk:"failover_type"`
+	Timeout          types.String `tfsdk:"timeout"`
+}
+
+const storageAccountFailoverTypeUnplanned = "Unplanned"
+
+func (s *StorageAccountFailoverAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"storage_account_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the geo-redundant Storage Account which should be failed over to its secondary region.",
+				MarkdownDescription: "The ID of the geo-redundant Storage Account which should be failed over to its secondary region.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateStorageAccountID,
+					},
+				},
+			},
+
+			"failover_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The type of failover to perform. Possible values are `Planned` and `Unplanned`. Defaults to `Planned`.",
+				MarkdownDescription: "The type of failover to perform. Possible values are `Planned` and `Unplanned`. Defaults to `Planned`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(storageaccounts.FailoverTypePlanned),
+						storageAccountFailoverTypeUnplanned,
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `2h`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `2h`.",
+			},
+		},
+	}
+}
+
+func (s *StorageAccountFailoverAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_storage_account_failover"
+}
+
+func (s *StorageAccountFailoverAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := s.Client.Storage.ResourceManager.StorageAccounts
+
+	model := StorageAccountFailoverActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 2 * time.Hour
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := commonids.ParseStorageAccountID(model.StorageAccountId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	planned := model.FailoverType.IsNull() || model.FailoverType.ValueString() == string(storageaccounts.FailoverTypePlanned)
+
+	existing, err := client.GetProperties(ctx, *id, storageaccounts.GetPropertiesOperationOptions{
+		Expand: pointer.To(storageaccounts.StorageAccountExpandGeoReplicationStats),
+	})
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "retrieving storage account", fmt.Sprintf("retrieving %s: %+v", id, err))
+		return
+	}
+
+	if account := existing.Model; account != nil && account.Properties != nil {
+		if pointer.From(account.Properties.FailoverInProgress) {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("a failover is already in progress for %s", id))
+			return
+		}
+
+		if stats := account.Properties.GeoReplicationStats; stats != nil {
+			if planned && !pointer.From(stats.CanPlannedFailover) {
+				sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("%s can't currently be failed over using a Planned failover, the account must be geo-redundant and the secondary region must be in sync", id))
+				return
+			}
+			if !planned && !pointer.From(stats.CanFailover) {
+				sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("%s can't currently be failed over, the account must be geo-redundant", id))
+				return
+			}
+		}
+	}
+
+	options := storageaccounts.DefaultFailoverOperationOptions()
+	if planned {
+		options.FailoverType = pointer.To(storageaccounts.FailoverTypePlanned)
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("failing over %s", id),
+	})
+
+	if err := client.FailoverThenPoll(ctx, *id, options); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("failing over %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("failover of %s completed", id),
+	})
+}
+
+func (s *StorageAccountFailoverAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	s.Defaults(ctx, request, response)
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type StorageAccountFailoverAction struct{}
+
+func TestAccStorageAccountFailoverAction_planned(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_failover", "test")
+	a := StorageAccountFailoverAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.planned(data),
+			},
+		},
+	})
+}
+
+func (a *StorageAccountFailoverAction) planned(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_storage_account_failover" "test" {
+  config {
+    storage_account_id = azurerm_storage_account.test.id
+    failover_type      = "Planned"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_storage_account.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_storage_account_failover.test]
+    }
+  }
+}
+`, StorageAccountResource{}.replicationType(data, "GRS"))
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-06-01/storageaccountmigrations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-06-01/storageaccounts"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/custompollers"
+)
+
+// storageAccountGeoRedundancyForReplicationType returns the geo-redundancy portion of the replication type
+// (e.g. `RAG` for `RAGZRS`), which is empty for replication types which are only replicated within a region
+func storageAccountGeoRedundancyForReplicationType(replicationType string) string {
+	replicationType = strings.ToUpper(replicationType)
+	if storageAccountReplicationTypeIsZoneRedundant(replicationType) {
+		return strings.TrimSuffix(replicationType, "ZRS")
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(replicationType, "RS"), "L")
+}
+
+func storageAccountReplicationTypeIsZoneRedundant(replicationType string) bool {
+	return strings.HasSuffix(strings.ToUpper(replicationType), "ZRS")
+}
+
+// storageAccountSupportsZoneMigration returns whether the zone redundancy of a Storage Account with the specified kind
+// and tier can be changed using a customer-initiated migration, the zone redundancy of other accounts (such as legacy
+// `Storage` or `BlobStorage` accounts, or Premium page blob accounts) can only be changed by recreating the account
+func storageAccountSupportsZoneMigration(accountKind, accountTier string) bool {
+	switch storageaccounts.Kind(accountKind) {
+	case storageaccounts.KindStorageVTwo:
+		return accountTier == string(storageaccounts.SkuTierStandard)
+	case storageaccounts.KindBlockBlobStorage, storageaccounts.KindFileStorage:
+		return accountTier == string(storageaccounts.SkuTierPremium)
+	}
+
+	return false
+}
+
+// storageAccountZoneMigrationTargetSku returns the SKU which the Storage Account needs to be migrated to in order to
+// change the zone redundancy from the old to the new replication type - or nil when the zone redundancy is unchanged.
+//
+// The zone redundancy and geo redundancy of an account can't be changed at the same time, as such the migration only
+// changes the zone redundancy (e.g. `LRS` -> `GZRS` is migrated to `ZRS`), the geo redundancy is then updated separately.
+func storageAccountZoneMigrationTargetSku(accountTier, provisionedBillingModelVersion, oldReplicationType, newReplicationType string) *storageaccounts.SkuName {
+	zoneRedundant := storageAccountReplicationTypeIsZoneRedundant(newReplicationType)
+	if storageAccountReplicationTypeIsZoneRedundant(oldReplicationType) == zoneRedundant {
+		return nil
+	}
+
+	geoRedundancy := storageAccountGeoRedundancyForReplicationType(oldReplicationType)
+	replicationType := geoRedundancy + "RS"
+	switch {
+	case zoneRedundant:
+		replicationType = geoRedundancy + "ZRS"
+	case geoRedundancy == "":
+		replicationType = "LRS"
+	}
+
+	return pointer.To(storageaccounts.SkuName(fmt.Sprintf("%s%s_%s", accountTier, provisionedBillingModelVersion, replicationType)))
+}
+
+// migrateStorageAccountSku performs a customer-initiated migration of the Storage Account to the specified SKU and
+// waits for it to complete, if a migration to this SKU is already in progress (e.g. from a previous apply which timed out)
+// then this waits for that migration to complete instead
+func migrateStorageAccountSku(ctx context.Context, client *storageaccounts.StorageAccountsClient, migrationsClient *storageaccountmigrations.StorageAccountMigrationsClient, id commonids.StorageAccountId, targetSku storageaccounts.SkuName) error {
+	existing, err := migrationsClient.StorageAccountsGetCustomerInitiatedMigration(ctx, id)
+	if err != nil && !response.WasNotFound(existing.HttpResponse) {
+		return fmt.Errorf("retrieving the existing migration for %s: %+v", id, err)
+	}
+
+	inProgress := false
+	if model := existing.Model; model != nil && strings.EqualFold(string(model.Properties.TargetSkuName), string(targetSku)) {
+		switch pointer.From(model.Properties.MigrationStatus) {
+		case storageaccountmigrations.MigrationStatusInProgress, storageaccountmigrations.MigrationStatusSubmittedForConversion:
+			inProgress = true
+		}
+	}
+
+	if inProgress {
+		log.Printf("[DEBUG] A migration of %s to %q is already in progress", id, targetSku)
+	} else {
+		log.Printf("[DEBUG] Migrating %s to %q", id, targetSku)
+		payload := storageaccounts.StorageAccountMigration{
+			Properties: storageaccounts.StorageAccountMigrationProperties{
+				TargetSkuName: targetSku,
+			},
+		}
+		if err := client.CustomerInitiatedMigrationThenPoll(ctx, id, payload); err != nil {
+			return fmt.Errorf("starting the migration of %s to %q: %+v", id, targetSku, err)
+		}
+	}
+
+	pollerType := custompollers.NewStorageAccountMigrationPoller(migrationsClient, id)
+	poller := pollers.NewPoller(pollerType, time.Minute, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("waiting for the migration of %s to %q to complete: %+v", id, targetSku, err)
+	}
+
+	return nil
+}
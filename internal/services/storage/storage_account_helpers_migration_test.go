@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestStorageAccountZoneMigrationTargetSku(t *testing.T) {
+	testcases := []struct {
+		AccountTier                    string
+		ProvisionedBillingModelVersion string
+		Old                            string
+		New                            string
+		Expected                       string
+	}{
+		{
+			AccountTier: "Standard",
+			Old:         "LRS",
+			New:         "GRS",
+			Expected:    "",
+		},
+		{
+			AccountTier: "Standard",
+			Old:         "GZRS",
+			New:         "RAGZRS",
+			Expected:    "",
+		},
+		{
+			AccountTier: "Standard",
+			Old:         "LRS",
+			New:         "ZRS",
+			Expected:    "Standard_ZRS",
+		},
+		{
+			AccountTier: "Standard",
+			Old:         "ZRS",
+			New:         "LRS",
+			Expected:    "Standard_LRS",
+		},
+		{
+			AccountTier: "Standard",
+			Old:         "GRS",
+			New:         "GZRS",
+			Expected:    "Standard_GZRS",
+		},
+		{
+			AccountTier: "Standard",
+			Old:         "RAGZRS",
+			New:         "RAGRS",
+			Expected:    "Standard_RAGRS",
+		},
+		{
+			// the geo redundancy is changed separately once the zone redundancy has been migrated
+			AccountTier: "Standard",
+			Old:         "LRS",
+			New:         "RAGZRS",
+			Expected:    "Standard_ZRS",
+		},
+		{
+			AccountTier: "Standard",
+			Old:         "GZRS",
+			New:         "LRS",
+			Expected:    "Standard_GRS",
+		},
+		{
+			AccountTier: "Premium",
+			Old:         "LRS",
+			New:         "ZRS",
+			Expected:    "Premium_ZRS",
+		},
+		{
+			AccountTier:                    "Premium",
+			ProvisionedBillingModelVersion: "V2",
+			Old:                            "ZRS",
+			New:                            "LRS",
+			Expected:                       "PremiumV2_LRS",
+		},
+	}
+
+	for _, tc := range testcases {
+		actual := pointer.From(storageAccountZoneMigrationTargetSku(tc.AccountTier, tc.ProvisionedBillingModelVersion, tc.Old, tc.New))
+		if string(actual) != tc.Expected {
+			t.Errorf("expected the migration from %q to %q to target %q but got %q", tc.Old, tc.New, tc.Expected, actual)
+		}
+	}
+}
+
+func TestStorageAccountSupportsZoneMigration(t *testing.T) {
+	testcases := []struct {
+		AccountKind string
+		AccountTier string
+		Expected    bool
+	}{
+		{
+			AccountKind: "StorageV2",
+			AccountTier: "Standard",
+			Expected:    true,
+		},
+		{
+			// Premium page blobs
+			AccountKind: "StorageV2",
+			AccountTier: "Premium",
+			Expected:    false,
+		},
+		{
+			AccountKind: "BlockBlobStorage",
+			AccountTier: "Premium",
+			Expected:    true,
+		},
+		{
+			AccountKind: "FileStorage",
+			AccountTier: "Premium",
+			Expected:    true,
+		},
+		{
+			AccountKind: "Storage",
+			AccountTier: "Standard",
+			Expected:    false,
+		},
+		{
+			AccountKind: "BlobStorage",
+			AccountTier: "Standard",
+			Expected:    false,
+		},
+	}
+
+	for _, tc := range testcases {
+		if actual := storageAccountSupportsZoneMigration(tc.AccountKind, tc.AccountTier); actual != tc.Expected {
+			t.Errorf("expected zone migration support for a %q account with tier %q to be %t but got %t", tc.AccountKind, tc.AccountTier, tc.Expected, actual)
+		}
+	}
+}
@@ -1180,20 +1180,19 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				}
 				return nil
 			}),
-			pluginsdk.ForceNewIfChange("account_replication_type", func(ctx context.Context, old, new, meta interface{}) bool {
-				newAccRep := strings.ToUpper(new.(string))
+			pluginsdk.ForceNewIf("account_replication_type", func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) bool {
+				if d.Id() == "" {
+					return false
+				}
 
-				switch strings.ToUpper(old.(string)) {
-				case "LRS", "GRS", "RAGRS":
-					if newAccRep == "GZRS" || newAccRep == "RAGZRS" || newAccRep == "ZRS" {
-						return true
-					}
-				case "ZRS", "GZRS", "RAGZRS":
-					if newAccRep == "LRS" || newAccRep == "GRS" || newAccRep == "RAGRS" {
-						return true
-					}
+				oldReplicationType, newReplicationType := d.GetChange("account_replication_type")
+				if storageAccountReplicationTypeIsZoneRedundant(oldReplicationType.(string)) == storageAccountReplicationTypeIsZoneRedundant(newReplicationType.(string)) {
+					return false
 				}
-				return false
+
+				// the account kind is upgraded after the zone redundancy is migrated, so the existing account kind is used
+				oldAccountKind, _ := d.GetChange("account_kind")
+				return !storageAccountSupportsZoneMigration(oldAccountKind.(string), d.Get("account_tier").(string))
 			}),
 		),
 	}
@@ -1741,6 +1740,16 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("an `account_replication_type` of `ZRS` isn't supported for Blob Storage accounts")
 	}
 
+	// changing the zone redundancy of the account requires a migration, any change to the geo redundancy is then made when updating the SKU below
+	if d.HasChange("account_replication_type") {
+		oldReplicationType, _ := d.GetChange("account_replication_type")
+		if targetSku := storageAccountZoneMigrationTargetSku(string(accountTier), provisionedBillingModelVersion, oldReplicationType.(string), replicationType); targetSku != nil {
+			if err := migrateStorageAccountSku(ctx, client, storageClient.StorageAccountMigrations, *id, *targetSku); err != nil {
+				return err
+			}
+		}
+	}
+
 	existing, err := client.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -0,0 +1,394 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageBlobDirectoryResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = StorageBlobDirectoryResource{}
+	_ sdk.ResourceWithCustomizeDiff = StorageBlobDirectoryResource{}
+)
+
+type StorageBlobDirectoryResourceModel struct {
+	StorageContainerId string            `tfschema:"storage_container_id"`
+	Source             string            `tfschema:"source"`
+	Prefix             string            `tfschema:"prefix"`
+	CacheControl       string            `tfschema:"cache_control"`
+	ContentTypes       map[string]string `tfschema:"content_types"`
+	DeleteRemovedBlobs bool              `tfschema:"delete_removed_blobs"`
+	Parallelism        int64             `tfschema:"parallelism"`
+	Files              map[string]string `tfschema:"files"`
+	ManifestHash       string            `tfschema:"manifest_hash"`
+}
+
+func (r StorageBlobDirectoryResource) ResourceType() string {
+	return "azurerm_storage_blob_directory"
+}
+
+func (r StorageBlobDirectoryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageBlobDirectoryID
+}
+
+func (r StorageBlobDirectoryResource) ModelObject() interface{} {
+	return &StorageBlobDirectoryResourceModel{}
+}
+
+func (r StorageBlobDirectoryResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageContainerID,
+		},
+
+		"source": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"prefix": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[^/](.*[^/])?$`),
+				"`prefix` must not start or end with a `/`",
+			),
+		},
+
+		"cache_control": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"content_types": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"delete_removed_blobs": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"parallelism": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      8,
+			ValidateFunc: validation.IntBetween(1, 64),
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"files": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"manifest_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			// the source directory may not exist until apply time, e.g. when it's built by another resource
+			if !diff.NewValueKnown("source") {
+				if err := diff.SetNewComputed("files"); err != nil {
+					return err
+				}
+				return diff.SetNewComputed("manifest_hash")
+			}
+
+			manifest, err := buildBlobDirectoryManifest(diff.Get("source").(string))
+			if err != nil {
+				return err
+			}
+
+			existing := make(map[string]string)
+			for k, v := range diff.Get("files").(map[string]interface{}) {
+				existing[k] = v.(string)
+			}
+
+			if diff.Id() != "" && blobDirectoryManifestHash(existing) == blobDirectoryManifestHash(manifest) {
+				return nil
+			}
+
+			if err := diff.SetNew("files", manifest); err != nil {
+				return fmt.Errorf("setting `files`: %+v", err)
+			}
+			return diff.SetNew("manifest_hash", blobDirectoryManifestHash(manifest))
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model StorageBlobDirectoryResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerId, err := commonids.ParseStorageContainerID(model.StorageContainerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStorageBlobDirectoryID(containerId.StorageAccountName, storageClient.StorageDomainSuffix, containerId.ContainerName, model.Prefix)
+
+			syncer, err := r.syncer(ctx, metadata, id, model)
+			if err != nil {
+				return err
+			}
+
+			local, err := buildBlobDirectoryManifest(model.Source)
+			if err != nil {
+				return err
+			}
+
+			remote, err := syncer.ListRemote(ctx)
+			if err != nil {
+				return fmt.Errorf("retrieving the existing blobs for %s: %+v", id, err)
+			}
+			if remote == nil {
+				return fmt.Errorf("the container %q for %s was not found", containerId.ContainerName, id)
+			}
+
+			// blobs which already exist with the same contents aren't uploaded again
+			upload, removed := blobDirectoryChanges(local, remote)
+			if err := syncer.Upload(ctx, upload); err != nil {
+				return fmt.Errorf("uploading %s: %+v", id, err)
+			}
+
+			if model.DeleteRemovedBlobs {
+				if err := syncer.Delete(ctx, removed); err != nil {
+					return fmt.Errorf("deleting the removed blobs for %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := parse.StorageBlobDirectoryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state StorageBlobDirectoryResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountName, id, err)
+			}
+			if account == nil {
+				metadata.Logger.Infof("Unable to locate Storage Account %q for %s - assuming removed & removing from state", id.AccountName, id)
+				return metadata.MarkAsGone(id)
+			}
+
+			syncer, err := r.syncer(ctx, metadata, *id, state)
+			if err != nil {
+				return err
+			}
+
+			remote, err := syncer.ListRemote(ctx)
+			if err != nil {
+				return fmt.Errorf("retrieving the blobs for %s: %+v", id, err)
+			}
+			if remote == nil {
+				metadata.Logger.Infof("Container %q for %s was not found - assuming removed & removing from state", id.ContainerName, id)
+				return metadata.MarkAsGone(id)
+			}
+
+			state.StorageContainerId = commonids.NewStorageContainerID(account.StorageAccountId.SubscriptionId, account.StorageAccountId.ResourceGroupName, id.AccountName, id.ContainerName).ID()
+			state.Prefix = id.Prefix
+
+			// only the blobs managed by this resource are tracked, unless the blobs which aren't present in the source
+			// directory are removed - or this resource is being imported, in which case all blobs beneath the prefix are
+			files := make(map[string]string)
+			for name, hash := range remote {
+				if _, managed := state.Files[name]; managed || state.DeleteRemovedBlobs || state.Source == "" {
+					files[name] = hash
+				}
+			}
+			state.Files = files
+			state.ManifestHash = blobDirectoryManifestHash(files)
+
+			if state.Parallelism == 0 {
+				state.Parallelism = 8
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageBlobDirectoryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageBlobDirectoryResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			syncer, err := r.syncer(ctx, metadata, *id, model)
+			if err != nil {
+				return err
+			}
+
+			local, err := buildBlobDirectoryManifest(model.Source)
+			if err != nil {
+				return err
+			}
+
+			remote, err := syncer.ListRemote(ctx)
+			if err != nil {
+				return fmt.Errorf("retrieving the blobs for %s: %+v", id, err)
+			}
+			if remote == nil {
+				return fmt.Errorf("the container %q for %s was not found", id.ContainerName, id)
+			}
+
+			upload, removed := blobDirectoryChanges(local, remote)
+
+			// the properties of a blob are set when it's uploaded, so all of the files are re-uploaded when these change
+			if metadata.ResourceData.HasChanges("cache_control", "content_types") {
+				upload, _ = blobDirectoryChanges(local, map[string]string{})
+			}
+
+			if err := syncer.Upload(ctx, upload); err != nil {
+				return fmt.Errorf("uploading %s: %+v", id, err)
+			}
+
+			if model.DeleteRemovedBlobs {
+				if err := syncer.Delete(ctx, removed); err != nil {
+					return fmt.Errorf("deleting the removed blobs for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageBlobDirectoryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageBlobDirectoryResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			syncer, err := r.syncer(ctx, metadata, *id, model)
+			if err != nil {
+				return err
+			}
+
+			files := make([]string, 0, len(model.Files))
+			for name := range model.Files {
+				files = append(files, name)
+			}
+
+			if err := syncer.Delete(ctx, files); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) syncer(ctx context.Context, metadata sdk.ResourceMetaData, id parse.StorageBlobDirectoryId, model StorageBlobDirectoryResourceModel) (*BlobDirectorySync, error) {
+	storageClient := metadata.Client.Storage
+	subscriptionId := metadata.Client.Account.SubscriptionId
+
+	account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountName, id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("locating Storage Account %q for %s", id.AccountName, id)
+	}
+
+	blobsClient, err := storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Blobs Client: %+v", err)
+	}
+
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Containers Client: %+v", err)
+	}
+
+	return &BlobDirectorySync{
+		BlobsClient:      blobsClient,
+		ContainersClient: containersClient,
+		ContainerName:    id.ContainerName,
+		Prefix:           id.Prefix,
+		Source:           model.Source,
+		CacheControl:     model.CacheControl,
+		ContentTypes:     model.ContentTypes,
+		Parallelism:      int(model.Parallelism),
+	}, nil
+}
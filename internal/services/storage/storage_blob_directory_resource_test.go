@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
+)
+
+type StorageBlobDirectoryResource struct{}
+
+func TestAccStorageBlobDirectory_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+	source := r.source(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("2"),
+				check.That(data.ResourceName).Key("files.index.html").HasValue("c83301425b2ad1d496473a5ff3d9ecca"),
+				check.That(data.ResourceName).Key("manifest_hash").IsSet(),
+			),
+		},
+		data.ImportStep("source"),
+	})
+}
+
+func TestAccStorageBlobDirectory_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+	source := r.source(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("2"),
+			),
+		},
+		{
+			PreConfig: func() {
+				r.writeFiles(t, source, map[string]string{
+					"index.html": "<html><body></body></html>",
+					"js/site.js": "console.log('hello')",
+				})
+				if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+					t.Fatalf("removing file: %+v", err)
+				}
+			},
+			Config: r.complete(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("2"),
+				check.That(data.ResourceName).Key("files.js/site.js").IsSet(),
+			),
+		},
+		data.ImportStep("source", "cache_control", "content_types"),
+	})
+}
+
+func TestAccStorageBlobDirectory_prefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+	source := r.source(t, map[string]string{
+		"index.html":    "<html></html>",
+		"img/logo.svg":  "<svg></svg>",
+		"fonts/a.woff2": "font",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.prefix(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+			),
+		},
+		data.ImportStep("source"),
+	})
+}
+
+func (r StorageBlobDirectoryResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageBlobDirectoryID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, client.Account.SubscriptionId, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for %s: %+v", id.AccountName, id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Account %q for %s", id.AccountName, id)
+	}
+
+	containersClient, err := client.Storage.ContainersDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Containers Client: %+v", err)
+	}
+
+	input := containers.ListBlobsInput{}
+	if id.Prefix != "" {
+		input.Prefix = pointer.To(id.Prefix + "/")
+	}
+	result, err := containersClient.ListBlobs(ctx, id.ContainerName, input)
+	if err != nil {
+		return nil, fmt.Errorf("listing blobs for %s: %+v", id, err)
+	}
+
+	return pointer.To(result != nil && len(result.Blobs.Blobs) > 0), nil
+}
+
+func (r StorageBlobDirectoryResource) source(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	r.writeFiles(t, dir, files)
+	return dir
+}
+
+func (r StorageBlobDirectoryResource) writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			t.Fatalf("creating directory for %q: %+v", name, err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+}
+
+func (r StorageBlobDirectoryResource) basic(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  source               = %q
+}
+`, r.template(data), filepath.ToSlash(source))
+}
+
+func (r StorageBlobDirectoryResource) complete(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  source               = %q
+  cache_control        = "public, max-age=3600"
+  delete_removed_blobs = true
+  parallelism          = 4
+
+  content_types = {
+    ".js" = "text/javascript"
+  }
+}
+`, r.template(data), filepath.ToSlash(source))
+}
+
+func (r StorageBlobDirectoryResource) prefix(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  source               = %q
+  prefix               = "static/%s"
+}
+`, r.template(data), filepath.ToSlash(source), strings.ToLower(data.RandomString))
+}
+
+func (r StorageBlobDirectoryResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "assets"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-06-01/storagequeues"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/queue/messages"
+)
+
+type StorageQueueMessageAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &StorageQueueMessageAction{}
+
+func newStorageQueueMessageAction() action.Action {
+	return &StorageQueueMessageAction{}
+}
+
+type StorageQueueMessageActionModel struct {
+	StorageQueueId           types.String   `tfsdk:"storage_queue_id"`
+	Messages                 []types.String `tfsdk:"messages"`
+	TimeToLiveInSeconds      types.Int64    `tfsdk:"time_to_live_in_seconds"`
+	VisibilityDelayInSeconds types.Int64    `tfsdk:"visibility_delay_in_seconds"`
+	Timeout                  types.String   `tfsdk:"timeout"`
+}
+
+func (s *StorageQueueMessageAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"storage_queue_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Storage Queue which the messages should be added to.",
+				MarkdownDescription: "The ID of the Storage Queue which the messages should be added to.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: storagequeues.ValidateQueueID,
+					},
+				},
+			},
+
+			"messages": schema.ListAttribute{
+				Required:            true,
+				Description:         "The messages which should be added to the Storage Queue, in order. Each message can be up to 64 KB in size.",
+				MarkdownDescription: "The messages which should be added to the Storage Queue, in order. Each message can be up to 64 KB in size.",
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 65536)),
+				},
+			},
+
+			"time_to_live_in_seconds": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The number of seconds the messages should remain in the Storage Queue, `-1` means the messages never expire. Defaults to 7 days.",
+				MarkdownDescription: "The number of seconds the messages should remain in the Storage Queue, `-1` means the messages never expire. Defaults to 7 days.",
+			},
+
+			"visibility_delay_in_seconds": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The number of seconds before the messages become visible in the Storage Queue, between `0` and `604800` (7 days). Defaults to `0`.",
+				MarkdownDescription: "The number of seconds before the messages become visible in the Storage Queue, between `0` and `604800` (7 days). Defaults to `0`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `15m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `15m`.",
+			},
+		},
+	}
+}
+
+func (s *StorageQueueMessageAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_storage_queue_message"
+}
+
+func (s *StorageQueueMessageAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	storageClient := s.Client.Storage
+	subscriptionId := s.Client.Account.SubscriptionId
+
+	model := StorageQueueMessageActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 15 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := storagequeues.ParseQueueID(model.StorageQueueId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	account, err := storageClient.FindAccount(ctx, subscriptionId, id.StorageAccountName)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "retrieving storage account", fmt.Sprintf("retrieving Storage Account %q for %s: %+v", id.StorageAccountName, id, err))
+		return
+	}
+	if account == nil {
+		sdk.SetResponseErrorDiagnostic(response, "retrieving storage account", fmt.Sprintf("locating Storage Account %q for %s", id.StorageAccountName, id))
+		return
+	}
+
+	client, err := storageClient.QueueMessagesDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "building client", fmt.Sprintf("building Queue Messages Client: %+v", err))
+		return
+	}
+
+	input := messages.PutInput{}
+	if v := model.TimeToLiveInSeconds; !v.IsNull() {
+		if v.ValueInt64() != -1 && v.ValueInt64() < 1 {
+			sdk.SetResponseErrorDiagnostic(response, "invalid `time_to_live_in_seconds`", fmt.Sprintf("`time_to_live_in_seconds` must be `-1` or a positive number but got %d", v.ValueInt64()))
+			return
+		}
+		input.MessageTtl = pointer.To(int(v.ValueInt64()))
+	}
+	if v := model.VisibilityDelayInSeconds; !v.IsNull() {
+		if v.ValueInt64() < 0 || v.ValueInt64() > 604800 {
+			sdk.SetResponseErrorDiagnostic(response, "invalid `visibility_delay_in_seconds`", fmt.Sprintf("`visibility_delay_in_seconds` must be between `0` and `604800` but got %d", v.ValueInt64()))
+			return
+		}
+		input.VisibilityTimeout = pointer.To(int(v.ValueInt64()))
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("adding %d message(s) to %s", len(model.Messages), id),
+	})
+
+	for i, message := range model.Messages {
+		input.Message = message.ValueString()
+		if _, err := client.Put(ctx, id.QueueName, input); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("adding message %d to %s: %+v", i, id, err))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("added %d message(s) to %s", len(model.Messages), id),
+	})
+}
+
+func (s *StorageQueueMessageAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	s.Defaults(ctx, request, response)
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type StorageQueueMessageAction struct{}
+
+func TestAccStorageQueueMessageAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_queue_message", "test")
+	a := StorageQueueMessageAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func TestAccStorageQueueMessageAction_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_queue_message", "test")
+	a := StorageQueueMessageAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.complete(data),
+			},
+		},
+	})
+}
+
+func (a *StorageQueueMessageAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_storage_queue_message" "test" {
+  config {
+    storage_queue_id = azurerm_storage_queue.test.id
+    messages         = ["hello world"]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_storage_queue.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_storage_queue_message.test]
+    }
+  }
+}
+`, StorageQueueResource{}.basic(data))
+}
+
+func (a *StorageQueueMessageAction) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_storage_queue_message" "test" {
+  config {
+    storage_queue_id            = azurerm_storage_queue.test.id
+    messages                    = ["first", "second", jsonencode({ hello = "world" })]
+    time_to_live_in_seconds     = -1
+    visibility_delay_in_seconds = 30
+    timeout                     = "5m"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_storage_queue.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_storage_queue_message.test]
+    }
+  }
+}
+`, StorageQueueResource{}.basic(data))
+}
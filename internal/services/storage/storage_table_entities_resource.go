@@ -0,0 +1,444 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/accounts"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/tables"
+)
+
+type StorageTableEntitiesResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageTableEntitiesResource{}
+
+type StorageTableEntitiesResourceModel struct {
+	StorageTableId string                            `tfschema:"storage_table_id"`
+	Entities       []StorageTableEntitiesEntityModel `tfschema:"entity"`
+}
+
+type StorageTableEntitiesEntityModel struct {
+	PartitionKey string            `tfschema:"partition_key"`
+	RowKey       string            `tfschema:"row_key"`
+	Properties   map[string]string `tfschema:"properties"`
+}
+
+func (r StorageTableEntitiesResource) ResourceType() string {
+	return "azurerm_storage_table_entities"
+}
+
+func (r StorageTableEntitiesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageTableEntitiesID
+}
+
+func (r StorageTableEntitiesResource) ModelObject() interface{} {
+	return &StorageTableEntitiesResourceModel{}
+}
+
+func (r StorageTableEntitiesResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_table_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageTableDataPlaneID,
+		},
+
+		"entity": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"partition_key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"row_key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"properties": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageTableEntitiesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model StorageTableEntitiesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			tableId, err := tables.ParseTableID(model.StorageTableId, storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			// the resource manages all entities within the table, as such no filter is used
+			id := parse.StorageTableEntitiesId{
+				AccountName:  tableId.AccountId.AccountName,
+				DomainSuffix: storageClient.StorageDomainSuffix,
+				TableName:    tableId.TableName,
+			}
+
+			if err := validateStorageTableEntities(model.Entities); err != nil {
+				return err
+			}
+
+			batch, err := r.batch(ctx, metadata, id)
+			if err != nil {
+				return err
+			}
+
+			// entities which already exist should be imported, rather than being overwritten
+			existing, err := r.listEntities(ctx, *batch, model.Entities)
+			if err != nil {
+				return fmt.Errorf("checking for existing entities for %s: %+v", id, err)
+			}
+			for _, entity := range model.Entities {
+				if _, ok := existing[tableEntityKey{PartitionKey: entity.PartitionKey, RowKey: entity.RowKey}]; ok {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			if err := batch.Execute(ctx, storageTableEntitiesChanges(nil, model.Entities)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := parse.StorageTableEntitiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state StorageTableEntitiesResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountName, id, err)
+			}
+			if account == nil {
+				metadata.Logger.Infof("Unable to locate Storage Account %q for %s - assuming removed & removing from state", id.AccountName, id)
+				return metadata.MarkAsGone(id)
+			}
+
+			tablesClient, err := storageClient.TablesDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Tables Client: %+v", err)
+			}
+
+			exists, err := tablesClient.Exists(ctx, id.TableName)
+			if err != nil {
+				return fmt.Errorf("checking for the existence of the table for %s: %+v", id, err)
+			}
+			if exists == nil || !*exists {
+				metadata.Logger.Infof("Table %q for %s was not found - assuming removed & removing from state", id.TableName, id)
+				return metadata.MarkAsGone(id)
+			}
+
+			batch, err := r.batch(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			remote, err := r.listEntities(ctx, *batch, state.Entities)
+			if err != nil {
+				return fmt.Errorf("retrieving the entities for %s: %+v", id, err)
+			}
+
+			accountId, err := accounts.ParseAccountID(id.ID(), id.DomainSuffix)
+			if err != nil {
+				return fmt.Errorf("parsing Account ID: %+v", err)
+			}
+			state.StorageTableId = tables.NewTableID(*accountId, id.TableName).ID()
+
+			// only the entities managed by this resource are tracked, unless this resource is being imported,
+			// in which case all of the entities within the table are
+			importing := len(state.Entities) == 0
+			managed := make(map[tableEntityKey]struct{})
+			for _, entity := range state.Entities {
+				managed[tableEntityKey{PartitionKey: entity.PartitionKey, RowKey: entity.RowKey}] = struct{}{}
+			}
+
+			entities := make([]StorageTableEntitiesEntityModel, 0)
+			for key, properties := range remote {
+				if _, ok := managed[key]; !ok && !importing {
+					continue
+				}
+				entities = append(entities, StorageTableEntitiesEntityModel{
+					PartitionKey: key.PartitionKey,
+					RowKey:       key.RowKey,
+					Properties:   properties,
+				})
+			}
+			sort.Slice(entities, func(i, j int) bool {
+				if entities[i].PartitionKey != entities[j].PartitionKey {
+					return entities[i].PartitionKey < entities[j].PartitionKey
+				}
+				return entities[i].RowKey < entities[j].RowKey
+			})
+			state.Entities = entities
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageTableEntitiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageTableEntitiesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := validateStorageTableEntities(model.Entities); err != nil {
+				return err
+			}
+
+			batch, err := r.batch(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			old, _ := metadata.ResourceData.GetChange("entity")
+			operations := storageTableEntitiesChanges(expandStorageTableEntities(old.(*pluginsdk.Set).List()), model.Entities)
+			if err := batch.Execute(ctx, operations); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageTableEntitiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageTableEntitiesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			batch, err := r.batch(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			if err := batch.Execute(ctx, storageTableEntitiesChanges(model.Entities, nil)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) batch(ctx context.Context, metadata sdk.ResourceMetaData, id parse.StorageTableEntitiesId) (*TableEntityBatch, error) {
+	storageClient := metadata.Client.Storage
+	subscriptionId := metadata.Client.Account.SubscriptionId
+
+	account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountName, id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("locating Storage Account %q for %s", id.AccountName, id)
+	}
+
+	client, err := storageClient.TableEntityDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Table Entity Client: %+v", err)
+	}
+
+	return &TableEntityBatch{
+		Client:    client,
+		TableName: id.TableName,
+	}, nil
+}
+
+// listEntities returns the properties of the existing entities within the partitions used by the specified entities,
+// or all of the entities within the table when none are specified
+func (r StorageTableEntitiesResource) listEntities(ctx context.Context, batch TableEntityBatch, input []StorageTableEntitiesEntityModel) (map[tableEntityKey]map[string]string, error) {
+	partitionKeys := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, entity := range input {
+		if _, ok := seen[entity.PartitionKey]; !ok {
+			seen[entity.PartitionKey] = struct{}{}
+			partitionKeys = append(partitionKeys, entity.PartitionKey)
+		}
+	}
+	if len(partitionKeys) == 0 {
+		partitionKeys = append(partitionKeys, "")
+	}
+
+	output := make(map[tableEntityKey]map[string]string)
+	for _, partitionKey := range partitionKeys {
+		results, err := batch.List(ctx, partitionKey)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range results {
+			key := tableEntityKey{
+				PartitionKey: fmt.Sprint(result["PartitionKey"]),
+				RowKey:       fmt.Sprint(result["RowKey"]),
+			}
+
+			properties := make(map[string]string)
+			for k, v := range flattenEntity(result) {
+				properties[k] = fmt.Sprint(v)
+			}
+			output[key] = properties
+		}
+	}
+
+	return output, nil
+}
+
+type tableEntityKey struct {
+	PartitionKey string
+	RowKey       string
+}
+
+// storageTableEntitiesChanges returns the operations required to change the entities from `old` to `new` - inserting or
+// replacing the entities which are new or whose properties have changed, and deleting those which have been removed
+func storageTableEntitiesChanges(old, new []StorageTableEntitiesEntityModel) []TableEntityBatchOperation {
+	existing := make(map[tableEntityKey]map[string]string)
+	for _, entity := range old {
+		existing[tableEntityKey{PartitionKey: entity.PartitionKey, RowKey: entity.RowKey}] = entity.Properties
+	}
+
+	operations := make([]TableEntityBatchOperation, 0)
+	desired := make(map[tableEntityKey]struct{})
+	for _, entity := range new {
+		key := tableEntityKey{PartitionKey: entity.PartitionKey, RowKey: entity.RowKey}
+		desired[key] = struct{}{}
+
+		if properties, ok := existing[key]; ok && storageTableEntityPropertiesEqual(properties, entity.Properties) {
+			continue
+		}
+
+		properties := make(map[string]interface{}, len(entity.Properties))
+		for k, v := range entity.Properties {
+			properties[k] = v
+		}
+		operations = append(operations, TableEntityBatchOperation{
+			PartitionKey: entity.PartitionKey,
+			RowKey:       entity.RowKey,
+			Entity:       properties,
+		})
+	}
+
+	for _, entity := range old {
+		if _, ok := desired[tableEntityKey{PartitionKey: entity.PartitionKey, RowKey: entity.RowKey}]; !ok {
+			operations = append(operations, TableEntityBatchOperation{
+				PartitionKey: entity.PartitionKey,
+				RowKey:       entity.RowKey,
+			})
+		}
+	}
+
+	return operations
+}
+
+func storageTableEntityPropertiesEqual(first, second map[string]string) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for k, v := range first {
+		if other, ok := second[k]; !ok || other != v {
+			return false
+		}
+	}
+	return true
+}
+
+func validateStorageTableEntities(input []StorageTableEntitiesEntityModel) error {
+	seen := make(map[tableEntityKey]struct{})
+	for _, entity := range input {
+		key := tableEntityKey{PartitionKey: entity.PartitionKey, RowKey: entity.RowKey}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("each `entity` must have a unique combination of `partition_key` and `row_key` but found multiple with partition key %q and row key %q", entity.PartitionKey, entity.RowKey)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+func expandStorageTableEntities(input []interface{}) []StorageTableEntitiesEntityModel {
+	output := make([]StorageTableEntitiesEntityModel, 0, len(input))
+	for _, item := range input {
+		raw := item.(map[string]interface{})
+
+		properties := make(map[string]string)
+		for k, v := range raw["properties"].(map[string]interface{}) {
+			properties[k] = v.(string)
+		}
+
+		output = append(output, StorageTableEntitiesEntityModel{
+			PartitionKey: raw["partition_key"].(string),
+			RowKey:       raw["row_key"].(string),
+			Properties:   properties,
+		})
+	}
+	return output
+}
@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/entities"
+)
+
+type StorageTableEntitiesResource struct{}
+
+func TestAccStorageTableEntities_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTableEntities_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageTableEntities_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTableEntities_multipleBatches(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleBatches(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("150"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageTableEntitiesResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageTableEntitiesID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.Storage.FindAccount(ctx, client.Account.SubscriptionId, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Table %q: %+v", id.AccountName, id.TableName, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("storage Account %q was not found", id.AccountName)
+	}
+
+	entitiesClient, err := client.Storage.TableEntityDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Table Entity Client: %+v", err)
+	}
+
+	resp, err := entitiesClient.Query(ctx, id.TableName, entities.QueryEntitiesInput{
+		MetaDataLevel: entities.NoMetaData,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("querying the entities in Table %q in %s: %+v", id.TableName, account.StorageAccountId, err)
+	}
+	return pointer.To(len(resp.Entities) > 0), nil
+}
+
+func (r StorageTableEntitiesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_table_id = azurerm_storage_table.test.id
+
+  entity {
+    partition_key = "partition1"
+    row_key       = "row1"
+    properties = {
+      Foo = "Bar"
+    }
+  }
+
+  entity {
+    partition_key = "partition2"
+    row_key       = "row1"
+  }
+}
+`, StorageTableEntityResource{}.template(data))
+}
+
+func (r StorageTableEntitiesResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "import" {
+  storage_table_id = azurerm_storage_table_entities.test.storage_table_id
+
+  entity {
+    partition_key = "partition1"
+    row_key       = "row1"
+    properties = {
+      Foo = "Bar"
+    }
+  }
+
+  entity {
+    partition_key = "partition2"
+    row_key       = "row1"
+  }
+}
+`, r.basic(data))
+}
+
+func (r StorageTableEntitiesResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_table_id = azurerm_storage_table.test.id
+
+  entity {
+    partition_key = "partition1"
+    row_key       = "row1"
+    properties = {
+      Foo = "Baz"
+      Bar = "Qux"
+    }
+  }
+
+  entity {
+    partition_key = "partition1"
+    row_key       = "row2"
+  }
+
+  entity {
+    partition_key = "partition3"
+    row_key       = "row1"
+    properties = {
+      Hello = "World"
+    }
+  }
+}
+`, StorageTableEntityResource{}.template(data))
+}
+
+func (r StorageTableEntitiesResource) multipleBatches(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_table_id = azurerm_storage_table.test.id
+
+  dynamic "entity" {
+    for_each = range(150)
+    content {
+      partition_key = "partition1"
+      row_key       = format("row%%03d", entity.value)
+      properties = {
+        Index = tostring(entity.value)
+      }
+    }
+  }
+}
+`, StorageTableEntityResource{}.template(data))
+}
@@ -0,0 +1,313 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/entities"
+)
+
+// tableEntityBatchMaxOperations is the maximum number of operations which can be included in a single Entity Group Transaction
+const tableEntityBatchMaxOperations = 100
+
+// TableEntityBatchOperation is an operation on a single entity which is performed as a part of an Entity Group Transaction
+type TableEntityBatchOperation struct {
+	PartitionKey string
+	RowKey       string
+
+	// Entity contains the properties of the entity to insert or replace, the entity is deleted when this is nil
+	Entity map[string]interface{}
+}
+
+// TableEntityBatch performs operations on the entities within a table using Entity Group Transactions
+type TableEntityBatch struct {
+	Client    *entities.Client
+	TableName string
+}
+
+// Execute performs the operations, grouped into a transaction per partition key (split into chunks of 100 operations)
+func (b TableEntityBatch) Execute(ctx context.Context, operations []TableEntityBatchOperation) error {
+	for _, changeset := range tableEntityBatchChangesets(operations) {
+		if err := b.submit(ctx, changeset); err != nil {
+			return fmt.Errorf("performing the batch of %d operation(s) for the partition key %q in table %q: %+v", len(changeset), changeset[0].PartitionKey, b.TableName, err)
+		}
+	}
+
+	return nil
+}
+
+// List returns all the entities within the partition, or within the table when `partitionKey` is empty
+func (b TableEntityBatch) List(ctx context.Context, partitionKey string) ([]map[string]interface{}, error) {
+	input := entities.QueryEntitiesInput{
+		MetaDataLevel: entities.FullMetaData,
+	}
+	if partitionKey != "" {
+		input.Filter = pointer.To(fmt.Sprintf("PartitionKey eq '%s'", strings.ReplaceAll(partitionKey, "'", "''")))
+	}
+
+	output := make([]map[string]interface{}, 0)
+	for {
+		result, err := b.Client.Query(ctx, b.TableName, input)
+		if err != nil {
+			return nil, fmt.Errorf("querying the entities in table %q: %+v", b.TableName, err)
+		}
+		output = append(output, result.Entities...)
+
+		// the continuation tokens are returned as headers which aren't exposed by the SDK
+		if result.HttpResponse == nil {
+			break
+		}
+		nextPartitionKey := result.HttpResponse.Header.Get("x-ms-continuation-NextPartitionKey")
+		nextRowKey := result.HttpResponse.Header.Get("x-ms-continuation-NextRowKey")
+		if nextPartitionKey == "" && nextRowKey == "" {
+			break
+		}
+		input.NextPartitionKey = pointer.To(nextPartitionKey)
+		input.NextRowKey = pointer.To(nextRowKey)
+	}
+
+	return output, nil
+}
+
+func (b TableEntityBatch) submit(ctx context.Context, operations []TableEntityBatchOperation) error {
+	batchId, err := uuid.GenerateUUID()
+	if err != nil {
+		return err
+	}
+	changesetId, err := uuid.GenerateUUID()
+	if err != nil {
+		return err
+	}
+	batchBoundary := fmt.Sprintf("batch_%s", batchId)
+
+	body, err := buildTableEntityBatchRequestBody(b.Client.Client.BaseUri, b.TableName, batchBoundary, fmt.Sprintf("changeset_%s", changesetId), operations)
+	if err != nil {
+		return fmt.Errorf("building request body: %+v", err)
+	}
+
+	opts := client.RequestOptions{
+		ContentType: fmt.Sprintf("multipart/mixed; boundary=%s", batchBoundary),
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+		},
+		HttpMethod:    http.MethodPost,
+		OptionsObject: tableEntityBatchOptions{},
+		Path:          "/$batch",
+	}
+
+	req, err := b.Client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+	if err := req.Marshal(body); err != nil {
+		return fmt.Errorf("marshalling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("executing request: %+v", err)
+	}
+	if resp == nil || resp.Response == nil {
+		return fmt.Errorf("executing request: response was nil")
+	}
+	defer resp.Body.Close()
+
+	// the batch itself is accepted even when an operation fails, in which case the whole changeset is rolled back
+	return parseTableEntityBatchResponse(resp.Header.Get("Content-Type"), resp.Body)
+}
+
+type tableEntityBatchOptions struct{}
+
+func (o tableEntityBatchOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("Accept", "application/json")
+	headers.Append("DataServiceVersion", "3.0")
+	headers.Append("MaxDataServiceVersion", "3.0;NetFx")
+	return headers
+}
+
+func (o tableEntityBatchOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o tableEntityBatchOptions) ToQuery() *client.QueryParams {
+	return nil
+}
+
+// tableEntityBatchChangesets groups the operations by partition key, since a transaction can only contain entities
+// within a single partition, splitting each partition into chunks of at most 100 operations
+func tableEntityBatchChangesets(operations []TableEntityBatchOperation) [][]TableEntityBatchOperation {
+	partitions := make(map[string][]TableEntityBatchOperation)
+	for _, operation := range operations {
+		partitions[operation.PartitionKey] = append(partitions[operation.PartitionKey], operation)
+	}
+
+	partitionKeys := make([]string, 0, len(partitions))
+	for partitionKey := range partitions {
+		partitionKeys = append(partitionKeys, partitionKey)
+	}
+	sort.Strings(partitionKeys)
+
+	changesets := make([][]TableEntityBatchOperation, 0)
+	for _, partitionKey := range partitionKeys {
+		items := partitions[partitionKey]
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].RowKey < items[j].RowKey
+		})
+
+		for len(items) > 0 {
+			size := len(items)
+			if size > tableEntityBatchMaxOperations {
+				size = tableEntityBatchMaxOperations
+			}
+			changesets = append(changesets, items[:size])
+			items = items[size:]
+		}
+	}
+
+	return changesets
+}
+
+// buildTableEntityBatchRequestBody builds the multipart body of an Entity Group Transaction containing a single changeset
+func buildTableEntityBatchRequestBody(baseUri, tableName, batchBoundary, changesetBoundary string, operations []TableEntityBatchOperation) ([]byte, error) {
+	escapeKey := func(input string) string {
+		return url.PathEscape(strings.ReplaceAll(input, "'", "''"))
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "--%s\r\n", batchBoundary)
+	fmt.Fprintf(buf, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", changesetBoundary)
+
+	for _, operation := range operations {
+		entityUri := fmt.Sprintf("%s/%s(PartitionKey='%s',RowKey='%s')", strings.TrimSuffix(baseUri, "/"), tableName, escapeKey(operation.PartitionKey), escapeKey(operation.RowKey))
+
+		fmt.Fprintf(buf, "--%s\r\n", changesetBoundary)
+		buf.WriteString("Content-Type: application/http\r\n")
+		buf.WriteString("Content-Transfer-Encoding: binary\r\n\r\n")
+
+		if operation.Entity == nil {
+			fmt.Fprintf(buf, "DELETE %s HTTP/1.1\r\n", entityUri)
+			buf.WriteString("Accept: application/json;odata=minimalmetadata\r\n")
+			buf.WriteString("If-Match: *\r\n")
+			buf.WriteString("DataServiceVersion: 3.0;\r\n\r\n")
+			continue
+		}
+
+		entity := make(map[string]interface{}, len(operation.Entity)+2)
+		for k, v := range operation.Entity {
+			entity[k] = v
+		}
+		entity["PartitionKey"] = operation.PartitionKey
+		entity["RowKey"] = operation.RowKey
+
+		payload, err := json.Marshal(entity)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling the entity with partition key %q and row key %q: %+v", operation.PartitionKey, operation.RowKey, err)
+		}
+
+		fmt.Fprintf(buf, "PUT %s HTTP/1.1\r\n", entityUri)
+		buf.WriteString("Content-Type: application/json\r\n")
+		buf.WriteString("Accept: application/json;odata=minimalmetadata\r\n")
+		buf.WriteString("Prefer: return-no-content\r\n")
+		buf.WriteString("DataServiceVersion: 3.0;\r\n\r\n")
+		buf.Write(payload)
+		buf.WriteString("\r\n")
+	}
+
+	fmt.Fprintf(buf, "--%s--\r\n", changesetBoundary)
+	fmt.Fprintf(buf, "--%s--\r\n", batchBoundary)
+
+	return buf.Bytes(), nil
+}
+
+// parseTableEntityBatchResponse returns an error when any of the operations within the batch response failed
+func parseTableEntityBatchResponse(contentType string, body io.Reader) error {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("parsing the content type %q of the batch response: %+v", contentType, err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return fmt.Errorf("expected the batch response to be multipart but got %q", mediaType)
+	}
+
+	reader := multipart.NewReader(body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading the batch response: %+v", err)
+		}
+
+		partMediaType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		if err != nil {
+			return fmt.Errorf("parsing the content type of the batch response part: %+v", err)
+		}
+
+		// the operations within a changeset are nested within a multipart changeset response
+		if strings.HasPrefix(partMediaType, "multipart/") {
+			if err := parseTableEntityBatchResponse(part.Header.Get("Content-Type"), part); err != nil {
+				return err
+			}
+			continue
+		}
+		if partMediaType != "application/http" {
+			continue
+		}
+
+		if err := checkTableEntityBatchOperationResponse(part); err != nil {
+			return err
+		}
+	}
+}
+
+func checkTableEntityBatchOperationResponse(part io.Reader) error {
+	resp, err := http.ReadResponse(bufio.NewReader(part), nil)
+	if err != nil {
+		return fmt.Errorf("reading the response for an operation within the batch: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading the error for an operation within the batch: %+v", err)
+	}
+
+	// the error message is prefixed with the index of the failed operation within the changeset, e.g. `1:The specified resource does not exist.`
+	message := strings.TrimSpace(string(respBody))
+	var tableError struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message struct {
+				Value string `json:"value"`
+			} `json:"message"`
+		} `json:"odata.error"`
+	}
+	if err := json.Unmarshal(respBody, &tableError); err == nil && tableError.Error.Code != "" {
+		message = fmt.Sprintf("%s: %s", tableError.Error.Code, tableError.Error.Message.Value)
+	}
+
+	return fmt.Errorf("operation failed with status %d: %s", resp.StatusCode, message)
+}
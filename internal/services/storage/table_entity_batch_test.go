@@ -0,0 +1,279 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/entities"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/tables"
+)
+
+func TestTableEntityBatchChangesets(t *testing.T) {
+	operations := make([]TableEntityBatchOperation, 0)
+	for i := 0; i < 150; i++ {
+		operations = append(operations, TableEntityBatchOperation{
+			PartitionKey: "large",
+			RowKey:       fmt.Sprintf("%03d", i),
+		})
+	}
+	operations = append(operations,
+		TableEntityBatchOperation{PartitionKey: "b", RowKey: "2"},
+		TableEntityBatchOperation{PartitionKey: "a", RowKey: "1"},
+		TableEntityBatchOperation{PartitionKey: "b", RowKey: "1"},
+	)
+
+	changesets := tableEntityBatchChangesets(operations)
+	if len(changesets) != 4 {
+		t.Fatalf("expected 4 changesets but got %d", len(changesets))
+	}
+
+	expected := []struct {
+		PartitionKey string
+		Size         int
+		FirstRowKey  string
+	}{
+		{PartitionKey: "a", Size: 1, FirstRowKey: "1"},
+		{PartitionKey: "b", Size: 2, FirstRowKey: "1"},
+		{PartitionKey: "large", Size: 100, FirstRowKey: "000"},
+		{PartitionKey: "large", Size: 50, FirstRowKey: "100"},
+	}
+	for i, v := range expected {
+		changeset := changesets[i]
+		if len(changeset) != v.Size {
+			t.Fatalf("expected changeset %d to contain %d operations but got %d", i, v.Size, len(changeset))
+		}
+		if changeset[0].RowKey != v.FirstRowKey {
+			t.Fatalf("expected changeset %d to start with row key %q but got %q", i, v.FirstRowKey, changeset[0].RowKey)
+		}
+		for _, operation := range changeset {
+			if operation.PartitionKey != v.PartitionKey {
+				t.Fatalf("expected changeset %d to only contain partition key %q but got %q", i, v.PartitionKey, operation.PartitionKey)
+			}
+		}
+	}
+}
+
+func TestBuildTableEntityBatchRequestBody(t *testing.T) {
+	operations := []TableEntityBatchOperation{
+		{
+			PartitionKey: "partition",
+			RowKey:       "it's",
+			Entity: map[string]interface{}{
+				"Name": "example",
+			},
+		},
+		{
+			PartitionKey: "partition",
+			RowKey:       "removed",
+		},
+	}
+
+	body, err := buildTableEntityBatchRequestBody("https://account1.table.core.windows.net/", "table1", "batch_1", "changeset_1", operations)
+	if err != nil {
+		t.Fatalf("building body: %+v", err)
+	}
+
+	expected := strings.Join([]string{
+		"--batch_1",
+		"Content-Type: multipart/mixed; boundary=changeset_1",
+		"",
+		"--changeset_1",
+		"Content-Type: application/http",
+		"Content-Transfer-Encoding: binary",
+		"",
+		"PUT https://account1.table.core.windows.net/table1(PartitionKey='partition',RowKey='it%27%27s') HTTP/1.1",
+		"Content-Type: application/json",
+		"Accept: application/json;odata=minimalmetadata",
+		"Prefer: return-no-content",
+		"DataServiceVersion: 3.0;",
+		"",
+		`{"Name":"example","PartitionKey":"partition","RowKey":"it's"}`,
+		"--changeset_1",
+		"Content-Type: application/http",
+		"Content-Transfer-Encoding: binary",
+		"",
+		"DELETE https://account1.table.core.windows.net/table1(PartitionKey='partition',RowKey='removed') HTTP/1.1",
+		"Accept: application/json;odata=minimalmetadata",
+		"If-Match: *",
+		"DataServiceVersion: 3.0;",
+		"",
+		"--changeset_1--",
+		"--batch_1--",
+		"",
+	}, "\r\n")
+
+	if string(body) != expected {
+		t.Fatalf("expected the body to be:\n%s\nbut got:\n%s", expected, string(body))
+	}
+}
+
+func TestParseTableEntityBatchResponse(t *testing.T) {
+	buildResponse := func(statusLines ...string) string {
+		lines := []string{
+			"--batchresponse_1",
+			"Content-Type: multipart/mixed; boundary=changesetresponse_1",
+			"",
+		}
+		for _, statusLine := range statusLines {
+			lines = append(lines,
+				"--changesetresponse_1",
+				"Content-Type: application/http",
+				"Content-Transfer-Encoding: binary",
+				"",
+				statusLine,
+			)
+		}
+		lines = append(lines, "--changesetresponse_1--", "--batchresponse_1--", "")
+		return strings.Join(lines, "\r\n")
+	}
+	contentType := "multipart/mixed; boundary=batchresponse_1"
+
+	success := buildResponse(
+		"HTTP/1.1 204 No Content\r\nContent-Length: 0\r\n\r\n",
+		"HTTP/1.1 204 No Content\r\nContent-Length: 0\r\n\r\n",
+	)
+	if err := parseTableEntityBatchResponse(contentType, strings.NewReader(success)); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	errorBody := `{"odata.error":{"code":"ResourceNotFound","message":{"lang":"en-US","value":"1:The specified resource does not exist."}}}`
+	failure := buildResponse(fmt.Sprintf("HTTP/1.1 404 Not Found\r\nContent-Type: application/json\r\nContent-Length: %d\r\n\r\n%s", len(errorBody), errorBody))
+	err := parseTableEntityBatchResponse(contentType, strings.NewReader(failure))
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if expected := "operation failed with status 404: ResourceNotFound: 1:The specified resource does not exist."; err.Error() != expected {
+		t.Fatalf("expected the error %q but got %q", expected, err.Error())
+	}
+
+	if err := parseTableEntityBatchResponse("application/json", strings.NewReader("{}")); err == nil {
+		t.Fatalf("expected an error for a non-multipart response but didn't get one")
+	}
+}
+
+func TestStorageTableEntitiesChanges(t *testing.T) {
+	old := []StorageTableEntitiesEntityModel{
+		{PartitionKey: "p1", RowKey: "unchanged", Properties: map[string]string{"a": "1"}},
+		{PartitionKey: "p1", RowKey: "modified", Properties: map[string]string{"a": "1"}},
+		{PartitionKey: "p1", RowKey: "removed", Properties: map[string]string{}},
+	}
+	new := []StorageTableEntitiesEntityModel{
+		{PartitionKey: "p1", RowKey: "unchanged", Properties: map[string]string{"a": "1"}},
+		{PartitionKey: "p1", RowKey: "modified", Properties: map[string]string{"a": "2"}},
+		{PartitionKey: "p2", RowKey: "added", Properties: map[string]string{"b": "1"}},
+	}
+
+	operations := storageTableEntitiesChanges(old, new)
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].RowKey < operations[j].RowKey
+	})
+
+	expected := []TableEntityBatchOperation{
+		{PartitionKey: "p2", RowKey: "added", Entity: map[string]interface{}{"b": "1"}},
+		{PartitionKey: "p1", RowKey: "modified", Entity: map[string]interface{}{"a": "2"}},
+		{PartitionKey: "p1", RowKey: "removed"},
+	}
+	if !reflect.DeepEqual(operations, expected) {
+		t.Fatalf("expected the operations to be %+v but got %+v", expected, operations)
+	}
+
+	if operations := storageTableEntitiesChanges(old, old); len(operations) != 0 {
+		t.Fatalf("expected no operations when nothing has changed but got %+v", operations)
+	}
+}
+
+// TestTableEntityBatchAzurite runs a batch against the Azurite storage emulator, which can be started using
+// `docker run -p 10002:10002 mcr.microsoft.com/azure-storage/azurite azurite-table --tableHost 0.0.0.0`
+// and then running this test with `AZURITE_TABLE_ENDPOINT=http://127.0.0.1:10002/devstoreaccount1`
+func TestTableEntityBatchAzurite(t *testing.T) {
+	endpoint := os.Getenv("AZURITE_TABLE_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("`AZURITE_TABLE_ENDPOINT` isn't set, skipping")
+	}
+
+	// these are the well-known credentials for the storage emulator
+	accountName := "devstoreaccount1"
+	accountKey := "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	authorizer, err := auth.NewSharedKeyAuthorizer(accountName, accountKey, auth.SharedKeyTable)
+	if err != nil {
+		t.Fatalf("building authorizer: %+v", err)
+	}
+
+	tablesClient, err := tables.NewWithBaseUri(endpoint)
+	if err != nil {
+		t.Fatalf("building tables client: %+v", err)
+	}
+	tablesClient.Client.SetAuthorizer(authorizer)
+
+	entitiesClient, err := entities.NewWithBaseUri(endpoint)
+	if err != nil {
+		t.Fatalf("building entities client: %+v", err)
+	}
+	entitiesClient.Client.SetAuthorizer(authorizer)
+
+	tableName := fmt.Sprintf("acctest%d", time.Now().UnixNano())
+	if _, err := tablesClient.Create(ctx, tableName); err != nil {
+		t.Fatalf("creating table: %+v", err)
+	}
+	defer tablesClient.Delete(context.Background(), tableName) // nolint: errcheck
+
+	batch := TableEntityBatch{
+		Client:    entitiesClient,
+		TableName: tableName,
+	}
+
+	operations := make([]TableEntityBatchOperation, 0)
+	for i := 0; i < 120; i++ {
+		operations = append(operations, TableEntityBatchOperation{
+			PartitionKey: "partition",
+			RowKey:       fmt.Sprintf("%03d", i),
+			Entity: map[string]interface{}{
+				"Index": fmt.Sprintf("%d", i),
+			},
+		})
+	}
+	if err := batch.Execute(ctx, operations); err != nil {
+		t.Fatalf("inserting entities: %+v", err)
+	}
+
+	result, err := batch.List(ctx, "partition")
+	if err != nil {
+		t.Fatalf("listing entities: %+v", err)
+	}
+	if len(result) != 120 {
+		t.Fatalf("expected 120 entities but got %d", len(result))
+	}
+
+	deletes := make([]TableEntityBatchOperation, 0)
+	for _, operation := range operations {
+		deletes = append(deletes, TableEntityBatchOperation{
+			PartitionKey: operation.PartitionKey,
+			RowKey:       operation.RowKey,
+		})
+	}
+	if err := batch.Execute(ctx, deletes); err != nil {
+		t.Fatalf("deleting entities: %+v", err)
+	}
+
+	result, err = batch.List(ctx, "")
+	if err != nil {
+		t.Fatalf("listing entities: %+v", err)
+	}
+	if len(result) != 0 {
+		t.Fatalf("expected no entities but got %d", len(result))
+	}
+}
@@ -6,6 +6,9 @@ package pluginsdk
 import (
 	"context"
 	"log"
+	"runtime"
+	"sync"
+	"weak"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +18,43 @@ type IDValidationFunc func(id string) error
 
 type ImporterFunc = func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error)
 
+var (
+	// importerIDValidationFuncs tracks the IDValidationFunc used by each Importer built using the functions below,
+	// allowing the Resource ID type of a Resource to be determined from its Importer. Since Resources (and so their
+	// Importers) are built each time the Provider is, the Importers are weakly referenced and their entries are removed
+	// once they've been garbage collected.
+	importerIDValidationFuncs     = make(map[weak.Pointer[schema.ResourceImporter]]IDValidationFunc)
+	importerIDValidationFuncsLock sync.Mutex
+)
+
+func trackImporterIDValidationFunc(importer *schema.ResourceImporter, validateFunc IDValidationFunc) {
+	key := weak.Make(importer)
+
+	importerIDValidationFuncsLock.Lock()
+	importerIDValidationFuncs[key] = validateFunc
+	importerIDValidationFuncsLock.Unlock()
+
+	runtime.AddCleanup(importer, func(key weak.Pointer[schema.ResourceImporter]) {
+		importerIDValidationFuncsLock.Lock()
+		delete(importerIDValidationFuncs, key)
+		importerIDValidationFuncsLock.Unlock()
+	}, key)
+}
+
+// IDValidationFuncForImporter returns the IDValidationFunc used to validate the ID at import time for an Importer built
+// using ImporterValidatingResourceId(Then) or ImporterValidatingIdentity(Then)
+func IDValidationFuncForImporter(importer *schema.ResourceImporter) (IDValidationFunc, bool) {
+	if importer == nil {
+		return nil, false
+	}
+
+	importerIDValidationFuncsLock.Lock()
+	defer importerIDValidationFuncsLock.Unlock()
+
+	v, ok := importerIDValidationFuncs[weak.Make(importer)]
+	return v, ok
+}
+
 // ImporterValidatingResourceId validates the ID provided at import time is valid
 // using the validateFunc.
 func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.ResourceImporter {
@@ -27,7 +67,7 @@ func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.Resourc
 // ImporterValidatingResourceIdThen validates the ID provided at import time is valid
 // using the validateFunc then runs the 'thenFunc', allowing the import to be customised.
 func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc ImporterFunc) *schema.ResourceImporter {
-	return &schema.ResourceImporter{
+	importer := &schema.ResourceImporter{
 		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
 			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
 
@@ -45,6 +85,9 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 			return thenFunc(ctx, d, meta)
 		},
 	}
+	trackImporterIDValidationFunc(importer, validateFunc)
+
+	return importer
 }
 
 // ImporterValidatingIdentity validates the ID provided at import time is valid or that the resource identity data provided in the import block is valid
@@ -60,7 +103,7 @@ func ImporterValidatingIdentity(id resourceids.ResourceId, idType ...ResourceTyp
 // ImporterValidatingIdentityThen validates the ID provided at import time is valid or that the resource identity data provided in the import block is valid
 // based on the expected resource ID type, then runs the 'thenFunc', allowing the import to be customised.
 func ImporterValidatingIdentityThen(id resourceids.ResourceId, thenFunc ImporterFunc, idType ...ResourceTypeForIdentity) *schema.ResourceImporter {
-	return &schema.ResourceImporter{
+	importer := &schema.ResourceImporter{
 		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
 			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
 
@@ -86,4 +129,10 @@ func ImporterValidatingIdentityThen(id resourceids.ResourceId, thenFunc Importer
 			return thenFunc(ctx, d, meta)
 		},
 	}
+	trackImporterIDValidationFunc(importer, func(input string) error {
+		_, err := resourceids.NewParserFromResourceIdType(id).Parse(input, false)
+		return err
+	})
+
+	return importer
 }
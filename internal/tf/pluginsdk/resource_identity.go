@@ -43,11 +43,14 @@ func identityType(idType []ResourceTypeForIdentity) ResourceTypeForIdentity {
 // segmentTypeSupported contains a list of segments that should be used to construct the resource identity schema
 // this list will need to be extended to support hierarchical resource IDs for management groups or resources
 // that begin with a different prefix to /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1
+// Scope segments are exposed as a single `scope` attribute containing the full ID of the parent scope, which allows
+// resources that can be created at any scope (e.g. Role Assignments or Policy Assignments) to expose a resource identity
 func segmentTypeSupported(segment resourceids.SegmentType) bool {
 	supportedSegmentTypes := []resourceids.SegmentType{
 		resourceids.ConstantSegmentType,
 		resourceids.SubscriptionIdSegmentType,
 		resourceids.ResourceGroupSegmentType,
+		resourceids.ScopeSegmentType,
 		resourceids.UserSpecifiedSegmentType,
 	}
 
@@ -131,6 +134,11 @@ func ValidateResourceIdentityData(d *schema.ResourceData, id resourceids.Resourc
 				return fmt.Errorf("error setting id: %+v", err)
 			}
 
+			if segment.Type == resourceids.ScopeSegmentType {
+				// scopes are themselves Resource IDs and so are prefixed with a `/`
+				value = strings.TrimPrefix(value, "/")
+			}
+
 			identityString += value + "/"
 		}
 	}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/vcr"
+)
+
+func main() {
+	options := vcr.DefaultLintOptions()
+
+	root := flag.String("path", ".", "the directory to search for `vcrtestdata` cassettes within")
+	allowed := flag.String("allow", "", "a comma separated list of regular expressions matching values which are known not to be sensitive")
+	flag.Float64Var(&options.EntropyThreshold, "entropy-threshold", options.EntropyThreshold, "the Shannon entropy (bits per character) above which a value is considered a secret")
+	flag.IntVar(&options.MinimumTokenLength, "min-length", options.MinimumTokenLength, "the minimum length of a value which is checked for entropy")
+	flag.Parse()
+
+	if *allowed != "" {
+		for _, pattern := range strings.Split(*allowed, ",") {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "parsing allowed pattern %q: %+v\n", pattern, err)
+				os.Exit(1)
+			}
+			options.Allowed = append(options.Allowed, re)
+		}
+	}
+
+	findings, err := vcr.LintCassettes(*root, options)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "linting cassettes: %+v\n", err)
+		os.Exit(1)
+	}
+
+	if len(findings) > 0 {
+		fmt.Fprintf(os.Stderr, "❌ Potentially sensitive values were found in %d place(s) within the VCR cassettes:\n\n", len(findings))
+		for _, finding := range findings {
+			fmt.Fprintf(os.Stderr, "%s\n", finding)
+		}
+		fmt.Fprintf(os.Stderr, `
+Cassettes must not contain secrets - re-record the test(s) after adding a redaction rule for these values
+(see DefaultRedactionRules/RegisterRedactionRules in internal/vcr/redaction.go), or use -allow if the
+values are known not to be sensitive.
+
+`)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✅ No sensitive values detected in the VCR cassettes within %q\n", *root)
+}
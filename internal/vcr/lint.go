@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+// LintOptions configures the checks performed by LintCassettes.
+type LintOptions struct {
+	// EntropyThreshold is the Shannon entropy (in bits per character) above which a token is considered to be a
+	// secret. Hex-encoded values (such as GUIDs and thumbprints) cannot exceed 4 bits per character.
+	EntropyThreshold float64
+
+	// MinimumTokenLength is the minimum length of a token which is checked for entropy.
+	MinimumTokenLength int
+
+	// Allowed is a list of regular expressions matching values which are known not to be sensitive, such as
+	// public certificate data.
+	Allowed []*regexp.Regexp
+}
+
+// DefaultLintOptions returns the LintOptions used by the `vcr-lint` tool by default.
+func DefaultLintOptions() LintOptions {
+	return LintOptions{
+		EntropyThreshold:   4.5,
+		MinimumTokenLength: 32,
+	}
+}
+
+// LintFinding describes a potentially sensitive value found within a cassette.
+type LintFinding struct {
+	File        string
+	Interaction int
+	Location    string
+	Reason      string
+	Value       string
+}
+
+func (f LintFinding) String() string {
+	return fmt.Sprintf("%s: interaction %d: %s: %s (%s)", f.File, f.Interaction, f.Location, f.Reason, f.Value)
+}
+
+var (
+	tokenRe = regexp.MustCompile(`[A-Za-z0-9+/_\-]+={0,2}`)
+
+	unredactedSubscriptionRe = regexp.MustCompile(`(?i)/subscriptions/([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})`)
+)
+
+// LintCassettes checks all of the cassettes within `vcrtestdata` directories beneath `root`, returning any values
+// which the redaction pipeline would have redacted or which appear to be secrets due to their entropy.
+func LintCassettes(root string, options LintOptions) ([]LintFinding, error) {
+	files := make([]string, 0)
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && (d.Name() == "vendor" || d.Name() == ".git") {
+			return filepath.SkipDir
+		}
+		if !d.IsDir() && strings.HasSuffix(path, ".yaml") && strings.Contains(filepath.ToSlash(path), "/"+testDataPath+"/") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("finding cassettes within %q: %+v", root, err)
+	}
+	sort.Strings(files)
+
+	findings := make([]LintFinding, 0)
+	for _, file := range files {
+		fileFindings, err := LintCassette(file, options)
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, fileFindings...)
+	}
+
+	return findings, nil
+}
+
+// LintCassette checks a single cassette file, see LintCassettes.
+func LintCassette(file string, options LintOptions) ([]LintFinding, error) {
+	c, err := cassette.Load(strings.TrimSuffix(file, ".yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("loading cassette %q: %+v", file, err)
+	}
+
+	redactor := NewRedactor(append([]RedactionRule{newTenantRule()}, registeredRedactionRules()...)...)
+
+	findings := make([]LintFinding, 0)
+	for _, i := range c.Interactions {
+		values := map[string]string{
+			"request url":   i.Request.URL,
+			"request body":  i.Request.Body,
+			"response body": i.Response.Body,
+		}
+		addHeaders(values, "request header", i.Request.Headers)
+		addHeaders(values, "response header", i.Response.Headers)
+		for k, vals := range i.Request.Form {
+			for _, v := range vals {
+				values[fmt.Sprintf("request form %q", k)] = fmt.Sprintf("%s=%s", k, v)
+			}
+		}
+
+		for location, value := range values {
+			if value == "" {
+				continue
+			}
+
+			if strings.EqualFold(location, fmt.Sprintf("request header %q", "Authorization")) {
+				if value != "Bearer REDACTED" {
+					findings = append(findings, LintFinding{File: file, Interaction: i.ID, Location: location, Reason: "authorization header was not redacted", Value: truncate(value)})
+				}
+				continue
+			}
+
+			for _, match := range unredactedSubscriptionRe.FindAllStringSubmatch(value, -1) {
+				if !IsPlaceholder(match[1]) {
+					findings = append(findings, LintFinding{File: file, Interaction: i.ID, Location: location, Reason: "subscription id was not redacted", Value: match[1]})
+				}
+			}
+
+			if redacted := redactor.Redact(value); redacted != value {
+				findings = append(findings, LintFinding{File: file, Interaction: i.ID, Location: location, Reason: "contains a known-sensitive value", Value: truncate(firstDifference(value, redacted))})
+			}
+
+			for _, token := range tokenRe.FindAllString(value, -1) {
+				if len(token) < options.MinimumTokenLength || IsPlaceholder(token) || isAllowed(token, options.Allowed) {
+					continue
+				}
+				if entropy := shannonEntropy(token); entropy > options.EntropyThreshold {
+					findings = append(findings, LintFinding{File: file, Interaction: i.ID, Location: location, Reason: fmt.Sprintf("high entropy value (%.2f bits per character)", entropy), Value: truncate(token)})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(findings, func(x, y int) bool {
+		if findings[x].Interaction != findings[y].Interaction {
+			return findings[x].Interaction < findings[y].Interaction
+		}
+		return findings[x].Location < findings[y].Location
+	})
+
+	return findings, nil
+}
+
+func addHeaders(values map[string]string, prefix string, headers http.Header) {
+	for k, vals := range headers {
+		values[fmt.Sprintf("%s %q", prefix, k)] = strings.Join(vals, ", ")
+	}
+}
+
+func isAllowed(input string, allowed []*regexp.Regexp) bool {
+	for _, re := range allowed {
+		if re.MatchString(input) {
+			return true
+		}
+	}
+	return false
+}
+
+// shannonEntropy returns the Shannon entropy of `input` in bits per character.
+func shannonEntropy(input string) float64 {
+	if input == "" {
+		return 0
+	}
+
+	counts := make(map[rune]int)
+	for _, r := range input {
+		counts[r]++
+	}
+
+	entropy := 0.0
+	length := float64(len([]rune(input)))
+	for _, count := range counts {
+		p := float64(count) / length
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
+
+// firstDifference returns the portion of `original` starting where it first differs from `redacted`, so that the
+// finding points at the sensitive value rather than the start of the body.
+func firstDifference(original, redacted string) string {
+	for i := 0; i < len(original) && i < len(redacted); i++ {
+		if original[i] != redacted[i] {
+			return original[i:]
+		}
+	}
+	return original
+}
+
+// truncate shortens values so that the findings don't themselves reproduce the secret in full.
+func truncate(input string) string {
+	if len(input) <= 12 {
+		return input
+	}
+	return input[:12] + "..."
+}
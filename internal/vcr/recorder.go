@@ -38,7 +38,8 @@ const (
 )
 
 // GetRecorder returns the shared recorder for a given test name, initialising it if necessary.
-// it redacts sensitive information, such as SubscriptionID and Authorization Headers and tailors the matcher to AzureRM
+// it redacts sensitive information, such as SubscriptionID and Authorization Headers, along with the values matched by
+// the redaction pipeline (see DefaultRedactionRules and RegisterRedactionRules) and tailors the matcher to AzureRM
 // requests.
 func GetRecorder(testName string, subscriptionId string) (*recorder.Recorder, error) {
 	if testName == "" {
@@ -90,13 +91,11 @@ func GetRecorder(testName string, subscriptionId string) (*recorder.Recorder, er
 		})
 	}
 
-	redactHeaders := func(headers http.Header) {
-		for k, vals := range headers {
-			for j, v := range vals {
-				headers[k][j] = redactSubscriptions(v)
-			}
-		}
+	rules := []RedactionRule{
+		RedactionRuleFunc(redactSubscriptions),
+		newTenantRule(os.Getenv("ARM_TENANT_ID")),
 	}
+	redactor := NewRedactor(append(rules, registeredRedactionRules()...)...)
 
 	if r, exists := recorders[testName]; exists {
 		return r, nil
@@ -130,22 +129,22 @@ func GetRecorder(testName string, subscriptionId string) (*recorder.Recorder, er
 	)
 
 	matcher := cassette.MatcherFunc(func(r *http.Request, i cassette.Request) bool {
-		// Normalise subscription IDs in the incoming request before matching
-		normalisedURL, err := url.Parse(redactSubscriptions(r.URL.String()))
+		// Redact the incoming request before matching, since the cassette has already been redacted
+		normalisedURL, err := url.Parse(redactor.Redact(r.URL.String()))
 		if err != nil {
 			return false
 		}
 		rCopy := r.Clone(r.Context())
 		rCopy.URL = normalisedURL
-		rCopy.RequestURI = redactSubscriptions(rCopy.RequestURI)
-		redactHeaders(rCopy.Header)
+		rCopy.RequestURI = redactor.Redact(rCopy.RequestURI)
+		redactor.RedactHeaders(rCopy.Header)
 
 		// Redact Body in the incoming request so body matching succeeds
 		if r.Body != nil && r.Body != http.NoBody {
 			if bodyBytes, err := io.ReadAll(r.Body); err == nil {
 				// Restore original body for proper processing downstream
 				r.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-				redactedBody := redactSubscriptions(string(bodyBytes))
+				redactedBody := redactor.Redact(string(bodyBytes))
 				rCopy.Body = io.NopCloser(strings.NewReader(redactedBody))
 				rCopy.ContentLength = int64(len(redactedBody))
 			}
@@ -153,10 +152,10 @@ func GetRecorder(testName string, subscriptionId string) (*recorder.Recorder, er
 
 		// Also normalise in the cassette interaction copy
 		iCopy := i
-		iCopy.URL = redactSubscriptions(i.URL)
-		iCopy.RequestURI = redactSubscriptions(i.RequestURI)
-		iCopy.Body = redactSubscriptions(i.Body)
-		redactHeaders(i.Headers)
+		iCopy.URL = redactor.Redact(i.URL)
+		iCopy.RequestURI = redactor.Redact(i.RequestURI)
+		iCopy.Body = redactor.Redact(i.Body)
+		redactor.RedactHeaders(i.Headers)
 
 		return headerMatcher(rCopy, iCopy)
 	})
@@ -191,12 +190,13 @@ func GetRecorder(testName string, subscriptionId string) (*recorder.Recorder, er
 			return nil
 		}, recorder.BeforeSaveHook),
 		recorder.WithHook(func(i *cassette.Interaction) error {
-			i.Request.URL = redactSubscriptions(i.Request.URL)
-			i.Request.RequestURI = redactSubscriptions(i.Request.RequestURI)
-			i.Request.Body = redactSubscriptions(i.Request.Body)
-			redactHeaders(i.Request.Headers)
-			i.Response.Body = redactSubscriptions(i.Response.Body)
-			redactHeaders(i.Response.Headers)
+			i.Request.URL = redactor.Redact(i.Request.URL)
+			i.Request.RequestURI = redactor.Redact(i.Request.RequestURI)
+			i.Request.Body = redactor.Redact(i.Request.Body)
+			redactor.RedactHeaders(i.Request.Headers)
+			redactor.RedactForm(i.Request.Form)
+			i.Response.Body = redactor.Redact(i.Response.Body)
+			redactor.RedactHeaders(i.Response.Headers)
 			return nil
 		}, recorder.BeforeSaveHook),
 	)
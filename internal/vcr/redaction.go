@@ -0,0 +1,390 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// TenantPlaceholder replaces the Tenant ID, which (like the Subscription IDs) is mapped to a fixed value so that
+	// requests built from the `ARM_TENANT_ID` environment variable during replay match the cassette.
+	TenantPlaceholder = "00000000-0000-0000-0000-00000000000a"
+
+	// guidPlaceholderPrefix prefixes the deterministic placeholders used for other redacted GUIDs
+	guidPlaceholderPrefix = "00000000-0000-0000-0000-"
+
+	// secretPlaceholderPrefix prefixes the deterministic placeholders used for redacted secrets, these are valid
+	// (albeit meaningless) base64 so that they can still be decoded by the Provider during replay
+	secretPlaceholderPrefix = "REDACTED"
+)
+
+// PlaceholderKind determines the format of the placeholder which replaces a redacted value.
+type PlaceholderKind int
+
+const (
+	// PlaceholderSecret replaces the value with `REDACTED` followed by 16 hex characters
+	PlaceholderSecret PlaceholderKind = iota
+
+	// PlaceholderGUID replaces the value with a GUID in the form `00000000-0000-0000-0000-xxxxxxxxxxxx`
+	PlaceholderGUID
+)
+
+// Placeholder returns the deterministic placeholder for the sensitive value `input`. The same value always maps to the
+// same placeholder (including across test runs) so that requests containing it still match the cassette, and
+// placeholders are returned unchanged so that redaction can be applied repeatedly.
+func Placeholder(kind PlaceholderKind, input string) string {
+	if input == "" || IsPlaceholder(input) {
+		return input
+	}
+
+	hash := sha256.Sum256([]byte(input))
+	digest := hex.EncodeToString(hash[:])
+
+	if kind == PlaceholderGUID {
+		return guidPlaceholderPrefix + digest[:12]
+	}
+
+	return secretPlaceholderPrefix + digest[:16]
+}
+
+// IsPlaceholder returns whether `input` is a placeholder which has been inserted by redaction.
+func IsPlaceholder(input string) bool {
+	return strings.HasPrefix(input, guidPlaceholderPrefix) || strings.Contains(input, secretPlaceholderPrefix)
+}
+
+// RedactionRule is a single step in the redaction pipeline, which replaces any sensitive values within `input`.
+type RedactionRule interface {
+	Redact(input string) string
+}
+
+// RedactionRuleFunc allows a function to be used as a RedactionRule.
+type RedactionRuleFunc func(input string) string
+
+func (f RedactionRuleFunc) Redact(input string) string {
+	return f(input)
+}
+
+// RegexRule redacts the value captured by the last capturing group of Pattern wherever it's matched.
+type RegexRule struct {
+	Pattern *regexp.Regexp
+	Kind    PlaceholderKind
+}
+
+// NewRegexRule returns a RegexRule for the (case-insensitive) regular expression `pattern`, the last capturing group
+// of which is the sensitive value.
+func NewRegexRule(pattern string, kind PlaceholderKind) RegexRule {
+	return RegexRule{
+		Pattern: regexp.MustCompile("(?i)" + pattern),
+		Kind:    kind,
+	}
+}
+
+func (r RegexRule) Redact(input string) string {
+	return r.Pattern.ReplaceAllStringFunc(input, func(match string) string {
+		groups := r.Pattern.FindStringSubmatchIndex(match)
+		if len(groups) < 4 {
+			return Placeholder(r.Kind, match)
+		}
+
+		start, end := groups[len(groups)-2], groups[len(groups)-1]
+		if start < 0 {
+			return match
+		}
+
+		value := match[start:end]
+		if unescaped, err := url.QueryUnescape(value); err == nil && unescaped != value {
+			return match[:start] + url.QueryEscape(Placeholder(r.Kind, unescaped)) + match[end:]
+		}
+
+		return match[:start] + Placeholder(r.Kind, value) + match[end:]
+	})
+}
+
+// JSONPathRule redacts the string values found at Path within JSON documents, inputs which aren't JSON are returned
+// unchanged. Path supports a subset of JSONPath: `$` (the root), `.name` (a child), `..name` (any descendant with the
+// name, matched case-insensitively) and `[*]` (all items of an array or values of an object).
+type JSONPathRule struct {
+	Path string
+	Kind PlaceholderKind
+
+	once     sync.Once
+	segments []jsonPathSegment
+}
+
+type jsonPathSegment struct {
+	name      string
+	recursive bool
+	wildcard  bool
+}
+
+// NewJSONPathRule returns a JSONPathRule for the path `path`.
+func NewJSONPathRule(path string, kind PlaceholderKind) *JSONPathRule {
+	return &JSONPathRule{
+		Path: path,
+		Kind: kind,
+	}
+}
+
+func (r *JSONPathRule) Redact(input string) string {
+	trimmed := strings.TrimSpace(input)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return input
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(trimmed))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return input
+	}
+
+	r.once.Do(func() {
+		r.segments = parseJSONPath(r.Path)
+	})
+
+	document, changed := r.redactValue(document, r.segments)
+	if !changed {
+		return input
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(document); err != nil {
+		return input
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func (r *JSONPathRule) redactValue(input interface{}, segments []jsonPathSegment) (interface{}, bool) {
+	if len(segments) == 0 {
+		switch v := input.(type) {
+		case string:
+			redacted := Placeholder(r.Kind, v)
+			return redacted, redacted != v
+		case []interface{}:
+			// e.g. a list of keys, redact each of them
+			changed := false
+			for i, item := range v {
+				var itemChanged bool
+				v[i], itemChanged = r.redactValue(item, nil)
+				changed = changed || itemChanged
+			}
+			return v, changed
+		}
+		return input, false
+	}
+
+	segment := segments[0]
+	changed := false
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			var valueChanged bool
+			if segment.wildcard || strings.EqualFold(key, segment.name) {
+				v[key], valueChanged = r.redactValue(value, segments[1:])
+				changed = changed || valueChanged
+				if segment.recursive {
+					// the value may itself contain further matches
+					v[key], valueChanged = r.redactValue(v[key], segments)
+					changed = changed || valueChanged
+				}
+				continue
+			}
+			if segment.recursive {
+				v[key], valueChanged = r.redactValue(value, segments)
+				changed = changed || valueChanged
+			}
+		}
+
+	case []interface{}:
+		for i, item := range v {
+			var itemChanged bool
+			if segment.wildcard && !segment.recursive {
+				v[i], itemChanged = r.redactValue(item, segments[1:])
+			} else if segment.recursive {
+				v[i], itemChanged = r.redactValue(item, segments)
+			}
+			changed = changed || itemChanged
+		}
+	}
+
+	return input, changed
+}
+
+func parseJSONPath(path string) []jsonPathSegment {
+	path = strings.TrimPrefix(strings.TrimSpace(path), "$")
+	path = strings.ReplaceAll(path, "[*]", ".*")
+
+	segments := make([]jsonPathSegment, 0)
+	recursive := false
+	for _, part := range strings.Split(path, ".") {
+		if part == "" {
+			// an empty part between two dots denotes a recursive descent (`..name`)
+			if len(segments) > 0 || strings.HasPrefix(path, "..") {
+				recursive = true
+			}
+			continue
+		}
+
+		segments = append(segments, jsonPathSegment{
+			name:      part,
+			recursive: recursive,
+			wildcard:  part == "*",
+		})
+		recursive = false
+	}
+
+	return segments
+}
+
+var (
+	additionalRules   = make([]RedactionRule, 0)
+	additionalRulesMu sync.Mutex
+)
+
+// RegisterRedactionRules adds rules to the redaction pipeline used by all recorders created after it's called, this
+// allows service packages to redact values specific to their APIs.
+func RegisterRedactionRules(rules ...RedactionRule) {
+	additionalRulesMu.Lock()
+	defer additionalRulesMu.Unlock()
+
+	additionalRules = append(additionalRules, rules...)
+}
+
+// registeredRedactionRules returns the DefaultRedactionRules followed by any rules added by RegisterRedactionRules.
+func registeredRedactionRules() []RedactionRule {
+	additionalRulesMu.Lock()
+	defer additionalRulesMu.Unlock()
+
+	return append(DefaultRedactionRules(), additionalRules...)
+}
+
+// DefaultRedactionRules returns the rules which redact commonly returned sensitive values - such as Tenant and Object
+// IDs, access keys, SAS signatures, connection strings, client secrets and passwords.
+func DefaultRedactionRules() []RedactionRule {
+	guid := `([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})`
+
+	rules := []RedactionRule{
+		// Object IDs and Client IDs of principals
+		NewRegexRule(`"(?:principalId|objectId|clientId|appId|oid|applicationId|servicePrincipalId)"\s*:\s*"`+guid, PlaceholderGUID),
+
+		// SAS tokens and signed URLs
+		NewRegexRule(`((?:[?&;]|\\u0026)sig=)([^&"'\s;\\]+)`, PlaceholderSecret),
+
+		// Connection Strings
+		NewRegexRule(`((?:AccountKey|SharedAccessKey|SharedAccessSignature|Password|Pwd|AccessKey)=)([^;"'\s]+)`, PlaceholderSecret),
+
+		// OAuth2 form bodies and tokens
+		NewRegexRule(`((?:client_secret|client_assertion|refresh_token|access_token|password)=)([^&"'\s]+)`, PlaceholderSecret),
+		NewRegexRule(`("(?:access_token|refresh_token|id_token)"\s*:\s*")([^"]+)`, PlaceholderSecret),
+	}
+
+	for _, path := range []string{
+		// `listKeys`-style responses
+		"$.keys[*].value",
+		"$..primaryKey",
+		"$..secondaryKey",
+		"$..primaryMasterKey",
+		"$..secondaryMasterKey",
+		"$..primaryReadonlyMasterKey",
+		"$..secondaryReadonlyMasterKey",
+		"$..primaryConnectionString",
+		"$..secondaryConnectionString",
+		"$..primaryAccessKey",
+		"$..secondaryAccessKey",
+		"$..accessKey",
+		"$..sharedKey",
+		"$..storageAccountKey",
+		"$..instrumentationKey",
+
+		// Passwords and secrets within request and response payloads
+		"$..password",
+		"$..adminPassword",
+		"$..administratorLoginPassword",
+		"$..clientSecret",
+		"$..secret",
+		"$..sasToken",
+		"$..sasUri",
+		"$..sasUrl",
+		"$..connectionString",
+	} {
+		rules = append(rules, NewJSONPathRule(path, PlaceholderSecret))
+	}
+
+	return rules
+}
+
+// Redactor runs a pipeline of RedactionRules over the requests and responses saved to (and matched against)
+// cassettes.
+type Redactor struct {
+	rules []RedactionRule
+}
+
+// NewRedactor returns a Redactor which applies the rules in the order specified.
+func NewRedactor(rules ...RedactionRule) *Redactor {
+	return &Redactor{
+		rules: rules,
+	}
+}
+
+// Redact applies each rule in the pipeline to `input`.
+func (r *Redactor) Redact(input string) string {
+	for _, rule := range r.rules {
+		input = rule.Redact(input)
+	}
+	return input
+}
+
+// RedactHeaders applies the pipeline to each of the header values in-place.
+func (r *Redactor) RedactHeaders(headers http.Header) {
+	for k, vals := range headers {
+		for j, v := range vals {
+			headers[k][j] = r.Redact(v)
+		}
+	}
+}
+
+// RedactForm applies the pipeline to each of the form values in-place.
+func (r *Redactor) RedactForm(form url.Values) {
+	for k, vals := range form {
+		for j, v := range vals {
+			// rules generally match on the key too, e.g. `client_secret=`
+			prefix := fmt.Sprintf("%s=", k)
+			form[k][j] = strings.TrimPrefix(r.Redact(prefix+v), prefix)
+		}
+	}
+}
+
+// newTenantRule returns a rule which maps the known Tenant IDs (and any other Tenant ID in a well-known location)
+// to the TenantPlaceholder.
+func newTenantRule(tenantIds ...string) RedactionRule {
+	knownTenants := make([]*regexp.Regexp, 0)
+	for _, id := range tenantIds {
+		if id != "" && id != TenantPlaceholder {
+			knownTenants = append(knownTenants, regexp.MustCompile("(?i)"+regexp.QuoteMeta(id)))
+		}
+	}
+
+	tenantRe := regexp.MustCompile(`(?i)("tenantId"\s*:\s*"|tenantId=|/tenants/|login\.microsoftonline\.com/|"tid"\s*:\s*")([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})`)
+
+	return RedactionRuleFunc(func(input string) string {
+		for _, re := range knownTenants {
+			input = re.ReplaceAllString(input, TenantPlaceholder)
+		}
+
+		return tenantRe.ReplaceAllString(input, "${1}"+TenantPlaceholder)
+	})
+}
@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/yaml.v3"
+)
+
+func TestPlaceholder(t *testing.T) {
+	first := Placeholder(PlaceholderSecret, "super-secret")
+	if first != Placeholder(PlaceholderSecret, "super-secret") {
+		t.Fatalf("expected the placeholder to be deterministic")
+	}
+	if !strings.HasPrefix(first, "REDACTED") || len(first) != 24 {
+		t.Fatalf("unexpected secret placeholder %q", first)
+	}
+	if Placeholder(PlaceholderSecret, first) != first {
+		t.Fatalf("expected a placeholder to be returned unchanged")
+	}
+
+	guid := Placeholder(PlaceholderGUID, "11111111-2222-3333-4444-555555555555")
+	if !strings.HasPrefix(guid, "00000000-0000-0000-0000-") || len(guid) != 36 {
+		t.Fatalf("unexpected guid placeholder %q", guid)
+	}
+}
+
+func TestRedactor(t *testing.T) {
+	redactor := NewRedactor(append([]RedactionRule{newTenantRule("aaaaaaaa-1111-2222-3333-444444444444")}, DefaultRedactionRules()...)...)
+
+	testData := []struct {
+		name        string
+		input       string
+		sensitive   []string
+		contains    []string
+		notModified bool
+	}{
+		{
+			name:      "listKeys",
+			input:     `{"keys":[{"keyName":"key1","value":"c2VjcmV0LWtleS0x","permissions":"FULL"},{"keyName":"key2","value":"c2VjcmV0LWtleS0y"}]}`,
+			sensitive: []string{"c2VjcmV0LWtleS0x", "c2VjcmV0LWtleS0y"},
+			contains:  []string{`"keyName":"key1"`, `"permissions":"FULL"`},
+		},
+		{
+			name:      "nested keys and passwords",
+			input:     `{"properties":{"osProfile":{"adminPassword":"P@ssw0rd1234!"},"primaryKey":"abc123","count":12345678901234567890}}`,
+			sensitive: []string{"P@ssw0rd1234!", "abc123"},
+			contains:  []string{"12345678901234567890"},
+		},
+		{
+			name:      "tenant and principal ids",
+			input:     `{"identity":{"principalId":"bbbbbbbb-1111-2222-3333-444444444444","tenantId":"cccccccc-1111-2222-3333-444444444444"},"owner":"aaaaaaaa-1111-2222-3333-444444444444"}`,
+			sensitive: []string{"bbbbbbbb-1111-2222-3333-444444444444", "cccccccc-1111-2222-3333-444444444444", "aaaaaaaa-1111-2222-3333-444444444444"},
+			contains:  []string{TenantPlaceholder},
+		},
+		{
+			name:      "sas url",
+			input:     "https://example.blob.core.windows.net/c/b?sv=2021-08-06&se=2030-01-01&sig=abc%2Fdef%3D&sp=r",
+			sensitive: []string{"abc%2Fdef%3D"},
+			contains:  []string{"&sp=r", "sv=2021-08-06"},
+		},
+		{
+			name:      "connection string",
+			input:     `DefaultEndpointsProtocol=https;AccountName=example;AccountKey=a2V5a2V5a2V5;EndpointSuffix=core.windows.net`,
+			sensitive: []string{"a2V5a2V5a2V5"},
+			contains:  []string{"AccountName=example", ";EndpointSuffix=core.windows.net"},
+		},
+		{
+			name:      "client secret form",
+			input:     "grant_type=client_credentials&client_id=abc&client_secret=s3cr3t&scope=x",
+			sensitive: []string{"s3cr3t"},
+			contains:  []string{"&scope=x"},
+		},
+		{
+			name:        "not sensitive",
+			input:       `{"name":"example","properties":{"provisioningState":"Succeeded"}}`,
+			notModified: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := redactor.Redact(v.input)
+		if v.notModified && actual != v.input {
+			t.Fatalf("expected the input to be unmodified but got %q", actual)
+		}
+		for _, s := range v.sensitive {
+			if strings.Contains(actual, s) {
+				t.Fatalf("expected %q to be redacted from %q", s, actual)
+			}
+		}
+		for _, s := range v.contains {
+			if !strings.Contains(actual, s) {
+				t.Fatalf("expected %q to be retained in %q", s, actual)
+			}
+		}
+
+		if again := redactor.Redact(actual); again != actual {
+			t.Fatalf("expected redaction to be idempotent, got %q then %q", actual, again)
+		}
+		if again := redactor.Redact(v.input); again != actual {
+			t.Fatalf("expected redaction to be deterministic, got %q then %q", actual, again)
+		}
+	}
+}
+
+func TestLintCassette(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), testDataPath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	c := cassette.New(filepath.Join(dir, "TestAccExample_basic"))
+	c.MarshalFunc = yaml.Marshal
+	c.AddInteraction(&cassette.Interaction{
+		Request: cassette.Request{
+			URL:     "https://management.azure.com/subscriptions/" + SubscriptionPlaceholder + "/resourceGroups/example?api-version=2020-01-01",
+			Headers: map[string][]string{"Authorization": {"Bearer REDACTED"}},
+			Method:  "GET",
+		},
+		Response: cassette.Response{
+			Body: `{"name":"example","properties":{"provisioningState":"Succeeded"}}`,
+			Code: 200,
+		},
+	})
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	findings, err := LintCassettes(filepath.Dir(dir), DefaultLintOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 0 {
+		t.Fatalf("expected no findings for a redacted cassette but got %+v", findings)
+	}
+
+	c.AddInteraction(&cassette.Interaction{
+		Request: cassette.Request{
+			URL:     "https://management.azure.com/subscriptions/12345678-1234-1234-1234-123456789012/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys?api-version=2023-01-01",
+			Headers: map[string][]string{"Authorization": {"Bearer eyJ0eXAi"}},
+			Method:  "POST",
+		},
+		Response: cassette.Response{
+			Body: `{"keys":[{"keyName":"key1","value":"Zm9vYmFyYmF6cXV4cXV1eGNvcmdlZ3JhdWx0Z2FycGx5d2FsZG8="}]}`,
+			Code: 200,
+		},
+	})
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	findings, err = LintCassettes(filepath.Dir(dir), DefaultLintOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reasons := make([]string, 0)
+	for _, f := range findings {
+		reasons = append(reasons, f.Reason)
+	}
+	for _, expected := range []string{"authorization header was not redacted", "subscription id was not redacted", "contains a known-sensitive value", "high entropy value"} {
+		found := false
+		for _, reason := range reasons {
+			if strings.HasPrefix(reason, expected) {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("expected a finding for %q but got %+v", expected, findings)
+		}
+	}
+}